@@ -0,0 +1,193 @@
+package elevationrequest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rancher/norman/api/access"
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/elevationrequest"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/rancher/rancher/pkg/types/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Handler struct {
+	ElevationRequests  v3.ElevationRequestInterface
+	RoleTemplateLister v3.RoleTemplateLister
+	Approvers          *elevationrequest.Approvers
+}
+
+func NewHandler(management *config.ScaledContext) *Handler {
+	return &Handler{
+		ElevationRequests:  management.Management.ElevationRequests(""),
+		RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		Approvers: &elevationrequest.Approvers{
+			CRTBLister:         management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+			PRTBLister:         management.Management.ProjectRoleTemplateBindings("").Controller().Lister(),
+			GRBLister:          management.Management.GlobalRoleBindings("").Controller().Lister(),
+			RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		},
+	}
+}
+
+// Validator sets the requesting user and checks the requested role, target and duration of new elevation requests.
+func (h *Handler) Validator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if request.Method != http.MethodPost {
+		return nil
+	}
+
+	userID := request.Request.Header.Get("Impersonate-User")
+	if userID == "" {
+		return httperror.NewAPIError(httperror.NotFound, "missing user")
+	}
+	data[client.ElevationRequestFieldUserID] = userID
+
+	clusterID := convert.ToString(data[client.ElevationRequestFieldClusterID])
+	projectID := convert.ToString(data[client.ElevationRequestFieldProjectID])
+	if (clusterID == "") == (projectID == "") {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "must target a cluster [clusterId] OR a project [projectId]")
+	}
+	context := "cluster"
+	if projectID != "" {
+		context = "project"
+	}
+
+	roleTemplateID := convert.ToString(data[client.ElevationRequestFieldRoleTemplateID])
+	roleTemplate, err := h.RoleTemplateLister.Get("", roleTemplateID)
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("Error getting role template: %v", err))
+	}
+	if roleTemplate.Locked {
+		return httperror.NewAPIError(httperror.InvalidState, "Role is locked and cannot be assigned")
+	}
+	if roleTemplate.Context != context {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("Cannot reference context [%s] from [%s] context",
+			roleTemplate.Context, context))
+	}
+
+	duration, err := convert.ToNumber(data[client.ElevationRequestFieldDurationMinutes])
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidFormat, fmt.Sprintf("invalid %s: %v", client.ElevationRequestFieldDurationMinutes, err))
+	}
+	if max := settings.ElevationRequestMaxDurationMinutes.GetInt(); max > 0 && duration > int64(max) {
+		return httperror.NewAPIError(httperror.MaxLimitExceeded, fmt.Sprintf("%s can not exceed %d minutes",
+			client.ElevationRequestFieldDurationMinutes, max))
+	}
+
+	return nil
+}
+
+// Formatter adds the approve and deny actions to pending requests of other users if the user can approve them.
+func (h *Handler) Formatter(apiContext *types.APIContext, resource *types.RawResource) {
+	if decided(resource.Values) {
+		return
+	}
+	if userID := apiContext.Request.Header.Get("Impersonate-User"); userID == "" || userID == resource.Values[client.ElevationRequestFieldUserID] {
+		return
+	}
+	if err := canApprove(apiContext, resource.Values); err != nil {
+		return
+	}
+	resource.AddAction(apiContext, v32.ElevationRequestActionApprove)
+	resource.AddAction(apiContext, v32.ElevationRequestActionDeny)
+}
+
+// ActionHandler records the decision of an approver on a pending request, the elevationrequest controller grants the
+// role of approved requests.
+func (h *Handler) ActionHandler(actionName string, action *types.Action, apiContext *types.APIContext) error {
+	if actionName != v32.ElevationRequestActionApprove && actionName != v32.ElevationRequestActionDeny {
+		return httperror.NewAPIError(httperror.NotFound, "not found")
+	}
+
+	if err := canApprove(apiContext, nil); err != nil {
+		return err
+	}
+
+	request, err := h.ElevationRequests.Get(apiContext.ID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	userID := apiContext.Request.Header.Get("Impersonate-User")
+	if userID == "" || userID == request.Spec.UserName {
+		return httperror.NewAPIError(httperror.PermissionDenied, "can not decide on your own elevation request")
+	}
+	if v32.ElevationRequestConditionApproved.GetStatus(request) != "" {
+		return httperror.NewAPIError(httperror.InvalidState, "elevation request has already been decided")
+	}
+
+	approved := actionName == v32.ElevationRequestActionApprove
+	if approved {
+		holdsRole, err := h.Approvers.HoldsRole(request, userID)
+		if err != nil {
+			return err
+		}
+		if !holdsRole {
+			return httperror.NewAPIError(httperror.PermissionDenied, fmt.Sprintf("can not approve role %s that you do not hold",
+				request.Spec.RoleTemplateName))
+		}
+	}
+
+	actionInput, err := parse.ReadBody(apiContext.Request)
+	if err != nil {
+		return err
+	}
+	input := v32.ElevationDecisionInput{}
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, fmt.Sprintf("failed to parse action input: %v", err))
+	}
+
+	request = request.DeepCopy()
+	request.Status.Decisions = append(request.Status.Decisions, v32.ElevationDecision{
+		UserName: userID,
+		Approved: approved,
+		Reason:   input.Reason,
+		Time:     metav1.NewTime(time.Now()),
+	})
+	if approved {
+		v32.ElevationRequestConditionApproved.True(request)
+		v32.ElevationRequestConditionApproved.Reason(request, "Approved")
+		v32.ElevationRequestConditionApproved.Message(request, fmt.Sprintf("approved by %s", userID))
+	} else {
+		v32.ElevationRequestConditionApproved.False(request)
+		v32.ElevationRequestConditionApproved.Reason(request, "Denied")
+		v32.ElevationRequestConditionApproved.Message(request, fmt.Sprintf("denied by %s", userID))
+	}
+
+	if _, err := h.ElevationRequests.Update(request); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{}
+	if err := access.ByID(apiContext, apiContext.Version, apiContext.Type, apiContext.ID, &data); err != nil {
+		return err
+	}
+
+	apiContext.WriteResponse(http.StatusOK, data)
+	return nil
+}
+
+func canApprove(apiContext *types.APIContext, obj map[string]interface{}) error {
+	return apiContext.AccessControl.CanDo(v3.ElevationRequestGroupVersionKind.Group, v3.ElevationRequestResource.Name,
+		v32.ElevationRequestApproveVerb, apiContext, obj, apiContext.Schema)
+}
+
+// decided returns true if the request has been approved or denied.
+func decided(data map[string]interface{}) bool {
+	conditions, _ := values.GetSlice(data, "status", "conditions")
+	for _, cond := range conditions {
+		if cond["type"] == string(v32.ElevationRequestConditionApproved) && convert.ToString(cond["status"]) != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@
 	"encoding/base32"
 	"fmt"
 	"strings"
+	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
@@ -66,8 +67,12 @@ func (ma *MemberAccess) IsAdmin(callerID string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	now := time.Now()
 	for _, grb := range grbs {
 		if grb.UserName == callerID {
+			if active, _ := rbac.BindingActive(grb.NotBefore, grb.ExpiresAt, now); !active {
+				continue
+			}
 			gr, err := ma.GrLister.Get("", grb.GlobalRoleName)
 			if apierrors.IsNotFound(err) {
 				continue
@@ -100,11 +105,13 @@ func (ma *MemberAccess) IsRestrictedAdmin(callerID string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	now := time.Now()
 	for _, grb := range grbs {
-		if grb.UserName == callerID {
-			if grb.GlobalRoleName == rbac.GlobalRestrictedAdmin {
-				return true, nil
-			}
+		if grb.UserName != callerID || grb.GlobalRoleName != rbac.GlobalRestrictedAdmin {
+			continue
+		}
+		if active, _ := rbac.BindingActive(grb.NotBefore, grb.ExpiresAt, now); active {
+			return true, nil
 		}
 	}
 	return false, nil
@@ -5,7 +5,6 @@
 	"fmt"
 	"strings"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/rancher/norman/api/access"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
@@ -16,6 +15,7 @@
 	"github.com/rancher/rancher/pkg/clustermanager"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
 	"github.com/rancher/rancher/pkg/resourcequota"
 	mgmtschema "github.com/rancher/rancher/pkg/schemas/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/types/config"
@@ -30,21 +30,23 @@
 
 type projectStore struct {
 	types.Store
-	projectLister      v3.ProjectLister
-	roleTemplateLister v3.RoleTemplateLister
-	scaledContext      *config.ScaledContext
-	clusterLister      v3.ClusterLister
-	secretLister       v1.SecretLister
+	projectLister                 v3.ProjectLister
+	roleTemplateLister            v3.RoleTemplateLister
+	projectTemplateRevisionLister v3.ProjectTemplateRevisionLister
+	scaledContext                 *config.ScaledContext
+	clusterLister                 v3.ClusterLister
+	secretLister                  v1.SecretLister
 }
 
 func SetProjectStore(schema *types.Schema, mgmt *config.ScaledContext) {
 	store := &projectStore{
-		Store:              schema.Store,
-		projectLister:      mgmt.Management.Projects("").Controller().Lister(),
-		roleTemplateLister: mgmt.Management.RoleTemplates("").Controller().Lister(),
-		scaledContext:      mgmt,
-		clusterLister:      mgmt.Management.Clusters("").Controller().Lister(),
-		secretLister:       mgmt.Core.Secrets("").Controller().Lister(),
+		Store:                         schema.Store,
+		projectLister:                 mgmt.Management.Projects("").Controller().Lister(),
+		roleTemplateLister:            mgmt.Management.RoleTemplates("").Controller().Lister(),
+		projectTemplateRevisionLister: mgmt.Management.ProjectTemplateRevisions("").Controller().Lister(),
+		scaledContext:                 mgmt,
+		clusterLister:                 mgmt.Management.Clusters("").Controller().Lister(),
+		secretLister:                  mgmt.Core.Secrets("").Controller().Lister(),
 	}
 	schema.Store = store
 }
@@ -55,6 +57,10 @@ func (s *projectStore) Create(apiContext *types.APIContext, schema *types.Schema
 		return nil, err
 	}
 
+	if err := s.loadDataFromTemplate(apiContext, data, ""); err != nil {
+		return nil, err
+	}
+
 	if err := s.validateResourceQuota(apiContext, data, ""); err != nil {
 		return nil, err
 	}
@@ -65,6 +71,10 @@ func (s *projectStore) Create(apiContext *types.APIContext, schema *types.Schema
 }
 
 func (s *projectStore) Update(apiContext *types.APIContext, schema *types.Schema, data map[string]interface{}, id string) (map[string]interface{}, error) {
+	if err := s.loadDataFromTemplate(apiContext, data, id); err != nil {
+		return nil, err
+	}
+
 	if err := s.validateResourceQuota(apiContext, data, id); err != nil {
 		return nil, err
 	}
@@ -107,7 +117,76 @@ func (s *projectStore) createProjectAnnotation() (string, error) {
 	return string(d), nil
 }
 
+// loadDataFromTemplate sets the project config of the project template revision a project is created from, or moved
+// to, so that it is validated like a config set on the project directly.
+func (s *projectStore) loadDataFromTemplate(apiContext *types.APIContext, data map[string]interface{}, id string) error {
+	revisionID := convert.ToString(data[mgmtclient.ProjectFieldProjectTemplateRevisionID])
+	if revisionID == "" {
+		return nil
+	}
+	if id != "" {
+		clusterName, projectName := ref.Parse(id)
+		existing, err := s.projectLister.Get(clusterName, projectName)
+		if err != nil {
+			return err
+		}
+		if existing.Spec.ProjectTemplateRevisionName == revisionID {
+			return nil
+		}
+	}
+
+	var revision mgmtclient.ProjectTemplateRevision
+	if err := access.ByID(apiContext, &mgmtschema.Version, mgmtclient.ProjectTemplateRevisionType, revisionID, &revision); err != nil {
+		if apiError, ok := err.(*httperror.APIError); ok && apiError.Code.Status == httperror.PermissionDenied.Status {
+			return httperror.NewAPIError(httperror.NotFound, "The projectTemplateRevision is not found")
+		}
+		return err
+	}
+
+	revisionNamespace, revisionName := ref.Parse(revisionID)
+	projectTemplateRevision, err := s.projectTemplateRevisionLister.Get(revisionNamespace, revisionName)
+	if err != nil {
+		return err
+	}
+	if projectTemplateRevision.Spec.Enabled != nil && !*projectTemplateRevision.Spec.Enabled {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, mgmtclient.ProjectFieldProjectTemplateRevisionID, "projectTemplateRevision is disabled")
+	}
+
+	projectConfig := projectTemplateRevision.Spec.ProjectConfig
+	if projectConfig == nil {
+		projectConfig = &v32.ProjectSpecBase{}
+	}
+	dataFromTemplate, err := convert.EncodeToMap(projectConfig)
+	if err != nil {
+		return err
+	}
+	for _, field := range []string{quotaField, namespaceQuotaField, mgmtclient.ProjectFieldContainerDefaultResourceLimit} {
+		data[field] = dataFromTemplate[field]
+	}
+	data[mgmtclient.ProjectFieldEnableProjectMonitoring] = projectConfig.EnableProjectMonitoring
+	data[mgmtclient.ProjectFieldProjectTemplateID] = projectTemplateRevision.Spec.ProjectTemplateName
+	return nil
+}
+
 func (s *projectStore) validateResourceQuota(apiContext *types.APIContext, data map[string]interface{}, id string) error {
+	nsQuotaLimit, projectQuotaLimit, err := validateQuotaFields(data)
+	if err != nil || nsQuotaLimit == nil {
+		return err
+	}
+	return s.isQuotaFit(apiContext, nsQuotaLimit, projectQuotaLimit, id)
+}
+
+// ValidateResourceQuota checks the resource quota and namespace default resource quota of a project spec, without
+// taking the namespaces of an existing project into account.
+func ValidateResourceQuota(data map[string]interface{}) error {
+	nsQuotaLimit, projectQuotaLimit, err := validateQuotaFields(data)
+	if err != nil || nsQuotaLimit == nil {
+		return err
+	}
+	return isNamespaceQuotaFit(nsQuotaLimit, projectQuotaLimit)
+}
+
+func validateQuotaFields(data map[string]interface{}) (*v32.ResourceQuotaLimit, *v32.ResourceQuotaLimit, error) {
 	quotaO, quotaOk := data[quotaField]
 	if quotaO == nil {
 		quotaOk = false
@@ -118,56 +197,61 @@ func (s *projectStore) validateResourceQuota(apiContext *types.APIContext, data
 	}
 	if quotaOk != namespaceQuotaOk {
 		if quotaOk {
-			return httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, "")
+			return nil, nil, httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, "")
 		}
-		return httperror.NewFieldAPIError(httperror.MissingRequired, quotaField, "")
+		return nil, nil, httperror.NewFieldAPIError(httperror.MissingRequired, quotaField, "")
 	} else if !quotaOk {
-		return nil
+		return nil, nil, nil
 	}
 
 	var nsQuota mgmtclient.NamespaceResourceQuota
 	if err := convert.ToObj(nsQuotaO, &nsQuota); err != nil {
-		return err
+		return nil, nil, err
 	}
 	var projectQuota mgmtclient.ProjectResourceQuota
 	if err := convert.ToObj(quotaO, &projectQuota); err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	projectQuotaLimit, err := limitToLimit(projectQuota.Limit)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	nsQuotaLimit, err := limitToLimit(nsQuota.Limit)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	if err := resourcequota.ValidateExtendedLimits(projectQuotaLimit); err != nil {
+		return nil, nil, httperror.NewFieldAPIError(httperror.InvalidFormat, quotaField, err.Error())
+	}
+	if err := resourcequota.ValidateExtendedLimits(nsQuotaLimit); err != nil {
+		return nil, nil, httperror.NewFieldAPIError(httperror.InvalidFormat, namespaceQuotaField, err.Error())
 	}
 
 	// limits in namespace default quota should include all limits defined in the project quota
-	projectQuotaLimitMap, err := convert.EncodeToMap(projectQuotaLimit)
+	projectQuotaLimitMap, err := resourcequota.LimitToMap(projectQuotaLimit)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	nsQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	nsQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if len(nsQuotaLimitMap) != len(projectQuotaLimitMap) {
-		return httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, fmt.Sprintf("does not have all fields defined on a %s", quotaField))
+		return nil, nil, httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, fmt.Sprintf("does not have all fields defined on a %s", quotaField))
 	}
 
 	for k := range projectQuotaLimitMap {
 		if _, ok := nsQuotaLimitMap[k]; !ok {
-			return httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, fmt.Sprintf("misses %s defined on a %s", k, quotaField))
+			return nil, nil, httperror.NewFieldAPIError(httperror.MissingRequired, namespaceQuotaField, fmt.Sprintf("misses %s defined on a %s", k, quotaField))
 		}
 	}
-	return s.isQuotaFit(apiContext, nsQuotaLimit, projectQuotaLimit, id)
+	return nsQuotaLimit, projectQuotaLimit, nil
 }
 
-func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v32.ResourceQuotaLimit,
-	projectQuotaLimit *v32.ResourceQuotaLimit, id string) error {
-	// check that namespace default quota is within project quota
+// isNamespaceQuotaFit checks that namespace default quota is within project quota.
+func isNamespaceQuotaFit(nsQuotaLimit *v32.ResourceQuotaLimit, projectQuotaLimit *v32.ResourceQuotaLimit) error {
 	isFit, exceeded, err := resourcequota.IsQuotaFit(nsQuotaLimit, []*v32.ResourceQuotaLimit{}, projectQuotaLimit)
 	if err != nil {
 		return err
@@ -176,6 +260,14 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 		return httperror.NewFieldAPIError(httperror.MaxLimitExceeded, namespaceQuotaField, fmt.Sprintf("exceeds %s on fields: %s",
 			quotaField, format.ResourceList(exceeded)))
 	}
+	return nil
+}
+
+func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v32.ResourceQuotaLimit,
+	projectQuotaLimit *v32.ResourceQuotaLimit, id string) error {
+	if err := isNamespaceQuotaFit(nsQuotaLimit, projectQuotaLimit); err != nil {
+		return err
+	}
 
 	if id == "" {
 		return nil
@@ -188,21 +280,25 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 
 	// check if fields were added or removed
 	// and update project's namespaces accordingly
-	defaultQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	defaultQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
 		return err
 	}
 
-	usedQuotaLimitMap := map[string]interface{}{}
+	usedQuotaLimitMap := map[string]string{}
 	if project.ResourceQuota != nil && project.ResourceQuota.UsedLimit != nil {
-		usedQuotaLimitMap, err = convert.EncodeToMap(project.ResourceQuota.UsedLimit)
+		usedLimit, err := limitToLimit(project.ResourceQuota.UsedLimit)
+		if err != nil {
+			return err
+		}
+		usedQuotaLimitMap, err = resourcequota.LimitToMap(usedLimit)
 		if err != nil {
 			return err
 		}
 	}
 
-	limitToAdd := map[string]interface{}{}
-	limitToRemove := map[string]interface{}{}
+	limitToAdd := map[string]string{}
+	limitToRemove := map[string]string{}
 	for key, value := range defaultQuotaLimitMap {
 		if _, ok := usedQuotaLimitMap[key]; !ok {
 			limitToAdd[key] = value
@@ -220,17 +316,11 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 		delete(usedQuotaLimitMap, key)
 	}
 
-	var usedLimitToCheck mgmtclient.ResourceQuotaLimit
-	err = convert.ToObj(usedQuotaLimitMap, &usedLimitToCheck)
-	if err != nil {
-		return err
-	}
-
-	usedQuotaLimit, err := limitToLimit(&usedLimitToCheck)
+	usedQuotaLimit, err := resourcequota.MapToLimit(usedQuotaLimitMap)
 	if err != nil {
 		return err
 	}
-	isFit, exceeded, err = resourcequota.IsQuotaFit(usedQuotaLimit, []*v32.ResourceQuotaLimit{}, projectQuotaLimit)
+	isFit, exceeded, err := resourcequota.IsQuotaFit(usedQuotaLimit, []*v32.ResourceQuotaLimit{}, projectQuotaLimit)
 	if err != nil {
 		return err
 	}
@@ -244,11 +334,7 @@ func (s *projectStore) isQuotaFit(apiContext *types.APIContext, nsQuotaLimit *v3
 	}
 
 	// check if default quota is enough to set on namespaces
-	toAppend := &mgmtclient.ResourceQuotaLimit{}
-	if err := mapstructure.Decode(limitToAdd, toAppend); err != nil {
-		return err
-	}
-	converted, err := limitToLimit(toAppend)
+	converted, err := resourcequota.MapToLimit(limitToAdd)
 	if err != nil {
 		return err
 	}
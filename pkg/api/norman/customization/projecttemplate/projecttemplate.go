@@ -0,0 +1,86 @@
+package projecttemplate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/rancher/pkg/api/norman/customization/project"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// podSecurityLabelPrefix is the prefix of the pod security admission labels of namespaces.
+const podSecurityLabelPrefix = "pod-security.kubernetes.io/"
+
+type Wrapper struct {
+	RoleTemplateLister v3.RoleTemplateLister
+}
+
+// RevisionValidator checks that a revision can be applied to a project: its quotas are consistent, its members are
+// bound to project roles and its namespaces have valid, unique names. The namespaces are created as the system user,
+// so only users who may update the pod security admission settings of all projects can set them on namespaces.
+func (w Wrapper) RevisionValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	if projectConfig, ok := data[client.ProjectTemplateRevisionFieldProjectConfig]; ok && projectConfig != nil {
+		if err := project.ValidateResourceQuota(convert.ToMapInterface(projectConfig)); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range convert.ToMapSlice(data[client.ProjectTemplateRevisionFieldMembers]) {
+		if err := w.validateMember(member); err != nil {
+			return err
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, ns := range convert.ToMapSlice(data[client.ProjectTemplateRevisionFieldNamespaces]) {
+		name := convert.ToString(ns[client.ProjectTemplateNamespaceFieldName])
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return httperror.NewFieldAPIError(httperror.InvalidFormat, client.ProjectTemplateRevisionFieldNamespaces,
+				fmt.Sprintf("invalid namespace name %s: %s", name, strings.Join(errs, ", ")))
+		}
+		if seen[name] {
+			return httperror.NewFieldAPIError(httperror.NotUnique, client.ProjectTemplateRevisionFieldNamespaces,
+				fmt.Sprintf("namespace %s is defined more than once", name))
+		}
+		seen[name] = true
+
+		for label := range convert.ToMapInterface(ns[client.ProjectTemplateNamespaceFieldLabels]) {
+			if !strings.HasPrefix(label, podSecurityLabelPrefix) {
+				continue
+			}
+			if err := request.AccessControl.CanDo(v3.ProjectGroupVersionKind.Group, v3.ProjectResource.Name, "updatepsa", request, nil, schema); err != nil {
+				return httperror.NewFieldAPIError(httperror.PermissionDenied, client.ProjectTemplateRevisionFieldNamespaces,
+					fmt.Sprintf("not allowed to set label %s on namespace %s", label, name))
+			}
+		}
+	}
+	return nil
+}
+
+func (w Wrapper) validateMember(member map[string]interface{}) error {
+	groupPrincipalID := convert.ToString(member[client.ProjectTemplateMemberFieldGroupPrincipalID])
+	if groupPrincipalID == "" {
+		return httperror.NewFieldAPIError(httperror.MissingRequired, client.ProjectTemplateRevisionFieldMembers, "groupPrincipalId is required")
+	}
+
+	roleTemplateID := convert.ToString(member[client.ProjectTemplateMemberFieldRoleTemplateID])
+	roleTemplate, err := w.RoleTemplateLister.Get("", roleTemplateID)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return httperror.NewFieldAPIError(httperror.InvalidReference, client.ProjectTemplateRevisionFieldMembers,
+				fmt.Sprintf("roleTemplate %s not found", roleTemplateID))
+		}
+		return err
+	}
+	if roleTemplate.Context != "project" {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, client.ProjectTemplateRevisionFieldMembers,
+			fmt.Sprintf("roleTemplate %s is not a project role", roleTemplateID))
+	}
+	return nil
+}
@@ -17,6 +17,7 @@
 	"github.com/rancher/rancher/pkg/api/norman/customization/clusterscan"
 	"github.com/rancher/rancher/pkg/api/norman/customization/clustertemplate"
 	"github.com/rancher/rancher/pkg/api/norman/customization/cred"
+	"github.com/rancher/rancher/pkg/api/norman/customization/elevationrequest"
 	"github.com/rancher/rancher/pkg/api/norman/customization/etcdbackup"
 	"github.com/rancher/rancher/pkg/api/norman/customization/feature"
 	"github.com/rancher/rancher/pkg/api/norman/customization/globaldns"
@@ -34,6 +35,7 @@
 	psptBinding "github.com/rancher/rancher/pkg/api/norman/customization/podsecuritypolicybinding"
 	"github.com/rancher/rancher/pkg/api/norman/customization/podsecuritypolicytemplate"
 	projectaction "github.com/rancher/rancher/pkg/api/norman/customization/project"
+	"github.com/rancher/rancher/pkg/api/norman/customization/projecttemplate"
 	"github.com/rancher/rancher/pkg/api/norman/customization/roletemplate"
 	"github.com/rancher/rancher/pkg/api/norman/customization/roletemplatebinding"
 	"github.com/rancher/rancher/pkg/api/norman/customization/secret"
@@ -88,6 +90,7 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.ClusterRoleTemplateBindingType,
 		client.ClusterType,
 		client.DynamicSchemaType,
+		client.ElevationRequestType,
 		client.EtcdBackupType,
 		client.FeatureType,
 		client.FleetWorkspaceType,
@@ -117,6 +120,8 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 		client.UserType,
 		client.ClusterTemplateType,
 		client.ClusterTemplateRevisionType,
+		client.ProjectTemplateType,
+		client.ProjectTemplateRevisionType,
 	)
 
 	factory.BatchCreateCRDs(ctx, config.ManagementStorageContext, scheme.Scheme, schemas, &managementschema.Version,
@@ -182,9 +187,11 @@ func Setup(ctx context.Context, apiContext *config.ScaledContext, clusterManager
 	PodSecurityPolicyTemplateProjectBinding(schemas, apiContext)
 	GlobalRole(schemas, apiContext)
 	GlobalRoleBindings(schemas, apiContext)
+	ElevationRequests(schemas, apiContext)
 	RoleTemplate(schemas, apiContext)
 	KontainerDriver(schemas, apiContext)
 	ClusterTemplates(schemas, apiContext)
+	ProjectTemplates(schemas, apiContext)
 	SystemImages(schemas, apiContext)
 	EtcdBackups(schemas, apiContext)
 	RancherUserNotifications(schemas, apiContext)
@@ -743,6 +750,14 @@ func GlobalRoleBindings(schemas *types.Schemas, management *config.ScaledContext
 	schema.Validator = globalrolebinding.Validator
 }
 
+func ElevationRequests(schemas *types.Schemas, management *config.ScaledContext) {
+	schema := schemas.Schema(&managementschema.Version, client.ElevationRequestType)
+	handler := elevationrequest.NewHandler(management)
+	schema.Validator = handler.Validator
+	schema.Formatter = handler.Formatter
+	schema.ActionHandler = handler.ActionHandler
+}
+
 func RoleTemplate(schemas *types.Schemas, management *config.ScaledContext) {
 	rt := roletemplate.Wrapper{
 		RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
@@ -883,6 +898,19 @@ func ClusterTemplates(schemas *types.Schemas, management *config.ScaledContext)
 	revisionSchema.ActionHandler = wrapper.ClusterTemplateRevisionsActionHandler
 }
 
+func ProjectTemplates(schemas *types.Schemas, management *config.ScaledContext) {
+	wrapper := projecttemplate.Wrapper{
+		RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+	}
+
+	schema := schemas.Schema(&managementschema.Version, client.ProjectTemplateType)
+	schema.Store = namespacedresource.Wrap(schema.Store, management.Core.Namespaces(""), namespace.GlobalNamespace)
+
+	revisionSchema := schemas.Schema(&managementschema.Version, client.ProjectTemplateRevisionType)
+	revisionSchema.Store = namespacedresource.Wrap(revisionSchema.Store, management.Core.Namespaces(""), namespace.GlobalNamespace)
+	revisionSchema.Validator = wrapper.RevisionValidator
+}
+
 func ClusterScans(schemas *types.Schemas, management *config.ScaledContext, clusterManager *clustermanager.Manager) {
 	clusterScanHandler := clusterscan.Handler{
 		ClusterManager: clusterManager,
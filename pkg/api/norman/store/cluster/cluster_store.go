@@ -31,6 +31,7 @@
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterstatus"
 	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup/target"
 	"github.com/rancher/rancher/pkg/controllers/management/rkeworkerupgrader"
 	"github.com/rancher/rancher/pkg/controllers/management/secretmigrator"
 	"github.com/rancher/rancher/pkg/controllers/management/secretmigrator/assemblers"
@@ -304,6 +305,9 @@ func (r *Store) Create(apiContext *types.APIContext, schema *types.Schema, data
 	if err = validateS3Credentials(data, nil); err != nil {
 		return nil, err
 	}
+	if err = r.validateEtcdBackupTarget(apiContext, nil, data); err != nil {
+		return nil, err
+	}
 	if err = validateKeyRotation(data); err != nil {
 		return nil, err
 	}
@@ -785,6 +789,9 @@ func (r *Store) Update(apiContext *types.APIContext, schema *types.Schema, data
 	if err := validateUpdatedS3Credentials(existingCluster, data, dialer); err != nil {
 		return nil, err
 	}
+	if err := r.validateEtcdBackupTarget(apiContext, existingCluster, data); err != nil {
+		return nil, err
+	}
 	if err := validateKeyRotation(data); err != nil {
 		return nil, err
 	}
@@ -1489,6 +1496,66 @@ func validateS3Credentials(data map[string]interface{}, dialer dialer.Dialer) er
 	return nil
 }
 
+// validateEtcdBackupTarget validates a new or changed etcd backup target. The user has to have access to the cloud
+// credential of the target, and the backend of the target has to be listable with it.
+func (r *Store) validateEtcdBackupTarget(apiContext *types.APIContext, oldData, newData map[string]interface{}) error {
+	newTarget := values.GetValueN(newData, managementv3.ClusterSpecFieldEtcdBackupTarget)
+	if newTarget == nil {
+		return nil
+	}
+	backupTarget := &apimgmtv3.EtcdBackupTarget{}
+	if err := convert.ToObj(newTarget, backupTarget); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, managementv3.ClusterSpecFieldEtcdBackupTarget, err.Error())
+	}
+	if oldTarget := values.GetValueN(oldData, managementv3.ClusterSpecFieldEtcdBackupTarget); oldTarget != nil {
+		existingTarget := &apimgmtv3.EtcdBackupTarget{}
+		if err := convert.ToObj(oldTarget, existingTarget); err == nil && reflect.DeepEqual(existingTarget, backupTarget) {
+			return nil
+		}
+	}
+
+	if values.GetValueN(newData, "rancherKubernetesEngineConfig", "services", "etcd", "backupConfig", "s3BackupConfig") != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, managementv3.ClusterSpecFieldEtcdBackupTarget,
+			"an etcd backup target can't be combined with an S3 backup config")
+	}
+	if err := target.Validate(backupTarget); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, managementv3.ClusterSpecFieldEtcdBackupTarget, err.Error())
+	}
+
+	var credentialName string
+	switch {
+	case backupTarget.AzureBlob != nil:
+		credentialName = backupTarget.AzureBlob.CloudCredentialName
+	case backupTarget.S3 != nil:
+		credentialName = backupTarget.S3.CloudCredentialName
+	default:
+		credentialName = backupTarget.GCS.CloudCredentialName
+	}
+	if !strings.Contains(credentialName, ":") {
+		credentialName = namespace.GlobalNamespace + ":" + credentialName
+	}
+	var credential map[string]interface{}
+	if err := access.ByID(apiContext, &managementschema.Version, managementv3.CloudCredentialType, credentialName, &credential); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidReference, managementv3.ClusterSpecFieldEtcdBackupTarget,
+			fmt.Sprintf("cloud credential [%s] not found", credentialName))
+	}
+
+	if backupTarget.Encryption != nil {
+		if _, err := target.EncryptionKeys(r.SecretLister, backupTarget.Encryption.KeySecretName); err != nil {
+			return httperror.NewFieldAPIError(httperror.InvalidReference, managementv3.ClusterSpecFieldEtcdBackupTarget, err.Error())
+		}
+	}
+
+	backend, err := target.New(apiContext.Request.Context(), backupTarget, r.SecretLister)
+	if err != nil {
+		return fmt.Errorf("Unable to validate etcd backup target configuration: %v", err)
+	}
+	if _, err := backend.List(apiContext.Request.Context()); err != nil {
+		return fmt.Errorf("Unable to validate etcd backup target configuration: %v", err)
+	}
+	return nil
+}
+
 func cleanPrivateRegistry(data map[string]interface{}) {
 	registries, ok := values.GetSlice(data, "rancherKubernetesEngineConfig", "privateRegistries")
 	if !ok || registries == nil {
@@ -105,14 +105,17 @@ func (p *Store) validateResourceQuota(apiContext *types.APIContext, schema *type
 	if err != nil {
 		return err
 	}
+	if err := resourcequota.ValidateExtendedLimits(nsQuotaLimit); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidFormat, quotaField, err.Error())
+	}
 
 	// limits in namespace should include all limits defined on a project
-	projectQuotaLimitMap, err := convert.EncodeToMap(projectQuotaLimit)
+	projectQuotaLimitMap, err := resourcequota.LimitToMap(projectQuotaLimit)
 	if err != nil {
 		return err
 	}
 
-	nsQuotaLimitMap, err := convert.EncodeToMap(nsQuotaLimit)
+	nsQuotaLimitMap, err := resourcequota.LimitToMap(nsQuotaLimit)
 	if err != nil {
 		return err
 	}
@@ -34,6 +34,7 @@ func Register(ctx context.Context, server *steve.Server,
 
 func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartUninstallAction{}, nil)
+	server.BaseSchemas.MustImportAndCustomize(types2.ChartRollbackAction{}, nil)
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartUpgradeAction{}, nil)
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartUpgrade{}, nil)
 	server.BaseSchemas.MustImportAndCustomize(types2.ChartInstallAction{}, nil)
@@ -46,11 +47,15 @@ func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 		Customize: func(apiSchema *types.APISchema) {
 			apiSchema.LinkHandlers = map[string]http.Handler{
 				"logs": ops,
+				"diff": ops,
 			}
 			apiSchema.Formatter = func(request *types.APIRequest, resource *types.RawResource) {
 				if !resource.APIObject.Data().Bool("status", "podCreated") {
 					delete(resource.Links, "logs")
 				}
+				if !resource.APIObject.Data().Bool("status", "dryRun") {
+					delete(resource.Links, "diff")
+				}
 			}
 		},
 	}
@@ -60,12 +65,20 @@ func addSchemas(server *steve.Server, ops *operation, index http.Handler) {
 		Customize: func(apiSchema *types.APISchema) {
 			apiSchema.ActionHandlers = map[string]http.Handler{
 				"uninstall": ops,
+				"rollback":  ops,
 			}
 			apiSchema.ResourceActions = map[string]schemas3.Action{
 				"uninstall": {
 					Input:  "chartUninstallAction",
 					Output: "chartActionOutput",
 				},
+				"rollback": {
+					Input:  "chartRollbackAction",
+					Output: "chartActionOutput",
+				},
+			}
+			apiSchema.LinkHandlers = map[string]http.Handler{
+				"history": ops,
 			}
 		},
 	}
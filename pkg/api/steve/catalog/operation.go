@@ -1,6 +1,7 @@
 package catalog
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/rancher/apiserver/pkg/types"
@@ -53,12 +54,28 @@ func (o *operation) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		op, err = o.ops.Upgrade(apiRequest.Context(), user, ns, name, req.Body, o.imageOverride)
 	case "uninstall":
 		op, err = o.ops.Uninstall(apiRequest.Context(), user, ns, name, req.Body, o.imageOverride)
+	case "rollback":
+		op, err = o.ops.Rollback(apiRequest.Context(), user, ns, name, req.Body, o.imageOverride)
 	}
 
 	switch apiRequest.Link {
 	case "logs":
 		err = o.ops.Log(apiRequest.Response, apiRequest.Request,
 			apiRequest.Namespace, apiRequest.Name)
+	case "diff":
+		var diffs []catalogtypes.ChartDiff
+		diffs, err = o.ops.Diff(apiRequest.Context(), apiRequest.Namespace, apiRequest.Name)
+		if err == nil {
+			rw.Header().Set("Content-Type", "application/json")
+			err = json.NewEncoder(rw).Encode(diffs)
+		}
+	case "history":
+		var revisions []catalogtypes.ChartRevision
+		revisions, err = o.ops.History(apiRequest.Context(), apiRequest.Namespace, apiRequest.Name)
+		if err == nil {
+			rw.Header().Set("Content-Type", "application/json")
+			err = json.NewEncoder(rw).Encode(revisions)
+		}
 	}
 
 	if err != nil {
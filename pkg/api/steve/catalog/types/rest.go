@@ -19,6 +19,7 @@ type ChartInstallAction struct {
 	Wait                     bool             `json:"wait,omitempty"`
 	DisableHooks             bool             `json:"noHooks,omitempty"`
 	DisableOpenAPIValidation bool             `json:"disableOpenAPIValidation,omitempty"`
+	DryRun                   bool             `json:"dryRun,omitempty"`
 	Namespace                string           `json:"namespace,omitempty"`
 	ProjectID                string           `json:"projectId,omitempty"`
 
@@ -49,6 +50,7 @@ type ChartUpgradeAction struct {
 	Force                    bool             `json:"force,omitempty"`
 	ForceAdopt               bool             `json:"forceAdopt,omitempty"`
 	MaxHistory               int              `json:"historyMax,omitempty"`
+	DryRun                   bool             `json:"dryRun,omitempty"`
 	Install                  bool             `json:"install,omitempty"`
 	Namespace                string           `json:"namespace,omitempty"`
 	CleanupOnFail            bool             `json:"cleanupOnFail,omitempty"`
@@ -66,6 +68,50 @@ type ChartUpgrade struct {
 	Annotations map[string]string     `json:"annotations,omitempty"`
 }
 
+type ChartRollbackAction struct {
+	Revision      int              `json:"revision,omitempty"`
+	Timeout       *metav1.Duration `json:"timeout,omitempty"`
+	Wait          bool             `json:"wait,omitempty"`
+	DisableHooks  bool             `json:"noHooks,omitempty"`
+	Force         bool             `json:"force,omitempty"`
+	CleanupOnFail bool             `json:"cleanupOnFail,omitempty"`
+	MaxHistory    int              `json:"historyMax,omitempty"`
+}
+
+type ChartRevision struct {
+	Revision     int                   `json:"revision,omitempty"`
+	Status       string                `json:"status,omitempty"`
+	ChartName    string                `json:"chartName,omitempty"`
+	ChartVersion string                `json:"chartVersion,omitempty"`
+	AppVersion   string                `json:"appVersion,omitempty"`
+	Description  string                `json:"description,omitempty"`
+	Updated      *metav1.Time          `json:"updated,omitempty"`
+	Values       v3.MapStringInterface `json:"values,omitempty"`
+}
+
+type ChartDiff struct {
+	ReleaseName string         `json:"releaseName,omitempty"`
+	Namespace   string         `json:"namespace,omitempty"`
+	Resources   []ResourceDiff `json:"resources,omitempty"`
+	Values      []ValueDiff    `json:"values,omitempty"`
+}
+
+type ResourceDiff struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Change     string `json:"change,omitempty"`
+	Diff       string `json:"diff,omitempty"`
+}
+
+type ValueDiff struct {
+	Path   string      `json:"path,omitempty"`
+	Change string      `json:"change,omitempty"`
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+}
+
 type ChartActionOutput struct {
 	OperationName      string `json:"operationName,omitempty"`
 	OperationNamespace string `json:"operationNamespace,omitempty"`
@@ -0,0 +1,530 @@
+package supportconfigs
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rancher/rancher/pkg/api/steve/proxy"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/auth/util"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterconnected"
+	capicontrollers "github.com/rancher/rancher/pkg/generated/controllers/cluster.x-k8s.io/v1beta1"
+	mgmtcontrollers "github.com/rancher/rancher/pkg/generated/controllers/management.cattle.io/v3"
+	provisioningcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	namespaces "github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"github.com/rancher/remotedialer"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	bundleContentType = "application/gzip"
+	redacted          = "[redacted]"
+
+	// defaultBundleMaxSizeMB caps the uncompressed size of a bundle, files that don't fit are left out and listed in bundle.json
+	defaultBundleMaxSizeMB = 200
+	defaultBundleLogLines  = 5000
+	maxLogBytes            = int64(50 << 20)
+)
+
+// sensitiveSettingWords are parts of setting names whose values are redacted from bundles.
+var sensitiveSettingWords = []string{"password", "secret", "token", "key", "credential"}
+
+// bundleOptions are the query parameters of a full support bundle: full=true enables it, cluster (repeated or comma
+// separated) limits it to some management clusters, maxSizeMB caps its size and logLines sets how much of the log
+// of each Rancher pod to include.
+type bundleOptions struct {
+	clusters map[string]bool
+	maxSize  int64
+	logLines int64
+}
+
+func parseBundleOptions(query url.Values) (bundleOptions, error) {
+	opts := bundleOptions{
+		clusters: map[string]bool{},
+		maxSize:  defaultBundleMaxSizeMB << 20,
+		logLines: defaultBundleLogLines,
+	}
+	for _, value := range query["cluster"] {
+		for _, cluster := range strings.Split(value, ",") {
+			if cluster = strings.TrimSpace(cluster); cluster != "" {
+				opts.clusters[cluster] = true
+			}
+		}
+	}
+	if value := query.Get("maxSizeMB"); value != "" {
+		maxSize, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || maxSize <= 0 || maxSize > defaultBundleMaxSizeMB {
+			return opts, fmt.Errorf("maxSizeMB must be a number between 1 and %d", defaultBundleMaxSizeMB)
+		}
+		opts.maxSize = maxSize << 20
+	}
+	if value := query.Get("logLines"); value != "" {
+		logLines, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || logLines < 0 {
+			return opts, fmt.Errorf("logLines must not be negative")
+		}
+		opts.logLines = logLines
+	}
+	return opts, nil
+}
+
+// includesCluster returns true if the bundle covers the management cluster.
+func (o bundleOptions) includesCluster(name string) bool {
+	return len(o.clusters) == 0 || o.clusters[name]
+}
+
+// bundleWriter writes files to a tar archive until its size cap is reached and keeps track of what was written.
+type bundleWriter struct {
+	tw        *tar.Writer
+	remaining int64
+	manifest  bundleManifest
+}
+
+type bundleManifest struct {
+	GeneratedAt string   `json:"generatedAt"`
+	Clusters    []string `json:"clusters,omitempty"`
+	Files       []string `json:"files"`
+	Skipped     []string `json:"skipped,omitempty"`
+	Errors      []string `json:"errors,omitempty"`
+}
+
+func newBundleWriter(tw *tar.Writer, opts bundleOptions) *bundleWriter {
+	b := &bundleWriter{
+		tw:        tw,
+		remaining: opts.maxSize,
+		manifest: bundleManifest{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	for cluster := range opts.clusters {
+		b.manifest.Clusters = append(b.manifest.Clusters, cluster)
+	}
+	sort.Strings(b.manifest.Clusters)
+	return b
+}
+
+func (b *bundleWriter) add(name string, data []byte) error {
+	if int64(len(data)) > b.remaining {
+		b.manifest.Skipped = append(b.manifest.Skipped, name)
+		return nil
+	}
+	if err := b.write(name, data); err != nil {
+		return err
+	}
+	b.remaining -= int64(len(data))
+	b.manifest.Files = append(b.manifest.Files, name)
+	return nil
+}
+
+func (b *bundleWriter) addJSON(name string, obj interface{}) error {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	return b.add(name, data)
+}
+
+func (b *bundleWriter) write(name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := b.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := b.tw.Write(data)
+	return err
+}
+
+// close writes bundle.json, which isn't counted against the size cap, and closes the archive.
+func (b *bundleWriter) close() error {
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := b.write("bundle.json", data); err != nil {
+		return err
+	}
+	return b.tw.Close()
+}
+
+// bundleCollector gathers the diagnostics of a full support bundle. Objects are read from the API instead of caches,
+// as not all of them are cached by every Rancher.
+type bundleCollector struct {
+	settings      mgmtcontrollers.SettingClient
+	features      mgmtcontrollers.FeatureClient
+	clusters      mgmtcontrollers.ClusterClient
+	provClusters  provisioningcontrollers.ClusterClient
+	controlPlanes rkecontrollers.RKEControlPlaneClient
+	machines      capicontrollers.MachineClient
+	k8s           kubernetes.Interface
+	tunnelServer  *remotedialer.Server
+	gatherer      prometheus.Gatherer
+}
+
+func newBundleCollector(wrangler *wrangler.Context) *bundleCollector {
+	return &bundleCollector{
+		settings:      wrangler.Mgmt.Setting(),
+		features:      wrangler.Mgmt.Feature(),
+		clusters:      wrangler.Mgmt.Cluster(),
+		provClusters:  wrangler.Provisioning.Cluster(),
+		controlPlanes: wrangler.RKE.RKEControlPlane(),
+		machines:      wrangler.CAPI.Machine(),
+		k8s:           wrangler.K8s,
+		tunnelServer:  wrangler.TunnelServer,
+		gatherer:      prometheus.DefaultGatherer,
+	}
+}
+
+// serveBundle streams a gzipped tar of the full support bundle. Failures to collect a part of the bundle are listed
+// in bundle.json instead of failing the whole bundle.
+func (h *Handler) serveBundle(writer http.ResponseWriter, request *http.Request) {
+	opts, err := parseBundleOptions(request.URL.Query())
+	if err != nil {
+		util.ReturnHTTPError(writer, request, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	logrus.Infof("[%s] Generating support bundle", logPrefix)
+	writer.Header().Set("Content-Type", bundleContentType)
+	writer.Header().Set("Content-Disposition", "attachment; filename=\"supportbundle_rancher.tar.gz\"")
+
+	gz := gzip.NewWriter(writer)
+	bundle := newBundleWriter(tar.NewWriter(gz), opts)
+
+	if cspConfig, err := h.getCSPConfig(); err == nil {
+		bundle.record("csp config", bundle.addJSON("rancher/config.json", cspConfig))
+	} else if err != errNotFound {
+		bundle.record("csp config", err)
+	}
+	h.bundle.collect(request.Context(), bundle, opts)
+
+	if err := bundle.close(); err != nil {
+		logrus.Warnf("[%s] Failed to write support bundle: %v", logPrefix, err)
+		return
+	}
+	if err := gz.Close(); err != nil {
+		logrus.Warnf("[%s] Failed to write support bundle: %v", logPrefix, err)
+		return
+	}
+	logrus.Infof("[%s] Done generating support bundle", logPrefix)
+}
+
+func (b *bundleWriter) record(part string, err error) {
+	if err != nil {
+		b.manifest.Errors = append(b.manifest.Errors, fmt.Sprintf("%s: %v", part, err))
+	}
+}
+
+func (c *bundleCollector) collect(ctx context.Context, bundle *bundleWriter, opts bundleOptions) {
+	bundle.record("settings", c.collectSettings(bundle))
+	bundle.record("features", c.collectFeatures(bundle))
+
+	clusters, err := c.clusters.List(metav1.ListOptions{})
+	if err != nil {
+		bundle.record("clusters", err)
+	} else {
+		bundle.record("clusters", c.collectClusters(bundle, clusters.Items, opts))
+		bundle.record("agent connections", c.collectConnections(bundle, clusters.Items, opts))
+	}
+
+	provisioned, err := c.collectProvisioningClusters(bundle, opts)
+	bundle.record("provisioning clusters", err)
+	bundle.record("rke control planes", c.collectControlPlanes(bundle, provisioned))
+	bundle.record("machines", c.collectMachines(bundle, provisioned))
+
+	bundle.record("queues", c.collectQueues(bundle))
+	bundle.record("logs", c.collectLogs(ctx, bundle, opts))
+}
+
+func (c *bundleCollector) collectSettings(bundle *bundleWriter) error {
+	settings, err := c.settings.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	type setting struct {
+		Name       string `json:"name"`
+		Value      string `json:"value"`
+		Default    string `json:"default"`
+		Customized bool   `json:"customized"`
+		Source     string `json:"source,omitempty"`
+	}
+	var result []setting
+	for _, s := range settings.Items {
+		result = append(result, setting{
+			Name:       s.Name,
+			Value:      redactSetting(s.Name, s.Value),
+			Default:    redactSetting(s.Name, s.Default),
+			Customized: s.Customized,
+			Source:     s.Source,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return bundle.addJSON("rancher/settings.json", result)
+}
+
+func redactSetting(name, value string) string {
+	if value == "" {
+		return value
+	}
+	for _, word := range sensitiveSettingWords {
+		if strings.Contains(strings.ToLower(name), word) {
+			return redacted
+		}
+	}
+	return value
+}
+
+func (c *bundleCollector) collectFeatures(bundle *bundleWriter) error {
+	features, err := c.features.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	type feature struct {
+		Name        string `json:"name"`
+		Value       *bool  `json:"value"`
+		Default     bool   `json:"default"`
+		LockedValue *bool  `json:"lockedValue,omitempty"`
+		Dynamic     bool   `json:"dynamic"`
+	}
+	var result []feature
+	for _, f := range features.Items {
+		result = append(result, feature{
+			Name:        f.Name,
+			Value:       f.Spec.Value,
+			Default:     f.Status.Default,
+			LockedValue: f.Status.LockedValue,
+			Dynamic:     f.Status.Dynamic,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return bundle.addJSON("rancher/features.json", result)
+}
+
+// collectClusters writes the status of management clusters, leaving out the fields that hold credentials.
+func (c *bundleCollector) collectClusters(bundle *bundleWriter, clusters []v3.Cluster, opts bundleOptions) error {
+	for _, cluster := range clusters {
+		if !opts.includesCluster(cluster.Name) {
+			continue
+		}
+		status := map[string]interface{}{
+			"name":          cluster.Name,
+			"displayName":   cluster.Spec.DisplayName,
+			"driver":        cluster.Status.Driver,
+			"provider":      cluster.Status.Provider,
+			"agentImage":    cluster.Status.AgentImage,
+			"agentFeatures": cluster.Status.AgentFeatures,
+			"version":       cluster.Status.Version,
+			"nodeCount":     cluster.Status.NodeCount,
+			"capacity":      cluster.Status.Capacity,
+			"allocatable":   cluster.Status.Allocatable,
+			"requested":     cluster.Status.Requested,
+			"conditions":    cluster.Status.Conditions,
+		}
+		if err := bundle.addJSON("clusters/management/"+cluster.Name+".json", status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectConnections writes the agent connection state of every downstream cluster, both as seen by the cluster
+// conditions and by the tunnel server of this Rancher.
+func (c *bundleCollector) collectConnections(bundle *bundleWriter, clusters []v3.Cluster, opts bundleOptions) error {
+	type connection struct {
+		Cluster       string `json:"cluster"`
+		DisplayName   string `json:"displayName,omitempty"`
+		Connected     string `json:"connected,omitempty"`
+		Ready         string `json:"ready,omitempty"`
+		ReadyMessage  string `json:"readyMessage,omitempty"`
+		AgentDeployed string `json:"agentDeployed,omitempty"`
+		LocalSession  bool   `json:"localSession"`
+	}
+	var result []connection
+	for i := range clusters {
+		cluster := &clusters[i]
+		if !opts.includesCluster(cluster.Name) || cluster.Name == "local" {
+			continue
+		}
+		result = append(result, connection{
+			Cluster:       cluster.Name,
+			DisplayName:   cluster.Spec.DisplayName,
+			Connected:     clusterconnected.Connected.GetStatus(cluster),
+			Ready:         v3.ClusterConditionReady.GetStatus(cluster),
+			ReadyMessage:  v3.ClusterConditionReady.GetMessage(cluster),
+			AgentDeployed: v3.ClusterConditionAgentDeployed.GetStatus(cluster),
+			LocalSession:  c.tunnelServer != nil && c.tunnelServer.HasSession(proxy.Prefix+cluster.Name),
+		})
+	}
+	return bundle.addJSON("clusters/connections.json", result)
+}
+
+// collectProvisioningClusters writes the status of provisioning clusters and returns the namespace/name of the
+// ones included in the bundle.
+func (c *bundleCollector) collectProvisioningClusters(bundle *bundleWriter, opts bundleOptions) (map[string]bool, error) {
+	clusters, err := c.provClusters.List("", metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	included := map[string]bool{}
+	for _, cluster := range clusters.Items {
+		if !opts.includesCluster(cluster.Status.ClusterName) {
+			continue
+		}
+		key := cluster.Namespace + "/" + cluster.Name
+		included[key] = true
+		if err := bundle.addJSON("clusters/provisioning/"+key+".json", map[string]interface{}{
+			"name":              cluster.Name,
+			"namespace":         cluster.Namespace,
+			"kubernetesVersion": cluster.Spec.KubernetesVersion,
+			"status":            cluster.Status,
+		}); err != nil {
+			return included, err
+		}
+	}
+	return included, nil
+}
+
+// collectControlPlanes writes the status of the RKE control planes of the included provisioning clusters, which
+// share their namespace and name. The applied spec is left out as it can hold credentials.
+func (c *bundleCollector) collectControlPlanes(bundle *bundleWriter, provisioned map[string]bool) error {
+	controlPlanes, err := c.controlPlanes.List("", metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, controlPlane := range controlPlanes.Items {
+		key := controlPlane.Namespace + "/" + controlPlane.Name
+		if !provisioned[key] {
+			continue
+		}
+		status := controlPlane.Status.DeepCopy()
+		status.AppliedSpec = nil
+		if err := bundle.addJSON("rkecontrolplanes/"+key+".json", struct {
+			Name      string                       `json:"name"`
+			Namespace string                       `json:"namespace"`
+			Status    *rkev1.RKEControlPlaneStatus `json:"status"`
+		}{controlPlane.Name, controlPlane.Namespace, status}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectMachines writes the status of the CAPI machines of the included provisioning clusters.
+func (c *bundleCollector) collectMachines(bundle *bundleWriter, provisioned map[string]bool) error {
+	machines, err := c.machines.List("", metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, machine := range machines.Items {
+		if !provisioned[machine.Namespace+"/"+machine.Labels[capi.ClusterLabelName]] {
+			continue
+		}
+		if err := bundle.addJSON("machines/"+machine.Namespace+"/"+machine.Name+".json", struct {
+			Name      string             `json:"name"`
+			Namespace string             `json:"namespace"`
+			Cluster   string             `json:"cluster"`
+			Status    capi.MachineStatus `json:"status"`
+		}{machine.Name, machine.Namespace, machine.Labels[capi.ClusterLabelName], machine.Status}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectQueues writes the depth of the controller queues of this Rancher, which are only tracked if Prometheus
+// metrics are enabled.
+func (c *bundleCollector) collectQueues(bundle *bundleWriter) error {
+	families, err := c.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+
+	type queue struct {
+		Name  string  `json:"name"`
+		Depth float64 `json:"depth"`
+	}
+	result := struct {
+		Note   string  `json:"note,omitempty"`
+		Queues []queue `json:"queues"`
+	}{}
+	for _, family := range families {
+		if family.GetName() != "workqueue_depth" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			q := queue{Depth: metric.GetGauge().GetValue()}
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" {
+					q.Name = label.GetValue()
+				}
+			}
+			result.Queues = append(result.Queues, q)
+		}
+	}
+	if len(result.Queues) == 0 {
+		result.Note = "queue depths are only tracked when CATTLE_PROMETHEUS_METRICS is true"
+	}
+	sort.Slice(result.Queues, func(i, j int) bool {
+		if result.Queues[i].Depth != result.Queues[j].Depth {
+			return result.Queues[i].Depth > result.Queues[j].Depth
+		}
+		return result.Queues[i].Name < result.Queues[j].Name
+	})
+	return bundle.addJSON("rancher/queues.json", result)
+}
+
+// collectLogs writes the recent logs of the Rancher pods.
+func (c *bundleCollector) collectLogs(ctx context.Context, bundle *bundleWriter, opts bundleOptions) error {
+	pods, err := c.k8s.CoreV1().Pods(namespaces.System).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{"app": "rancher"}.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	limitBytes := maxLogBytes
+	for _, pod := range pods.Items {
+		logOptions := &corev1.PodLogOptions{
+			Container:  "rancher",
+			LimitBytes: &limitBytes,
+		}
+		if opts.logLines > 0 {
+			logOptions.TailLines = &opts.logLines
+		}
+		logs, err := c.k8s.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).DoRaw(ctx)
+		if err != nil {
+			bundle.record("logs of "+pod.Name, err)
+			continue
+		}
+		if err := bundle.add("logs/"+pod.Name+".log", logs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
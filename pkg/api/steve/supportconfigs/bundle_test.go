@@ -0,0 +1,107 @@
+package supportconfigs
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBundleOptions(t *testing.T) {
+	opts, err := parseBundleOptions(url.Values{})
+	require.NoError(t, err)
+	assert.Empty(t, opts.clusters)
+	assert.Equal(t, int64(defaultBundleMaxSizeMB<<20), opts.maxSize)
+	assert.Equal(t, int64(defaultBundleLogLines), opts.logLines)
+	assert.True(t, opts.includesCluster("c-abcde"))
+
+	opts, err = parseBundleOptions(url.Values{"cluster": {"c-abcde,local", "c-fghij"}, "maxSizeMB": {"10"}, "logLines": {"100"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"c-abcde": true, "local": true, "c-fghij": true}, opts.clusters)
+	assert.Equal(t, int64(10<<20), opts.maxSize)
+	assert.Equal(t, int64(100), opts.logLines)
+	assert.True(t, opts.includesCluster("local"))
+	assert.False(t, opts.includesCluster("c-klmno"))
+
+	_, err = parseBundleOptions(url.Values{"maxSizeMB": {"100000"}})
+	assert.Error(t, err)
+	_, err = parseBundleOptions(url.Values{"logLines": {"-1"}})
+	assert.Error(t, err)
+}
+
+func TestRedactSetting(t *testing.T) {
+	assert.Equal(t, "v2.7.0", redactSetting("server-version", "v2.7.0"))
+	assert.Equal(t, redacted, redactSetting("ui-secret-value", "hunter2"))
+	assert.Equal(t, redacted, redactSetting("api-token-Key", "abc"))
+	assert.Equal(t, "", redactSetting("password-min-length", ""))
+}
+
+func readBundle(t *testing.T, data []byte) map[string][]byte {
+	files := map[string][]byte{}
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = content
+	}
+}
+
+func TestBundleWriter(t *testing.T) {
+	var buf bytes.Buffer
+	bundle := newBundleWriter(tar.NewWriter(&buf), bundleOptions{maxSize: 10, clusters: map[string]bool{"local": true}})
+	require.NoError(t, bundle.add("a.txt", []byte("12345")))
+	require.NoError(t, bundle.add("too-large.txt", []byte("123456")))
+	require.NoError(t, bundle.add("b.txt", []byte("12345")))
+	bundle.record("logs", assert.AnError)
+	require.NoError(t, bundle.close())
+
+	files := readBundle(t, buf.Bytes())
+	assert.Equal(t, []byte("12345"), files["a.txt"])
+	assert.Equal(t, []byte("12345"), files["b.txt"])
+	assert.NotContains(t, files, "too-large.txt")
+
+	manifest := bundleManifest{}
+	require.NoError(t, json.Unmarshal(files["bundle.json"], &manifest))
+	assert.Equal(t, []string{"local"}, manifest.Clusters)
+	assert.Equal(t, []string{"a.txt", "b.txt"}, manifest.Files)
+	assert.Equal(t, []string{"too-large.txt"}, manifest.Skipped)
+	assert.Equal(t, []string{"logs: " + assert.AnError.Error()}, manifest.Errors)
+}
+
+func TestCollectQueues(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Subsystem: "workqueue", Name: "depth"}, []string{"name"})
+	registry.MustRegister(depth)
+	depth.WithLabelValues("clusters").Set(3)
+	depth.WithLabelValues("settings").Set(10)
+
+	var buf bytes.Buffer
+	bundle := newBundleWriter(tar.NewWriter(&buf), bundleOptions{maxSize: 1 << 20})
+	c := &bundleCollector{gatherer: registry}
+	require.NoError(t, c.collectQueues(bundle))
+	require.NoError(t, bundle.close())
+
+	queues := struct {
+		Note   string
+		Queues []struct {
+			Name  string
+			Depth float64
+		}
+	}{}
+	require.NoError(t, json.Unmarshal(readBundle(t, buf.Bytes())["rancher/queues.json"], &queues))
+	assert.Empty(t, queues.Note)
+	require.Len(t, queues.Queues, 2)
+	assert.Equal(t, "settings", queues.Queues[0].Name)
+	assert.Equal(t, float64(10), queues.Queues[0].Depth)
+}
@@ -1,4 +1,5 @@
-// Package supportconfigs provides a HTTPHandler to serve supportconfigs. This handler should be registered at Endpoint
+// Package supportconfigs provides a HTTPHandler to serve supportconfigs. This handler should be registered at Endpoint.
+// With full=true the handler serves a diagnostic bundle of the state of Rancher and its clusters instead.
 package supportconfigs
 
 import (
@@ -9,6 +10,7 @@
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/rancher/rancher/pkg/auth/util"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
@@ -37,6 +39,7 @@ type Handler struct {
 	ConfigMaps           v1.ConfigMapInterface
 	SubjectAccessReviews authv1.SubjectAccessReviewInterface
 	adapterUtil          *cspadapter.ChartUtil
+	bundle               *bundleCollector
 }
 
 // NewHandler creates a handler using the clients defined in scaledContext
@@ -45,6 +48,7 @@ func NewHandler(scaledContext *config.ScaledContext) Handler {
 		ConfigMaps:           scaledContext.Core.ConfigMaps(cspadapter.ChartNamespace),
 		SubjectAccessReviews: scaledContext.K8sClient.AuthorizationV1().SubjectAccessReviews(),
 		adapterUtil:          cspadapter.NewChartUtil(scaledContext.Wrangler.RESTClientGetter),
+		bundle:               newBundleCollector(scaledContext.Wrangler),
 	}
 }
 
@@ -61,6 +65,11 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		util.ReturnHTTPError(writer, request, http.StatusForbidden, http.StatusText(http.StatusForbidden))
 		return
 	}
+	if strings.EqualFold(request.URL.Query().Get("full"), "true") {
+		// the full bundle doesn't depend on the csp adapter, its config is included if it exists
+		h.serveBundle(writer, request)
+		return
+	}
 	_, err = h.adapterUtil.GetRelease()
 	if err != nil {
 		if errors.Is(err, cspadapter.ErrNotFound) {
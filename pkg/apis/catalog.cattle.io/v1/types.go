@@ -23,7 +23,7 @@ type SecretReference struct {
 }
 
 type RepoSpec struct {
-	// URL A http URL of the repo to connect to
+	// URL A http URL of the repo to connect to, or an oci:// URL of a chart or a path in an OCI registry
 	URL string `json:"url,omitempty"`
 
 	// GitRepo a git repo to clone and index as the helm repo
@@ -40,7 +40,8 @@ type RepoSpec struct {
 	InsecureSkipTLSverify bool `json:"insecureSkipTLSVerify,omitempty"`
 
 	// ClientSecretName is the client secret to be used to connect to the repo
-	// It is expected the secret be of type "kubernetes.io/basic-auth" or "kubernetes.io/tls" for Helm repos
+	// It is expected the secret be of type "kubernetes.io/basic-auth" or "kubernetes.io/tls" for Helm repos,
+	// "kubernetes.io/basic-auth", "kubernetes.io/dockerconfigjson" or "kubernetes.io/tls" for OCI repos
 	// and "kubernetes.io/basic-auth" or "kubernetes.io/ssh-auth" for git repos.
 	// For a repo the Namespace file will be ignored
 	ClientSecret *SecretReference `json:"clientSecret,omitempty"`
@@ -118,5 +119,6 @@ type OperationStatus struct {
 	PodName            string                              `json:"podName,omitempty"`
 	PodNamespace       string                              `json:"podNamespace,omitempty"`
 	PodCreated         bool                                `json:"podCreated,omitempty"`
+	DryRun             bool                                `json:"dryRun,omitempty"`
 	Conditions         []genericcondition.GenericCondition `json:"conditions,omitempty"`
 }
@@ -4,10 +4,15 @@
 	"github.com/rancher/norman/condition"
 	"github.com/rancher/norman/types"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const UserConditionInitialRolesPopulated condition.Cond = "InitialRolesPopulated"
+const (
+	UserConditionInitialRolesPopulated condition.Cond = "InitialRolesPopulated"
+	// UserConditionLocked is true while a local user is locked out after too many failed logins.
+	UserConditionLocked condition.Cond = "Locked"
+)
 
 // +genclient
 // +genclient:nonNamespaced
@@ -32,6 +37,17 @@ type Token struct {
 	Current         bool              `json:"current"`
 	ClusterName     string            `json:"clusterName,omitempty" norman:"noupdate,type=reference[cluster]"`
 	Enabled         *bool             `json:"enabled,omitempty" norman:"default=true"`
+	// Rules limit the token to the requests they allow, on top of the permissions of the user.
+	// Tokens without rules carry all permissions of the user.
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty" norman:"noupdate"`
+	// AllowedClusters and AllowedProjects limit the token to requests for these clusters and projects.
+	// Tokens that list neither can be used for all clusters and projects.
+	AllowedClusters []string `json:"allowedClusters,omitempty" norman:"noupdate"`
+	AllowedProjects []string `json:"allowedProjects,omitempty" norman:"noupdate"`
+	// LastUsedAt and LastUsedIP record when and from where the token was last used to authenticate.
+	// They are updated at most once a minute.
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty" norman:"nocreate,noupdate"`
+	LastUsedIP string       `json:"lastUsedIP,omitempty" norman:"nocreate,noupdate"`
 }
 
 func (t *Token) ObjClusterName() string {
@@ -51,6 +67,8 @@ type User struct {
 	Username           string     `json:"username,omitempty"`
 	Password           string     `json:"password,omitempty" norman:"writeOnly,noupdate"`
 	MustChangePassword bool       `json:"mustChangePassword,omitempty"`
+	TOTPEnabled        bool       `json:"totpEnabled,omitempty" norman:"nocreate,noupdate"`
+	MustEnrollTOTP     bool       `json:"mustEnrollTotp,omitempty" norman:"nocreate,noupdate"`
 	PrincipalIDs       []string   `json:"principalIds,omitempty" norman:"type=array[reference[principal]]"`
 	Me                 bool       `json:"me,omitempty" norman:"nocreate,noupdate"`
 	Enabled            *bool      `json:"enabled,omitempty" norman:"default=true"`
@@ -155,6 +173,23 @@ type SetPasswordInput struct {
 	NewPassword string `json:"newPassword" norman:"type=string,required"`
 }
 
+type TOTPEnrollOutput struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+}
+
+type TOTPVerifyInput struct {
+	Code string `json:"code" norman:"type=string,required"`
+}
+
+type TOTPVerifyOutput struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type TOTPDisableInput struct {
+	Code string `json:"code" norman:"type=string"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -20,6 +20,10 @@
 	ProjectConditionMonitoringEnabled         condition.Cond = "MonitoringEnabled"
 	ProjectConditionMetricExpressionDeployed  condition.Cond = "MetricExpressionDeployed"
 	ProjectConditionSystemNamespacesAssigned  condition.Cond = "SystemNamespacesAssigned"
+	// RoleBindingConditionActive is true while a binding with a NotBefore or ExpiresAt time grants its role.
+	RoleBindingConditionActive condition.Cond = "Active"
+	// RoleBindingConditionExpired is true once the role of a binding was revoked at its ExpiresAt time.
+	RoleBindingConditionExpired condition.Cond = "Expired"
 )
 
 // +genclient
@@ -43,6 +47,8 @@ type ProjectStatus struct {
 	Conditions                    []ProjectCondition `json:"conditions"`
 	PodSecurityPolicyTemplateName string             `json:"podSecurityPolicyTemplateId"`
 	MonitoringStatus              *MonitoringStatus  `json:"monitoringStatus,omitempty" norman:"nocreate,noupdate"`
+	// AppliedProjectTemplateRevisionName is the project template revision last applied to the project.
+	AppliedProjectTemplateRevisionName string `json:"appliedProjectTemplateRevisionName,omitempty" norman:"nocreate,noupdate"`
 }
 
 type ProjectCondition struct {
@@ -60,16 +66,22 @@ type ProjectCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
-type ProjectSpec struct {
-	DisplayName                   string                  `json:"displayName,omitempty" norman:"required"`
-	Description                   string                  `json:"description"`
-	ClusterName                   string                  `json:"clusterName,omitempty" norman:"required,type=reference[cluster]"`
+type ProjectSpecBase struct {
 	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty"`
 	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty"`
 	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
 	EnableProjectMonitoring       bool                    `json:"enableProjectMonitoring" norman:"default=false"`
 }
 
+type ProjectSpec struct {
+	ProjectSpecBase
+	DisplayName                 string `json:"displayName,omitempty" norman:"required"`
+	Description                 string `json:"description"`
+	ClusterName                 string `json:"clusterName,omitempty" norman:"required,type=reference[cluster]"`
+	ProjectTemplateName         string `json:"projectTemplateName,omitempty" norman:"type=reference[projectTemplate],nocreate,noupdate"`
+	ProjectTemplateRevisionName string `json:"projectTemplateRevisionName,omitempty" norman:"type=reference[projectTemplateRevision]"`
+}
+
 func (p *ProjectSpec) ObjClusterName() string {
 	return p.ClusterName
 }
@@ -100,6 +112,10 @@ type GlobalRoleBinding struct {
 	UserName           string `json:"userName,omitempty" norman:"noupdate,type=reference[user]"`
 	GroupPrincipalName string `json:"groupPrincipalName,omitempty" norman:"noupdate,type=reference[principal]"`
 	GlobalRoleName     string `json:"globalRoleName,omitempty" norman:"required,noupdate,type=reference[globalRole]"`
+	// NotBefore and ExpiresAt bound when the binding grants its role, the binding is kept once it expired.
+	NotBefore *metav1.Time      `json:"notBefore,omitempty"`
+	ExpiresAt *metav1.Time      `json:"expiresAt,omitempty"`
+	Status    RoleBindingStatus `json:"status,omitempty" norman:"nocreate,noupdate"`
 }
 
 // +genclient
@@ -163,6 +179,10 @@ type ProjectRoleTemplateBinding struct {
 	ProjectName        string `json:"projectName,omitempty" norman:"required,noupdate,type=reference[project]"`
 	RoleTemplateName   string `json:"roleTemplateName,omitempty" norman:"required,noupdate,type=reference[roleTemplate]"`
 	ServiceAccount     string `json:"serviceAccount,omitempty" norman:"nocreate,noupdate"`
+	// NotBefore and ExpiresAt bound when the binding grants its role, the binding is kept once it expired.
+	NotBefore *metav1.Time      `json:"notBefore,omitempty"`
+	ExpiresAt *metav1.Time      `json:"expiresAt,omitempty"`
+	Status    RoleBindingStatus `json:"status,omitempty" norman:"nocreate,noupdate"`
 }
 
 func (p *ProjectRoleTemplateBinding) ObjClusterName() string {
@@ -186,12 +206,35 @@ type ClusterRoleTemplateBinding struct {
 	GroupPrincipalName string `json:"groupPrincipalName,omitempty" norman:"noupdate,type=reference[principal]"`
 	ClusterName        string `json:"clusterName,omitempty" norman:"required,noupdate,type=reference[cluster]"`
 	RoleTemplateName   string `json:"roleTemplateName,omitempty" norman:"required,noupdate,type=reference[roleTemplate]"`
+	// NotBefore and ExpiresAt bound when the binding grants its role, the binding is kept once it expired.
+	NotBefore *metav1.Time      `json:"notBefore,omitempty"`
+	ExpiresAt *metav1.Time      `json:"expiresAt,omitempty"`
+	Status    RoleBindingStatus `json:"status,omitempty" norman:"nocreate,noupdate"`
 }
 
 func (c *ClusterRoleTemplateBinding) ObjClusterName() string {
 	return c.ClusterName
 }
 
+type RoleBindingStatus struct {
+	Conditions []RoleBindingCondition `json:"conditions,omitempty"`
+}
+
+type RoleBindingCondition struct {
+	// Type of role binding condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime string `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	Message string `json:"message,omitempty"`
+}
+
 type SetPodSecurityPolicyTemplateInput struct {
 	PodSecurityPolicyTemplateName string `json:"podSecurityPolicyTemplateId" norman:"required,type=reference[podSecurityPolicyTemplate]"`
 }
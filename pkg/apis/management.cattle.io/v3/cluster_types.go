@@ -126,6 +126,8 @@ type ClusterSpecBase struct {
 	WindowsPreferedCluster               bool                                    `json:"windowsPreferedCluster" norman:"noupdate"`
 	LocalClusterAuthEndpoint             LocalClusterAuthEndpoint                `json:"localClusterAuthEndpoint,omitempty"`
 	ScheduledClusterScan                 *ScheduledClusterScan                   `json:"scheduledClusterScan,omitempty"`
+	EtcdBackupTarget                     *EtcdBackupTarget                       `json:"etcdBackupTarget,omitempty"`
+	EtcdBackupRetentionPolicy            *EtcdBackupRetentionPolicy              `json:"etcdBackupRetentionPolicy,omitempty"`
 	ClusterSecrets                       ClusterSecrets                          `json:"clusterSecrets" norman:"nocreate,noupdate"`
 }
 
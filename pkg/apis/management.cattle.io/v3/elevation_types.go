@@ -0,0 +1,100 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/rancher/norman/condition"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ElevationRequestActionApprove = "approve"
+	ElevationRequestActionDeny    = "deny"
+
+	// ElevationRequestApproveVerb is the verb on elevationrequests that allows to approve or deny the requests of other users.
+	ElevationRequestApproveVerb = "approve"
+)
+
+var (
+	// ElevationRequestConditionApproved is true once a request is approved, and false once it is denied.
+	ElevationRequestConditionApproved condition.Cond = "Approved"
+	// ElevationRequestConditionGranted is true while the binding of an approved request grants its role.
+	ElevationRequestConditionGranted condition.Cond = "Granted"
+	// ElevationRequestConditionExpired is true once the binding of an approved request was removed at the end of its duration.
+	ElevationRequestConditionExpired condition.Cond = "Expired"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ElevationRequest is the request of a user for a role on a cluster or project for a bounded duration. Once a user
+// holding the approve verb on elevationrequests and the requested role approves it, a CRTB or PRTB grants the role until
+// the duration ends.
+type ElevationRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElevationRequestSpec   `json:"spec"`
+	Status ElevationRequestStatus `json:"status"`
+}
+
+func (e *ElevationRequest) ObjClusterName() string {
+	return e.Spec.ObjClusterName()
+}
+
+type ElevationRequestSpec struct {
+	UserName         string `json:"userName,omitempty" norman:"type=reference[user],noupdate"`
+	ClusterName      string `json:"clusterName,omitempty" norman:"type=reference[cluster],noupdate"`
+	ProjectName      string `json:"projectName,omitempty" norman:"type=reference[project],noupdate"`
+	RoleTemplateName string `json:"roleTemplateName" norman:"required,type=reference[roleTemplate],noupdate"`
+	DurationMinutes  int    `json:"durationMinutes" norman:"required,min=1,noupdate"`
+	Justification    string `json:"justification" norman:"required,noupdate"`
+}
+
+func (e *ElevationRequestSpec) ObjClusterName() string {
+	if e.ClusterName != "" {
+		return e.ClusterName
+	}
+	if parts := strings.SplitN(e.ProjectName, ":", 2); len(parts) == 2 {
+		return parts[0]
+	}
+	return ""
+}
+
+type ElevationRequestStatus struct {
+	Conditions []ElevationRequestCondition `json:"conditions,omitempty"`
+	// Decisions are the approvals and denials of the request, in the order they were made.
+	Decisions []ElevationDecision `json:"decisions,omitempty"`
+	// BindingName is the namespace and name of the CRTB or PRTB that grants the role, separated by a colon.
+	BindingName string       `json:"bindingName,omitempty"`
+	GrantedAt   *metav1.Time `json:"grantedAt,omitempty"`
+	ExpiresAt   *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+type ElevationRequestCondition struct {
+	// Type of elevation request condition.
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime string `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition
+	Message string `json:"message,omitempty"`
+}
+
+type ElevationDecision struct {
+	UserName string      `json:"userName" norman:"type=reference[user]"`
+	Approved bool        `json:"approved"`
+	Reason   string      `json:"reason,omitempty"`
+	Time     metav1.Time `json:"time"`
+}
+
+type ElevationDecisionInput struct {
+	Reason string `json:"reason,omitempty"`
+}
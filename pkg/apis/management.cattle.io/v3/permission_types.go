@@ -0,0 +1,64 @@
+package v3
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	PermissionScopeGlobal  = "global"
+	PermissionScopeCluster = "cluster"
+	PermissionScopeProject = "project"
+)
+
+// EffectivePermissionsInput selects the user or group whose permissions are computed. Without a cluster or project
+// the permissions on all clusters and projects are returned. Only the bindings the reviewer can list are included,
+// unless users review their own permissions.
+type EffectivePermissionsInput struct {
+	UserName           string `json:"userId,omitempty"`
+	GroupPrincipalName string `json:"groupPrincipalId,omitempty"`
+	ClusterName        string `json:"clusterId,omitempty"`
+	ProjectName        string `json:"projectId,omitempty"`
+}
+
+type EffectivePermissionsOutput struct {
+	Permissions []GrantedPermission `json:"permissions"`
+}
+
+// WhoCanInput is the verb on a resource to find the subjects for. Without a resource name only the rules that allow it
+// on all resources of the kind match. Without a cluster or project the subjects that can do it on any cluster or
+// project are returned. Only the bindings the reviewer can list are included.
+type WhoCanInput struct {
+	Verb         string `json:"verb" norman:"required"`
+	APIGroup     string `json:"apiGroup,omitempty"`
+	Resource     string `json:"resource" norman:"required"`
+	ResourceName string `json:"resourceName,omitempty"`
+	ClusterName  string `json:"clusterId,omitempty"`
+	ProjectName  string `json:"projectId,omitempty"`
+}
+
+type WhoCanOutput struct {
+	Permissions []GrantedPermission `json:"permissions"`
+}
+
+// GrantedPermission is a rule granted to a subject along with the binding and role granting it.
+type GrantedPermission struct {
+	UserName           string `json:"userId,omitempty"`
+	UserPrincipalName  string `json:"userPrincipalId,omitempty"`
+	GroupName          string `json:"groupId,omitempty"`
+	GroupPrincipalName string `json:"groupPrincipalId,omitempty"`
+	// Scope is one of global, cluster or project. Cluster scoped permissions also apply to all projects of the cluster.
+	Scope       string `json:"scope"`
+	ClusterName string `json:"clusterId,omitempty"`
+	ProjectName string `json:"projectId,omitempty"`
+	// BindingType and BindingName identify the GlobalRoleBinding, ClusterRoleTemplateBinding or ProjectRoleTemplateBinding
+	// granting the rule, the name of namespaced bindings is prefixed by their namespace.
+	BindingType string `json:"bindingType"`
+	BindingName string `json:"bindingName"`
+	RoleName    string `json:"roleName"`
+	// Source is the global role or role template defining the rule, which differs from RoleName for rules inherited
+	// through roleTemplateNames.
+	Source string `json:"source"`
+	// External is true for rules of the cluster role backing an external role template.
+	External bool              `json:"external,omitempty"`
+	Rule     rbacv1.PolicyRule `json:"rule"`
+}
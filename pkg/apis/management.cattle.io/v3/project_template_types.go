@@ -0,0 +1,87 @@
+package v3
+
+import (
+	"github.com/rancher/norman/condition"
+	"github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProjectConditionTemplateApplied is true once the revision of the project template set on the project was applied.
+	ProjectConditionTemplateApplied condition.Cond = "TemplateApplied"
+
+	// ProjectTemplateLabel holds the name of the project template a project or namespace was created from.
+	ProjectTemplateLabel = "management.cattle.io/project-template"
+	// ProjectTemplateRevisionLabel holds the name of the project template revision a project or namespace was created from.
+	ProjectTemplateRevisionLabel = "management.cattle.io/project-template-revision"
+	// ProjectTemplateReconcileAnnotation is set to "true" on a project by its owners to have it moved to the default
+	// revision of a reconciled template.
+	ProjectTemplateReconcileAnnotation = "management.cattle.io/project-template-reconcile"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectTemplate struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectTemplateSpec `json:"spec"`
+}
+
+type ProjectTemplateSpec struct {
+	DisplayName         string `json:"displayName" norman:"required"`
+	Description         string `json:"description"`
+	DefaultRevisionName string `json:"defaultRevisionName,omitempty" norman:"type=reference[projectTemplateRevision]"`
+	// ReconcileProjects moves the projects created from any revision of the template to the default revision
+	// whenever the default revision changes, if their owners opted in with the ProjectTemplateReconcileAnnotation.
+	// Projects whose namespaces use more than its quota stay on their revision with the TemplateApplied condition set
+	// to false.
+	ReconcileProjects bool `json:"reconcileProjects,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ProjectTemplateRevision struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectTemplateRevisionSpec `json:"spec"`
+}
+
+// ProjectTemplateRevisionSpec is the config a revision applies to its projects. The config can't be changed once the
+// revision is created, since projects that already applied it wouldn't get the changes: a new revision is created
+// instead.
+type ProjectTemplateRevisionSpec struct {
+	DisplayName         string `json:"displayName" norman:"required"`
+	Enabled             *bool  `json:"enabled,omitempty" norman:"default=true"`
+	ProjectTemplateName string `json:"projectTemplateName,omitempty" norman:"type=reference[projectTemplate],required,noupdate"`
+
+	ProjectConfig                 *ProjectSpecBase           `json:"projectConfig" norman:"required,noupdate"`
+	PodSecurityPolicyTemplateName string                     `json:"podSecurityPolicyTemplateName,omitempty" norman:"type=reference[podSecurityPolicyTemplate],noupdate"`
+	Members                       []ProjectTemplateMember    `json:"members,omitempty" norman:"noupdate"`
+	Namespaces                    []ProjectTemplateNamespace `json:"namespaces,omitempty" norman:"noupdate"`
+}
+
+// ProjectTemplateMember is a project role template binding created for a group in every project of a revision.
+type ProjectTemplateMember struct {
+	GroupPrincipalName string `json:"groupPrincipalName" norman:"type=reference[principal],required"`
+	RoleTemplateName   string `json:"roleTemplateName" norman:"type=reference[roleTemplate],required"`
+}
+
+// ProjectTemplateNamespace is a namespace created in every project of a revision. Its labels can carry the pod
+// security admission settings of the namespace, if the creator of the revision may update them on projects.
+type ProjectTemplateNamespace struct {
+	Name        string            `json:"name" norman:"required"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
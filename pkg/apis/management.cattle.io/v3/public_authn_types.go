@@ -37,6 +37,7 @@ type BasicLogin struct {
 	GenericLogin `json:",inline"`
 	Username     string `json:"username" norman:"type=string,required"`
 	Password     string `json:"password" norman:"type=string,required"`
+	TOTPCode     string `json:"totpCode,omitempty" norman:"type=string"`
 }
 
 // +genclient
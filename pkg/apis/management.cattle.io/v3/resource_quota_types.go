@@ -23,6 +23,9 @@ type ResourceQuotaLimit struct {
 	RequestsStorage        string `json:"requestsStorage,omitempty"`
 	LimitsCPU              string `json:"limitsCpu,omitempty"`
 	LimitsMemory           string `json:"limitsMemory,omitempty"`
+	// Extended limits any other resource of a ResourceQuota by its name, such as count/deployments.apps,
+	// requests.ephemeral-storage, gold.storageclass.storage.k8s.io/requests.storage or requests.nvidia.com/gpu.
+	Extended map[string]string `json:"extended,omitempty"`
 }
 
 type ContainerResourceLimit struct {
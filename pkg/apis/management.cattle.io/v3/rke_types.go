@@ -53,3 +53,59 @@ type RkeAddon struct {
 
 	Template string `yaml:"template" json:"template,omitempty"`
 }
+
+// EtcdBackupTarget stores the etcd snapshots of an RKE cluster in an object store that RKE can't upload to itself, or
+// encrypts them. Rancher copies the snapshots between the etcd nodes and the target, so it can't be combined with an
+// S3 backup config.
+type EtcdBackupTarget struct {
+	AzureBlob *AzureBlobBackupConfig `json:"azureBlob,omitempty"`
+	GCS       *GCSBackupConfig       `json:"gcs,omitempty"`
+	S3        *S3BackupTargetConfig  `json:"s3,omitempty"`
+	// Encryption encrypts the snapshots before they are uploaded to the target.
+	Encryption *EtcdBackupEncryption `json:"encryption,omitempty"`
+}
+
+// S3BackupTargetConfig authenticates with the access key of an S3 cloud credential. Unlike an S3 backup config, Rancher
+// uploads the snapshots itself, so that they can be encrypted.
+type S3BackupTargetConfig struct {
+	BucketName          string `json:"bucketName,omitempty" norman:"required"`
+	Folder              string `json:"folder,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty"`
+	Region              string `json:"region,omitempty"`
+	CustomCA            string `json:"customCa,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" norman:"required"`
+}
+
+// EtcdBackupEncryption encrypts every snapshot with a data key of its own, which is stored alongside the snapshot
+// wrapped with the key of a secret in the global data namespace. The secret keeps the current key in the "key" field,
+// other 32 byte keys in the secret are only used to decrypt snapshots taken before the key was rotated.
+type EtcdBackupEncryption struct {
+	KeySecretName string `json:"keySecretName,omitempty" norman:"required"`
+}
+
+// EtcdBackupRetentionPolicy keeps the newest backup of each of the newest Hourly hours, Daily days, Weekly weeks and
+// Monthly months instead of the newest retention count of recurring backups. It applies to manual backups too, unless
+// they are pinned with the etcdbackup.cattle.io/pinned annotation.
+type EtcdBackupRetentionPolicy struct {
+	Hourly  int `json:"hourly,omitempty" norman:"min=0"`
+	Daily   int `json:"daily,omitempty" norman:"min=0"`
+	Weekly  int `json:"weekly,omitempty" norman:"min=0"`
+	Monthly int `json:"monthly,omitempty" norman:"min=0"`
+}
+
+// AzureBlobBackupConfig authenticates with the service principal of an Azure cloud credential.
+type AzureBlobBackupConfig struct {
+	AccountName         string `json:"accountName,omitempty" norman:"required"`
+	ContainerName       string `json:"containerName,omitempty" norman:"required"`
+	Folder              string `json:"folder,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" norman:"required"`
+}
+
+// GCSBackupConfig authenticates with the service account of a Google cloud credential.
+type GCSBackupConfig struct {
+	BucketName          string `json:"bucketName,omitempty" norman:"required"`
+	Folder              string `json:"folder,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" norman:"required"`
+}
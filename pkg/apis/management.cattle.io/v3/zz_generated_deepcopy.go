@@ -786,6 +786,22 @@ func (in *AzureADProviderList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobBackupConfig) DeepCopyInto(out *AzureBlobBackupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobBackupConfig.
+func (in *AzureBlobBackupConfig) DeepCopy() *AzureBlobBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicLogin) DeepCopyInto(out *BasicLogin) {
 	*out = *in
@@ -2161,6 +2177,15 @@ func (in *ClusterRoleTemplateBinding) DeepCopyInto(out *ClusterRoleTemplateBindi
 	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -2486,6 +2511,16 @@ func (in *ClusterSpecBase) DeepCopyInto(out *ClusterSpecBase) {
 		*out = new(ScheduledClusterScan)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EtcdBackupTarget != nil {
+		in, out := &in.EtcdBackupTarget, &out.EtcdBackupTarget
+		*out = new(EtcdBackupTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdBackupRetentionPolicy != nil {
+		in, out := &in.EtcdBackupRetentionPolicy, &out.EtcdBackupRetentionPolicy
+		*out = new(EtcdBackupRetentionPolicy)
+		**out = **in
+	}
 	out.ClusterSecrets = in.ClusterSecrets
 	return
 }
@@ -3388,6 +3423,45 @@ func (in *EKSStatus) DeepCopy() *EKSStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectivePermissionsInput) DeepCopyInto(out *EffectivePermissionsInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectivePermissionsInput.
+func (in *EffectivePermissionsInput) DeepCopy() *EffectivePermissionsInput {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectivePermissionsInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectivePermissionsOutput) DeepCopyInto(out *EffectivePermissionsOutput) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]GrantedPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectivePermissionsOutput.
+func (in *EffectivePermissionsOutput) DeepCopy() *EffectivePermissionsOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectivePermissionsOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchConfig) DeepCopyInto(out *ElasticsearchConfig) {
 	*out = *in
@@ -3404,6 +3478,168 @@ func (in *ElasticsearchConfig) DeepCopy() *ElasticsearchConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationDecision) DeepCopyInto(out *ElevationDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationDecision.
+func (in *ElevationDecision) DeepCopy() *ElevationDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationDecisionInput) DeepCopyInto(out *ElevationDecisionInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationDecisionInput.
+func (in *ElevationDecisionInput) DeepCopy() *ElevationDecisionInput {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationDecisionInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationRequest) DeepCopyInto(out *ElevationRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationRequest.
+func (in *ElevationRequest) DeepCopy() *ElevationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElevationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationRequestCondition) DeepCopyInto(out *ElevationRequestCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationRequestCondition.
+func (in *ElevationRequestCondition) DeepCopy() *ElevationRequestCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationRequestCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationRequestList) DeepCopyInto(out *ElevationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ElevationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationRequestList.
+func (in *ElevationRequestList) DeepCopy() *ElevationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElevationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationRequestSpec) DeepCopyInto(out *ElevationRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationRequestSpec.
+func (in *ElevationRequestSpec) DeepCopy() *ElevationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElevationRequestStatus) DeepCopyInto(out *ElevationRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ElevationRequestCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]ElevationDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GrantedAt != nil {
+		in, out := &in.GrantedAt, &out.GrantedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElevationRequestStatus.
+func (in *ElevationRequestStatus) DeepCopy() *ElevationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElevationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
 	*out = *in
@@ -3433,6 +3669,22 @@ func (in *EtcdBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupEncryption) DeepCopyInto(out *EtcdBackupEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupEncryption.
+func (in *EtcdBackupEncryption) DeepCopy() *EtcdBackupEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdBackupList) DeepCopyInto(out *EtcdBackupList) {
 	*out = *in
@@ -3466,6 +3718,58 @@ func (in *EtcdBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupRetentionPolicy) DeepCopyInto(out *EtcdBackupRetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupRetentionPolicy.
+func (in *EtcdBackupRetentionPolicy) DeepCopy() *EtcdBackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupTarget) DeepCopyInto(out *EtcdBackupTarget) {
+	*out = *in
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(AzureBlobBackupConfig)
+		**out = **in
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSBackupConfig)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3BackupTargetConfig)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EtcdBackupEncryption)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupTarget.
+func (in *EtcdBackupTarget) DeepCopy() *EtcdBackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventRule) DeepCopyInto(out *EventRule) {
 	*out = *in
@@ -3872,6 +4176,22 @@ func (in *FreeIpaTestAndApplyInput) DeepCopy() *FreeIpaTestAndApplyInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSBackupConfig) DeepCopyInto(out *GCSBackupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSBackupConfig.
+func (in *GCSBackupConfig) DeepCopy() *GCSBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GKEStatus) DeepCopyInto(out *GKEStatus) {
 	*out = *in
@@ -4371,6 +4691,15 @@ func (in *GlobalRoleBinding) DeepCopyInto(out *GlobalRoleBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -4626,6 +4955,23 @@ func (in *GoogleOauthLogin) DeepCopy() *GoogleOauthLogin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantedPermission) DeepCopyInto(out *GrantedPermission) {
+	*out = *in
+	in.Rule.DeepCopyInto(&out.Rule)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantedPermission.
+func (in *GrantedPermission) DeepCopy() *GrantedPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantedPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -6065,7 +6411,7 @@ func (in *MultiClusterAppStatus) DeepCopy() *MultiClusterAppStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
 	*out = *in
-	out.Limit = in.Limit
+	in.Limit.DeepCopyInto(&out.Limit)
 	return
 }
 
@@ -8252,8 +8598,8 @@ func (in *ProjectNetworkPolicyStatus) DeepCopy() *ProjectNetworkPolicyStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectResourceQuota) DeepCopyInto(out *ProjectResourceQuota) {
 	*out = *in
-	out.Limit = in.Limit
-	out.UsedLimit = in.UsedLimit
+	in.Limit.DeepCopyInto(&out.Limit)
+	in.UsedLimit.DeepCopyInto(&out.UsedLimit)
 	return
 }
 
@@ -8273,6 +8619,15 @@ func (in *ProjectRoleTemplateBinding) DeepCopyInto(out *ProjectRoleTemplateBindi
 	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -8330,15 +8685,32 @@ func (in *ProjectRoleTemplateBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
-	if in.ResourceQuota != nil {
-		in, out := &in.ResourceQuota, &out.ResourceQuota
-		*out = new(ProjectResourceQuota)
-		**out = **in
-	}
-	if in.NamespaceDefaultResourceQuota != nil {
-		in, out := &in.NamespaceDefaultResourceQuota, &out.NamespaceDefaultResourceQuota
+	in.ProjectSpecBase.DeepCopyInto(&out.ProjectSpecBase)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpecBase) DeepCopyInto(out *ProjectSpecBase) {
+	*out = *in
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(ProjectResourceQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceDefaultResourceQuota != nil {
+		in, out := &in.NamespaceDefaultResourceQuota, &out.NamespaceDefaultResourceQuota
 		*out = new(NamespaceResourceQuota)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContainerDefaultResourceLimit != nil {
 		in, out := &in.ContainerDefaultResourceLimit, &out.ContainerDefaultResourceLimit
@@ -8348,12 +8720,12 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpecBase.
+func (in *ProjectSpecBase) DeepCopy() *ProjectSpecBase {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(ProjectSpecBase)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -8384,6 +8756,228 @@ func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplate) DeepCopyInto(out *ProjectTemplate) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplate.
+func (in *ProjectTemplate) DeepCopy() *ProjectTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateList) DeepCopyInto(out *ProjectTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateList.
+func (in *ProjectTemplateList) DeepCopy() *ProjectTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateMember) DeepCopyInto(out *ProjectTemplateMember) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateMember.
+func (in *ProjectTemplateMember) DeepCopy() *ProjectTemplateMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateNamespace) DeepCopyInto(out *ProjectTemplateNamespace) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateNamespace.
+func (in *ProjectTemplateNamespace) DeepCopy() *ProjectTemplateNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateRevision) DeepCopyInto(out *ProjectTemplateRevision) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateRevision.
+func (in *ProjectTemplateRevision) DeepCopy() *ProjectTemplateRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTemplateRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateRevisionList) DeepCopyInto(out *ProjectTemplateRevisionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectTemplateRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateRevisionList.
+func (in *ProjectTemplateRevisionList) DeepCopy() *ProjectTemplateRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectTemplateRevisionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateRevisionSpec) DeepCopyInto(out *ProjectTemplateRevisionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProjectConfig != nil {
+		in, out := &in.ProjectConfig, &out.ProjectConfig
+		*out = new(ProjectSpecBase)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ProjectTemplateMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]ProjectTemplateNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateRevisionSpec.
+func (in *ProjectTemplateRevisionSpec) DeepCopy() *ProjectTemplateRevisionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateRevisionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTemplateSpec) DeepCopyInto(out *ProjectTemplateSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTemplateSpec.
+func (in *ProjectTemplateSpec) DeepCopy() *ProjectTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectTestInput) DeepCopyInto(out *ProjectTestInput) {
 	*out = *in
@@ -8726,6 +9320,13 @@ func (in *Recipient) DeepCopy() *Recipient {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaLimit) DeepCopyInto(out *ResourceQuotaLimit) {
 	*out = *in
+	if in.Extended != nil {
+		in, out := &in.Extended, &out.Extended
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -8954,6 +9555,43 @@ func (in *RkeK8sSystemImageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleBindingCondition) DeepCopyInto(out *RoleBindingCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBindingCondition.
+func (in *RoleBindingCondition) DeepCopy() *RoleBindingCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleBindingCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleBindingStatus) DeepCopyInto(out *RoleBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]RoleBindingCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBindingStatus.
+func (in *RoleBindingStatus) DeepCopy() *RoleBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleTemplate) DeepCopyInto(out *RoleTemplate) {
 	*out = *in
@@ -9117,6 +9755,22 @@ func (in *Route53ProviderConfig) DeepCopy() *Route53ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BackupTargetConfig) DeepCopyInto(out *S3BackupTargetConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BackupTargetConfig.
+func (in *S3BackupTargetConfig) DeepCopy() *S3BackupTargetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BackupTargetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3CredentialConfig) DeepCopyInto(out *S3CredentialConfig) {
 	*out = *in
@@ -9718,6 +10372,75 @@ func (in *SystemServiceRule) DeepCopy() *SystemServiceRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPDisableInput) DeepCopyInto(out *TOTPDisableInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPDisableInput.
+func (in *TOTPDisableInput) DeepCopy() *TOTPDisableInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPDisableInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPEnrollOutput) DeepCopyInto(out *TOTPEnrollOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPEnrollOutput.
+func (in *TOTPEnrollOutput) DeepCopy() *TOTPEnrollOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPEnrollOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPVerifyInput) DeepCopyInto(out *TOTPVerifyInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPVerifyInput.
+func (in *TOTPVerifyInput) DeepCopy() *TOTPVerifyInput {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPVerifyInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPVerifyOutput) DeepCopyInto(out *TOTPVerifyOutput) {
+	*out = *in
+	if in.RecoveryCodes != nil {
+		in, out := &in.RecoveryCodes, &out.RecoveryCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPVerifyOutput.
+func (in *TOTPVerifyOutput) DeepCopy() *TOTPVerifyOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPVerifyOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Target) DeepCopyInto(out *Target) {
 	*out = *in
@@ -10179,6 +10902,27 @@ func (in *Token) DeepCopyInto(out *Token) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]rbacv1.PolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedClusters != nil {
+		in, out := &in.AllowedClusters, &out.AllowedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedProjects != nil {
+		in, out := &in.AllowedProjects, &out.AllowedProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -10604,6 +11348,45 @@ func (in *WechatConfig) DeepCopy() *WechatConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanInput) DeepCopyInto(out *WhoCanInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanInput.
+func (in *WhoCanInput) DeepCopy() *WhoCanInput {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WhoCanOutput) DeepCopyInto(out *WhoCanOutput) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]GrantedPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WhoCanOutput.
+func (in *WhoCanOutput) DeepCopy() *WhoCanOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(WhoCanOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WindowsSystemImages) DeepCopyInto(out *WindowsSystemImages) {
 	*out = *in
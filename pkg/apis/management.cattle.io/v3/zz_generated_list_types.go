@@ -451,6 +451,23 @@ func NewDynamicSchema(namespace, name string, obj DynamicSchema) *DynamicSchema
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ElevationRequestList is a list of ElevationRequest resources
+type ElevationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ElevationRequest `json:"items"`
+}
+
+func NewElevationRequest(namespace, name string, obj ElevationRequest) *ElevationRequest {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ElevationRequest").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // EtcdBackupList is a list of EtcdBackup resources
 type EtcdBackupList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -1080,6 +1097,40 @@ func NewProjectRoleTemplateBinding(namespace, name string, obj ProjectRoleTempla
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ProjectTemplateList is a list of ProjectTemplate resources
+type ProjectTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ProjectTemplate `json:"items"`
+}
+
+func NewProjectTemplate(namespace, name string, obj ProjectTemplate) *ProjectTemplate {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ProjectTemplate").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectTemplateRevisionList is a list of ProjectTemplateRevision resources
+type ProjectTemplateRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ProjectTemplateRevision `json:"items"`
+}
+
+func NewProjectTemplateRevision(namespace, name string, obj ProjectTemplateRevision) *ProjectTemplateRevision {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ProjectTemplateRevision").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // RancherUserNotificationList is a list of RancherUserNotification resources
 type RancherUserNotificationList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -54,6 +54,7 @@
 	ClusterTemplateRevisionResourceName                 = "clustertemplaterevisions"
 	ComposeConfigResourceName                           = "composeconfigs"
 	DynamicSchemaResourceName                           = "dynamicschemas"
+	ElevationRequestResourceName                        = "elevationrequests"
 	EtcdBackupResourceName                              = "etcdbackups"
 	FeatureResourceName                                 = "features"
 	FleetWorkspaceResourceName                          = "fleetworkspaces"
@@ -92,6 +93,8 @@
 	ProjectMonitorGraphResourceName                     = "projectmonitorgraphs"
 	ProjectNetworkPolicyResourceName                    = "projectnetworkpolicies"
 	ProjectRoleTemplateBindingResourceName              = "projectroletemplatebindings"
+	ProjectTemplateResourceName                         = "projecttemplates"
+	ProjectTemplateRevisionResourceName                 = "projecttemplaterevisions"
 	RancherUserNotificationResourceName                 = "rancherusernotifications"
 	RkeAddonResourceName                                = "rkeaddons"
 	RkeK8sServiceOptionResourceName                     = "rkek8sserviceoptions"
@@ -181,6 +184,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ComposeConfigList{},
 		&DynamicSchema{},
 		&DynamicSchemaList{},
+		&ElevationRequest{},
+		&ElevationRequestList{},
 		&EtcdBackup{},
 		&EtcdBackupList{},
 		&Feature{},
@@ -257,6 +262,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectNetworkPolicyList{},
 		&ProjectRoleTemplateBinding{},
 		&ProjectRoleTemplateBindingList{},
+		&ProjectTemplate{},
+		&ProjectTemplateList{},
+		&ProjectTemplateRevision{},
+		&ProjectTemplateRevisionList{},
 		&RancherUserNotification{},
 		&RancherUserNotificationList{},
 		&RkeAddon{},
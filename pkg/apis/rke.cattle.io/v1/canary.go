@@ -0,0 +1,52 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type CanaryRolloutPhase string
+
+const (
+	// CanaryRolloutPhaseCanary is a rollout whose plan changes are applied to the canary machines of a tier.
+	CanaryRolloutPhaseCanary CanaryRolloutPhase = "Canary"
+	// CanaryRolloutPhaseSoaking is a rollout whose canary machines are watched for the soak period.
+	CanaryRolloutPhaseSoaking CanaryRolloutPhase = "Soaking"
+	// CanaryRolloutPhasePromoted is a rollout whose plan changes are applied to the other machines of a tier.
+	CanaryRolloutPhasePromoted CanaryRolloutPhase = "Promoted"
+	// CanaryRolloutPhasePaused is a rollout whose canary machines degraded, it resumes with a new soak period once they
+	// are healthy again.
+	CanaryRolloutPhasePaused CanaryRolloutPhase = "Paused"
+)
+
+// CanaryStrategy selects the canary machines of a cluster, plan changes are rolled out to the canary machines of each
+// tier of the cluster before they are rolled out to the other machines of the tier. The init node is always configured
+// first, it should be a canary too.
+type CanaryStrategy struct {
+	// MachineSelector selects canary machines by their labels.
+	MachineSelector *metav1.LabelSelector `json:"machineSelector,omitempty"`
+	// MachinePools are the machine pools whose machines are canaries.
+	MachinePools []string `json:"machinePools,omitempty"`
+	// SoakPeriod is how long the canary machines have to be healthy before plan changes are rolled out to the other
+	// machines.
+	SoakPeriod metav1.Duration `json:"soakPeriod,omitempty"`
+	// ProbeHealthGate pauses a rollout if the probes of a canary machine fail, a rollout is always paused if the node of
+	// a canary machine isn't ready or its plan fails to apply.
+	ProbeHealthGate bool `json:"probeHealthGate,omitempty"`
+	// QueryHealthGate is queried at the end of the soak period, the rollout is paused if it fails.
+	QueryHealthGate *CanaryQueryHealthGate `json:"queryHealthGate,omitempty"`
+}
+
+// CanaryQueryHealthGate is a Prometheus query the canary machines are healthy if it returns samples that aren't 0.
+type CanaryQueryHealthGate struct {
+	// URL is the address of a Prometheus compatible API that is reachable from Rancher, it has to be allowed by the
+	// canary-health-gate-urls setting.
+	URL   string `json:"url,omitempty"`
+	Query string `json:"query,omitempty"`
+}
+
+type CanaryRollout struct {
+	Tier  string             `json:"tier,omitempty"`
+	Phase CanaryRolloutPhase `json:"phase,omitempty"`
+	// Machines are the canary machines of the tier.
+	Machines      []string     `json:"machines,omitempty"`
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+	Message       string       `json:"message,omitempty"`
+}
@@ -58,6 +58,27 @@ type ClusterUpgradeStrategy struct {
 	// How many workers should be upgraded at a time
 	WorkerConcurrency  string       `json:"workerConcurrency,omitempty"`
 	WorkerDrainOptions DrainOptions `json:"workerDrainOptions,omitempty"`
+
+	// MaintenanceWindows are the times plan changes that restart nodes are rolled out in, changes are rolled out
+	// immediately if there are none. Nodes that are being upgraded when a window closes finish their upgrade, and new
+	// nodes always join the cluster immediately.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// IgnoreMaintenanceWindows rolls out plan changes immediately, for emergencies.
+	IgnoreMaintenanceWindows bool `json:"ignoreMaintenanceWindows,omitempty"`
+
+	// Rollback rolls back upgrades of the Kubernetes version that fail.
+	Rollback *UpgradeRollback `json:"rollback,omitempty"`
+	// Canary rolls out plan changes to canary machines first.
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	// Schedule is the cron schedule the window opens on.
+	Schedule string `json:"schedule,omitempty"`
+	// Duration is how long the window stays open.
+	Duration metav1.Duration `json:"duration,omitempty"`
+	// TimeZone is the IANA time zone of the schedule, UTC by default.
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type DrainOptions struct {
@@ -51,4 +51,6 @@ type RKEControlPlaneStatus struct {
 	ConfigGeneration              int64                               `json:"configGeneration,omitempty"`
 	Initialized                   bool                                `json:"initialized,omitempty"`
 	AgentConnected                bool                                `json:"agentConnected,omitempty"`
+	UpgradeRollback               *UpgradeRollbackStatus              `json:"upgradeRollback,omitempty"`
+	CanaryRollouts                []CanaryRollout                     `json:"canaryRollouts,omitempty"`
 }
@@ -51,6 +51,9 @@ type ETCDSnapshot struct {
 
 type ETCDSnapshotSpec struct {
 	ClusterName string `json:"clusterName,omitempty"`
+	// Pinned excludes the snapshot from the retention policy of the cluster, its local and S3 copies are kept until
+	// they are deleted.
+	Pinned bool `json:"pinned,omitempty"`
 }
 
 type ETCDSnapshotFile struct {
@@ -63,6 +66,9 @@ type ETCDSnapshotFile struct {
 	S3        *ETCDSnapshotS3 `json:"s3,omitempty"`
 	Status    string          `json:"status,omitempty"`
 	Message   string          `json:"message,omitempty"`
+	// SHA256 is the digest of the snapshot file, which is checked before the snapshot is restored. Restoring a snapshot
+	// with an empty digest skips the check.
+	SHA256 string `json:"sha256,omitempty"`
 }
 
 type ETCDSnapshotStatus struct {
@@ -74,4 +80,16 @@ type ETCD struct {
 	SnapshotScheduleCron string          `json:"snapshotScheduleCron,omitempty"`
 	SnapshotRetention    int             `json:"snapshotRetention,omitempty"`
 	S3                   *ETCDSnapshotS3 `json:"s3,omitempty"`
+	// SnapshotRetentionPolicy replaces SnapshotRetention when it keeps any snapshots. Rancher prunes the snapshots of
+	// every etcd node and their S3 copies instead of the distribution.
+	SnapshotRetentionPolicy *ETCDSnapshotRetentionPolicy `json:"snapshotRetentionPolicy,omitempty"`
+}
+
+// ETCDSnapshotRetentionPolicy keeps the newest successful snapshot of each of the newest Hourly hours, Daily days,
+// Weekly weeks and Monthly months of every etcd node.
+type ETCDSnapshotRetentionPolicy struct {
+	Hourly  int `json:"hourly,omitempty"`
+	Daily   int `json:"daily,omitempty"`
+	Weekly  int `json:"weekly,omitempty"`
+	Monthly int `json:"monthly,omitempty"`
 }
@@ -0,0 +1,71 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type ETCDRestoreDrillPhase string
+
+const (
+	ETCDRestoreDrillPhaseProvisioning ETCDRestoreDrillPhase = "Provisioning"
+	ETCDRestoreDrillPhaseRestoring    ETCDRestoreDrillPhase = "Restoring"
+	ETCDRestoreDrillPhaseChecking     ETCDRestoreDrillPhase = "Checking"
+	ETCDRestoreDrillPhaseTearingDown  ETCDRestoreDrillPhase = "TearingDown"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ETCDRestoreDrill periodically restores the newest S3 snapshot of a cluster into a scratch cluster with the machine
+// pools of the cluster, checks that the restored cluster works, and removes the scratch cluster again. The scratch
+// cluster has a single node in each etcd and control plane pool, and a single worker.
+type ETCDRestoreDrill struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ETCDRestoreDrillSpec   `json:"spec"`
+	Status            ETCDRestoreDrillStatus `json:"status,omitempty"`
+}
+
+type ETCDRestoreDrillSpec struct {
+	// ClusterName is the provisioning cluster in the namespace of the drill whose snapshots are restored. The cluster
+	// has to store its snapshots in S3, local snapshots can't be restored on other nodes.
+	ClusterName string `json:"clusterName,omitempty"`
+	// Schedule is the cron schedule the drill runs on, in UTC.
+	Schedule string `json:"schedule,omitempty"`
+	Paused   bool   `json:"paused,omitempty"`
+	// TimeoutSeconds fails a run that doesn't pass its checks in time, it is 3600 by default. The machines of the
+	// scratch cluster have to be provisioned within the timeout.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// MinReadyNodes is the number of nodes that have to be ready in the restored cluster, it is 1 by default.
+	MinReadyNodes int `json:"minReadyNodes,omitempty"`
+	// Namespaces have to exist in the restored cluster.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// RunsHistoryLimit is the number of finished runs kept in the status, it is 10 by default.
+	RunsHistoryLimit int `json:"runsHistoryLimit,omitempty"`
+}
+
+type ETCDRestoreDrillStatus struct {
+	Phase ETCDRestoreDrillPhase `json:"phase,omitempty"`
+	// ScratchClusterName is the provisioning cluster the current run restores into.
+	ScratchClusterName string               `json:"scratchClusterName,omitempty"`
+	Current            *ETCDRestoreDrillRun `json:"current,omitempty"`
+	LastScheduleTime   *metav1.Time         `json:"lastScheduleTime,omitempty"`
+	// Runs are the finished runs, the newest first.
+	Runs []ETCDRestoreDrillRun `json:"runs,omitempty"`
+}
+
+// ETCDRestoreDrillRun records when a run reached each phase, so that the time it takes to restore a cluster is known.
+type ETCDRestoreDrillRun struct {
+	SnapshotName    string                  `json:"snapshotName,omitempty"`
+	Passed          bool                    `json:"passed"`
+	Message         string                  `json:"message,omitempty"`
+	StartTime       *metav1.Time            `json:"startTime,omitempty"`
+	ProvisionedTime *metav1.Time            `json:"provisionedTime,omitempty"`
+	RestoredTime    *metav1.Time            `json:"restoredTime,omitempty"`
+	CompletionTime  *metav1.Time            `json:"completionTime,omitempty"`
+	Checks          []ETCDRestoreDrillCheck `json:"checks,omitempty"`
+}
+
+type ETCDRestoreDrillCheck struct {
+	Name    string `json:"name,omitempty"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
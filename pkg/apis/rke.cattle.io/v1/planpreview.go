@@ -0,0 +1,66 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	PlanPreviewFileAdded   = "Added"
+	PlanPreviewFileRemoved = "Removed"
+	PlanPreviewFileChanged = "Changed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RKEPlanPreview shows the changes a proposed configuration of a cluster makes to the plans of its machines, without
+// applying it: which files and instructions change, and which nodes are restarted or drained.
+type RKEPlanPreview struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              RKEPlanPreviewSpec   `json:"spec"`
+	Status            RKEPlanPreviewStatus `json:"status,omitempty"`
+}
+
+type RKEPlanPreviewSpec struct {
+	// ClusterName is the provisioning cluster in the namespace of the preview.
+	ClusterName string `json:"clusterName,omitempty"`
+	// KubernetesVersion is the proposed Kubernetes version, the version of the cluster is kept if it is empty.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// RKEConfig is the proposed configuration of the cluster.
+	RKEConfig RKEClusterSpecCommon `json:"rkeConfig,omitempty"`
+}
+
+type RKEPlanPreviewStatus struct {
+	ObservedGeneration int64  `json:"observedGeneration"`
+	Error              string `json:"error,omitempty"`
+	// Changed, Restarted and Drained are the number of machines whose plan changes, that are restarted and that are
+	// drained.
+	Changed   int                     `json:"changed"`
+	Restarted int                     `json:"restarted"`
+	Drained   int                     `json:"drained"`
+	Machines  []RKEPlanPreviewMachine `json:"machines,omitempty"`
+}
+
+type RKEPlanPreviewMachine struct {
+	MachineName string `json:"machineName,omitempty"`
+	NodeName    string `json:"nodeName,omitempty"`
+	Changed     bool   `json:"changed"`
+	// Minor changes are applied without restarting the node.
+	Minor bool `json:"minor"`
+	// RestartStampChanged restarts the distribution on the node.
+	RestartStampChanged bool `json:"restartStampChanged"`
+	// Drain is set if the node is drained before it is restarted.
+	Drain bool                 `json:"drain"`
+	Files []RKEPlanPreviewFile `json:"files,omitempty"`
+	// Instructions are the names of the new one-time instructions.
+	Instructions []string `json:"instructions,omitempty"`
+}
+
+type RKEPlanPreviewFile struct {
+	Path string `json:"path,omitempty"`
+	// Change is Added, Removed or Changed.
+	Change string `json:"change,omitempty"`
+	Minor  bool   `json:"minor"`
+	// Diff is the unified diff of the config file of the distribution, with its tokens and keys redacted. It isn't set
+	// for other files, which can contain credentials.
+	Diff string `json:"diff,omitempty"`
+}
@@ -0,0 +1,47 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type UpgradeRollbackPhase string
+
+const (
+	// UpgradeRollbackPhaseSnapshot is an upgrade that waits for an etcd snapshot of the previous version to be taken.
+	UpgradeRollbackPhaseSnapshot UpgradeRollbackPhase = "Snapshot"
+	// UpgradeRollbackPhaseUpgrading is an upgrade that is rolled out and watched for failures.
+	UpgradeRollbackPhaseUpgrading UpgradeRollbackPhase = "Upgrading"
+	// UpgradeRollbackPhaseSucceeded is an upgrade that was rolled out to all nodes.
+	UpgradeRollbackPhaseSucceeded UpgradeRollbackPhase = "Succeeded"
+	// UpgradeRollbackPhaseRestore is a failed upgrade whose pre-upgrade etcd snapshot is being restored.
+	UpgradeRollbackPhaseRestore UpgradeRollbackPhase = "Restore"
+	// UpgradeRollbackPhaseRollingBack is a failed upgrade whose nodes are reverted to the plans of the previous version.
+	UpgradeRollbackPhaseRollingBack UpgradeRollbackPhase = "RollingBack"
+	// UpgradeRollbackPhaseRolledBack is a failed upgrade whose nodes run the previous version again. The cluster stays
+	// on the previous version until its Kubernetes version is changed, which clears the rollback.
+	UpgradeRollbackPhaseRolledBack UpgradeRollbackPhase = "RolledBack"
+)
+
+// UpgradeRollback is the policy to roll back upgrades of the Kubernetes version of a cluster that fail.
+type UpgradeRollback struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// FailureThreshold is the number of nodes whose plan fails to apply before the upgrade is rolled back, defaults to 1.
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// Timeout is how long the upgrade can take before it is rolled back, upgrades don't time out if it is not set.
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// RestoreETCDSnapshot takes an etcd snapshot before the upgrade is rolled out, and restores it when the upgrade is
+	// rolled back, which loses the changes made to the cluster during the upgrade. Upgrades to another minor version
+	// always restore a snapshot, since the previous version isn't guaranteed to run on etcd data written by the newer
+	// one. Without it, a rollback only downgrades the binaries of a patch upgrade and keeps the data of the newer version.
+	RestoreETCDSnapshot bool `json:"restoreETCDSnapshot,omitempty"`
+}
+
+type UpgradeRollbackStatus struct {
+	FromVersion string               `json:"fromVersion,omitempty"`
+	ToVersion   string               `json:"toVersion,omitempty"`
+	Phase       UpgradeRollbackPhase `json:"phase,omitempty"`
+	StartTime   metav1.Time          `json:"startTime,omitempty"`
+	// SnapshotName is the etcdsnapshot object that is restored if the upgrade is rolled back.
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// FailedMachines are the machines whose plan failed to apply.
+	FailedMachines []string `json:"failedMachines,omitempty"`
+	Message        string   `json:"message,omitempty"`
+}
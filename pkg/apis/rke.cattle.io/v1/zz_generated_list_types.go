@@ -43,6 +43,23 @@ func NewCustomMachine(namespace, name string, obj CustomMachine) *CustomMachine
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ETCDRestoreDrillList is a list of ETCDRestoreDrill resources
+type ETCDRestoreDrillList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ETCDRestoreDrill `json:"items"`
+}
+
+func NewETCDRestoreDrill(namespace, name string, obj ETCDRestoreDrill) *ETCDRestoreDrill {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("ETCDRestoreDrill").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // ETCDSnapshotList is a list of ETCDSnapshot resources
 type ETCDSnapshotList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -125,3 +142,20 @@ func NewRKEControlPlane(namespace, name string, obj RKEControlPlane) *RKEControl
 	obj.Namespace = namespace
 	return &obj
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RKEPlanPreviewList is a list of RKEPlanPreview resources
+type RKEPlanPreviewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RKEPlanPreview `json:"items"`
+}
+
+func NewRKEPlanPreview(namespace, name string, obj RKEPlanPreview) *RKEPlanPreview {
+	obj.APIVersion, obj.Kind = SchemeGroupVersion.WithKind("RKEPlanPreview").ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
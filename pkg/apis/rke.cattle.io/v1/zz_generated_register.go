@@ -29,11 +29,13 @@
 
 var (
 	CustomMachineResourceName        = "custommachines"
+	ETCDRestoreDrillResourceName     = "etcdrestoredrills"
 	ETCDSnapshotResourceName         = "etcdsnapshots"
 	RKEBootstrapResourceName         = "rkebootstraps"
 	RKEBootstrapTemplateResourceName = "rkebootstraptemplates"
 	RKEClusterResourceName           = "rkeclusters"
 	RKEControlPlaneResourceName      = "rkecontrolplanes"
+	RKEPlanPreviewResourceName       = "rkeplanpreviews"
 )
 
 // SchemeGroupVersion is group version used to register these objects
@@ -59,6 +61,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CustomMachine{},
 		&CustomMachineList{},
+		&ETCDRestoreDrill{},
+		&ETCDRestoreDrillList{},
 		&ETCDSnapshot{},
 		&ETCDSnapshotList{},
 		&RKEBootstrap{},
@@ -69,6 +73,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RKEClusterList{},
 		&RKEControlPlane{},
 		&RKEControlPlaneList{},
+		&RKEPlanPreview{},
+		&RKEPlanPreviewList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
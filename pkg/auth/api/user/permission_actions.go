@@ -0,0 +1,121 @@
+package user
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/auth/permissions"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+)
+
+// effectivePermissions returns the permissions of a user or group. Users can review their own permissions, reviewing
+// the ones of others requires listing the bindings of the reviewed scope.
+func (h *Handler) effectivePermissions(request *types.APIContext) error {
+	actionInput, err := parse.ReadBody(request.Request)
+	if err != nil {
+		return err
+	}
+	input := v32.EffectivePermissionsInput{}
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
+	canList := permissions.AllowAll
+	if !isSelf(request, input.UserName) {
+		if err := canReview(request, input.ClusterName, input.ProjectName); err != nil {
+			return err
+		}
+		canList = listFilter(request)
+	}
+
+	granted, err := h.PermissionReviewer.EffectivePermissions(input, canList)
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, err.Error())
+	}
+
+	return writePermissions(request, client.EffectivePermissionsOutputType, granted)
+}
+
+// whoCan returns the subjects allowed to use a verb on a resource, which requires listing the bindings of the
+// reviewed scope.
+func (h *Handler) whoCan(request *types.APIContext) error {
+	actionInput, err := parse.ReadBody(request.Request)
+	if err != nil {
+		return err
+	}
+	input := v32.WhoCanInput{}
+	if err := convert.ToObj(actionInput, &input); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
+	if err := canReview(request, input.ClusterName, input.ProjectName); err != nil {
+		return err
+	}
+
+	granted, err := h.PermissionReviewer.WhoCan(input, listFilter(request))
+	if err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, err.Error())
+	}
+
+	return writePermissions(request, client.WhoCanOutputType, granted)
+}
+
+// canReview checks that the user can list the bindings of a project, a cluster, or without either the global role
+// bindings.
+func canReview(request *types.APIContext, clusterName, projectName string) error {
+	resource, namespace := "globalrolebindings", ""
+	switch {
+	case projectName != "":
+		resource = "projectroletemplatebindings"
+		if parts := strings.SplitN(projectName, ":", 2); len(parts) == 2 {
+			namespace = parts[1]
+		}
+	case clusterName != "":
+		resource, namespace = "clusterroletemplatebindings", clusterName
+	}
+
+	if err := request.AccessControl.CanDo(v3.GlobalRoleBindingGroupVersionKind.Group, resource, "list", request,
+		map[string]interface{}{"namespaceId": namespace}, request.Schema); err != nil {
+		return httperror.NewAPIError(httperror.PermissionDenied, "can not review the permissions of other users in this scope")
+	}
+	return nil
+}
+
+// listFilter leaves the bindings the user can't list out of a review, like the global role bindings of a review of a
+// cluster, or the bindings of the other clusters and projects of a review without one.
+func listFilter(request *types.APIContext) permissions.ListFilter {
+	allowed := map[string]bool{}
+	return func(resource, namespace string) bool {
+		key := resource + "/" + namespace
+		if ok, seen := allowed[key]; seen {
+			return ok
+		}
+		err := request.AccessControl.CanDo(v3.GlobalRoleBindingGroupVersionKind.Group, resource, "list", request,
+			map[string]interface{}{"namespaceId": namespace}, request.Schema)
+		allowed[key] = err == nil
+		return err == nil
+	}
+}
+
+func writePermissions(request *types.APIContext, outputType string, granted []v32.GrantedPermission) error {
+	data := []interface{}{}
+	for _, permission := range granted {
+		m, err := convert.EncodeToMap(permission)
+		if err != nil {
+			return err
+		}
+		data = append(data, m)
+	}
+
+	request.WriteResponse(http.StatusOK, map[string]interface{}{
+		"type":        outputType,
+		"permissions": data,
+	})
+	return nil
+}
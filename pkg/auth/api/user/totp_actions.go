@@ -0,0 +1,173 @@
+package user
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/parse"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/rancher/pkg/auth/totp"
+	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const totpIssuer = "Rancher"
+
+// addTOTPActions adds the TOTP actions to a user resource. Only users themselves can enroll,
+// while disabling is also offered to anyone who can update the user.
+func (h *Handler) addTOTPActions(apiContext *types.APIContext, resource *types.RawResource) {
+	self := isSelf(apiContext, resource.ID)
+	enabled, _ := resource.Values[client.UserFieldTOTPEnabled].(bool)
+
+	if self && !enabled {
+		resource.AddAction(apiContext, "totpenroll")
+		resource.AddAction(apiContext, "totpverify")
+	}
+	if enabled && (self || h.canUpdateUser(apiContext, resource.ID)) {
+		resource.AddAction(apiContext, "totpdisable")
+	}
+}
+
+func (h *Handler) totpEnroll(request *types.APIContext) error {
+	if !isSelf(request, request.ID) {
+		return httperror.NewAPIError(httperror.PermissionDenied, "users can only enroll a TOTP second factor for themselves")
+	}
+
+	user, err := h.UserClient.Get(request.ID, v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := h.TOTPStore.Get(user.Name)
+	if err != nil {
+		return err
+	}
+	if enrollment.Enrolled() {
+		return httperror.NewAPIError(httperror.Conflict, "a TOTP second factor is already enrolled, disable it first")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return err
+	}
+	enrollment.PendingSecret = secret
+	if err := h.TOTPStore.Save(user.Name, enrollment); err != nil {
+		return err
+	}
+
+	request.WriteResponse(http.StatusOK, map[string]interface{}{
+		"type":                             client.TOTPEnrollOutputType,
+		client.TOTPEnrollOutputFieldSecret: secret,
+		client.TOTPEnrollOutputFieldURL:    totp.KeyURI(totpIssuer, user.Username, secret),
+	})
+	return nil
+}
+
+func (h *Handler) totpVerify(request *types.APIContext) error {
+	if !isSelf(request, request.ID) {
+		return httperror.NewAPIError(httperror.PermissionDenied, "users can only enroll a TOTP second factor for themselves")
+	}
+
+	actionInput, err := parse.ReadBody(request.Request)
+	if err != nil {
+		return err
+	}
+	code, _ := actionInput[client.TOTPVerifyInputFieldCode].(string)
+	if code == "" {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "must specify code")
+	}
+
+	user, err := h.UserClient.Get(request.ID, v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := h.TOTPStore.Get(user.Name)
+	if err != nil {
+		return err
+	}
+	if enrollment.PendingSecret == "" {
+		return httperror.NewAPIError(httperror.InvalidState, "no TOTP enrollment in progress")
+	}
+
+	step, ok := totp.Validate(enrollment.PendingSecret, code, time.Now())
+	if !ok {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, "invalid code")
+	}
+
+	enrollment.Secret = enrollment.PendingSecret
+	enrollment.PendingSecret = ""
+	enrollment.LastStep = step
+	recoveryCodes, err := enrollment.GenerateRecoveryCodes()
+	if err != nil {
+		return err
+	}
+	if err := h.TOTPStore.Save(user.Name, enrollment); err != nil {
+		return err
+	}
+
+	user.TOTPEnabled = true
+	user.MustEnrollTOTP = false
+	if _, err := h.UserClient.Update(user); err != nil {
+		return err
+	}
+
+	request.WriteResponse(http.StatusOK, map[string]interface{}{
+		"type": client.TOTPVerifyOutputType,
+		client.TOTPVerifyOutputFieldRecoveryCodes: recoveryCodes,
+	})
+	return nil
+}
+
+func (h *Handler) totpDisable(request *types.APIContext) error {
+	self := isSelf(request, request.ID)
+	if !self && !h.canUpdateUser(request, request.ID) {
+		return httperror.NewAPIError(httperror.PermissionDenied, "not allowed to disable the TOTP second factor of this user")
+	}
+
+	actionInput, err := parse.ReadBody(request.Request)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.UserClient.Get(request.ID, v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// users disabling their own second factor must prove they still hold it, administrators can
+	// reset it for users that lost their device and recovery codes
+	if self {
+		enrollment, err := h.TOTPStore.Get(user.Name)
+		if err != nil {
+			return err
+		}
+		code, _ := actionInput[client.TOTPDisableInputFieldCode].(string)
+		if !enrollment.CheckCode(code, time.Now()) {
+			return httperror.NewAPIError(httperror.InvalidBodyContent, "invalid code")
+		}
+	}
+
+	// the user is updated first, a user with TOTPEnabled set but no enrollment could not log in anymore
+	user.TOTPEnabled = false
+	if _, err := h.UserClient.Update(user); err != nil {
+		return err
+	}
+
+	if err := h.TOTPStore.Delete(user.Name); err != nil {
+		return err
+	}
+
+	request.WriteResponse(http.StatusOK, nil)
+	return nil
+}
+
+func isSelf(apiContext *types.APIContext, userID string) bool {
+	return userID != "" && apiContext.Request.Header.Get("Impersonate-User") == userID
+}
+
+func (h *Handler) canUpdateUser(apiContext *types.APIContext, userID string) bool {
+	return apiContext.AccessControl.CanDo(v3.UserGroupVersionKind.Group, v3.UserResource.Name, "update", apiContext, map[string]interface{}{"id": userID}, apiContext.Schema) == nil
+}
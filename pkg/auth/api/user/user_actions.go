@@ -9,7 +9,10 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/parse"
 	"github.com/rancher/norman/types"
+	"github.com/rancher/rancher/pkg/auth/passwordpolicy"
+	"github.com/rancher/rancher/pkg/auth/permissions"
 	"github.com/rancher/rancher/pkg/auth/providerrefresh"
+	"github.com/rancher/rancher/pkg/auth/totp"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
@@ -19,6 +22,7 @@
 
 func (h *Handler) UserFormatter(apiContext *types.APIContext, resource *types.RawResource) {
 	resource.AddAction(apiContext, "setpassword")
+	h.addTOTPActions(apiContext, resource)
 
 	if canRefresh := h.userCanRefresh(apiContext); canRefresh {
 		resource.AddAction(apiContext, "refreshauthprovideraccess")
@@ -27,6 +31,8 @@ func (h *Handler) UserFormatter(apiContext *types.APIContext, resource *types.Ra
 
 func (h *Handler) CollectionFormatter(apiContext *types.APIContext, collection *types.GenericCollection) {
 	collection.AddAction(apiContext, "changepassword")
+	collection.AddAction(apiContext, "effectivepermissions")
+	collection.AddAction(apiContext, "whocan")
 	if canRefresh := h.userCanRefresh(apiContext); canRefresh {
 		collection.AddAction(apiContext, "refreshauthprovideraccess")
 	}
@@ -36,6 +42,9 @@ type Handler struct {
 	UserClient               v3.UserInterface
 	GlobalRoleBindingsClient v3.GlobalRoleBindingInterface
 	UserAuthRefresher        providerrefresh.UserAuthRefresher
+	TOTPStore                *totp.Store
+	PasswordHistory          *passwordpolicy.HistoryStore
+	PermissionReviewer       *permissions.Reviewer
 }
 
 func (h *Handler) Actions(actionName string, action *types.Action, apiContext *types.APIContext) error {
@@ -52,6 +61,22 @@ func (h *Handler) Actions(actionName string, action *types.Action, apiContext *t
 		if err := h.refreshAttributes(actionName, action, apiContext); err != nil {
 			return err
 		}
+	case "totpenroll":
+		if err := h.totpEnroll(apiContext); err != nil {
+			return err
+		}
+	case "totpverify":
+		if err := h.totpVerify(apiContext); err != nil {
+			return err
+		}
+	case "totpdisable":
+		if err := h.totpDisable(apiContext); err != nil {
+			return err
+		}
+	case "effectivepermissions":
+		return h.effectivePermissions(apiContext)
+	case "whocan":
+		return h.whoCan(apiContext)
 	default:
 		return errors.Errorf("bad action %v", actionName)
 	}
@@ -103,6 +128,10 @@ func (h *Handler) changePassword(actionName string, action *types.Action, reques
 		return httperror.NewAPIError(httperror.InvalidBodyContent, "invalid current password")
 	}
 
+	if err := h.PasswordHistory.Check(user.Name, newPass); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
 	newPassHash, err := HashPasswordString(newPass)
 	if err != nil {
 		return err
@@ -115,7 +144,7 @@ func (h *Handler) changePassword(actionName string, action *types.Action, reques
 		return err
 	}
 
-	return nil
+	return h.PasswordHistory.Record(user.Name, newPassHash)
 }
 
 func (h *Handler) setPassword(actionName string, action *types.Action, request *types.APIContext) error {
@@ -146,10 +175,15 @@ func (h *Handler) setPassword(actionName string, action *types.Action, request *
 		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
 	}
 
+	if err := h.PasswordHistory.Check(request.ID, newPass); err != nil {
+		return httperror.NewAPIError(httperror.InvalidBodyContent, err.Error())
+	}
+
 	userData[client.UserFieldPassword] = newPass
 	if err := hashPassword(userData); err != nil {
 		return err
 	}
+	newPassHash, _ := userData[client.UserFieldPassword].(string)
 	userData[client.UserFieldMustChangePassword] = false
 	delete(userData, "me")
 
@@ -158,6 +192,10 @@ func (h *Handler) setPassword(actionName string, action *types.Action, request *
 		return err
 	}
 
+	if err := h.PasswordHistory.Record(request.ID, newPassHash); err != nil {
+		return err
+	}
+
 	request.WriteResponse(http.StatusOK, userData)
 	return nil
 }
@@ -183,13 +221,17 @@ func (h *Handler) userCanRefresh(request *types.APIContext) bool {
 	return request.AccessControl.CanDo(v3.UserGroupVersionKind.Group, v3.UserResource.Name, "create", request, nil, request.Schema) == nil
 }
 
-// validatePassword will ensure a password is at least the minimum required length in runes,
-// that the username and password do not match, and that the new password is not the same as the current password.
+// validatePassword will ensure a password is at least the minimum required length in runes, that it meets the
+// complexity rules, that the username and password do not match, and that the new password is not the same as the current password.
 func validatePassword(user string, currentPass string, pass string, minPassLen int) error {
 	if utf8.RuneCountInString(pass) < minPassLen {
 		return errors.Errorf("Password must be at least %v characters", minPassLen)
 	}
 
+	if err := passwordpolicy.ComplexityFromSettings().Validate(pass); err != nil {
+		return err
+	}
+
 	if user == pass {
 		return errors.New("Password cannot be the same as username")
 	}
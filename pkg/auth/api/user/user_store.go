@@ -9,6 +9,7 @@
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/store/transform"
 	"github.com/rancher/norman/types"
+	"github.com/rancher/rancher/pkg/auth/passwordpolicy"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/settings"
@@ -23,9 +24,10 @@
 
 type userStore struct {
 	types.Store
-	mu          sync.Mutex
-	userIndexer cache.Indexer
-	userManager user.Manager
+	mu              sync.Mutex
+	userIndexer     cache.Indexer
+	userManager     user.Manager
+	passwordHistory *passwordpolicy.HistoryStore
 }
 
 func SetUserStore(schema *types.Schema, mgmt *config.ScaledContext) {
@@ -36,10 +38,11 @@ func SetUserStore(schema *types.Schema, mgmt *config.ScaledContext) {
 	userInformer.AddIndexers(userIndexers)
 
 	store := &userStore{
-		Store:       schema.Store,
-		mu:          sync.Mutex{},
-		userIndexer: userInformer.GetIndexer(),
-		userManager: mgmt.UserManager,
+		Store:           schema.Store,
+		mu:              sync.Mutex{},
+		userIndexer:     userInformer.GetIndexer(),
+		userManager:     mgmt.UserManager,
+		passwordHistory: &passwordpolicy.HistoryStore{Secrets: mgmt.Core.Secrets("")},
 	}
 
 	t := &transform.Store{
@@ -131,6 +134,13 @@ func (s *userStore) Create(apiContext *types.APIContext, schema *types.Schema, d
 		return nil, err
 	}
 
+	if id, ok := created[types.ResourceFieldID].(string); ok {
+		hash, _ := data[client.UserFieldPassword].(string)
+		if err := s.passwordHistory.Record(id, hash); err != nil {
+			logrus.Warnf("error while recording password history of user %s: %v", id, err)
+		}
+	}
+
 Tries:
 	for x := 0; x < 3; x++ {
 		if id, ok := created[types.ResourceFieldID].(string); ok {
@@ -57,6 +57,7 @@
 type auditLog struct {
 	log                *log
 	writer             *LogWriter
+	level              Level
 	reqBody            []byte
 	keysToConcealRegex *regexp.Regexp
 }
@@ -115,9 +116,10 @@ func FromContext(ctx context.Context) (*User, bool) {
 	return u, ok
 }
 
-func newAuditLog(writer *LogWriter, req *http.Request, keysToConcealRegex *regexp.Regexp) (*auditLog, error) {
+func newAuditLog(writer *LogWriter, level Level, req *http.Request, keysToConcealRegex *regexp.Regexp) (*auditLog, error) {
 	auditLog := &auditLog{
 		writer: writer,
+		level:  level,
 		log: &log{
 			AuditID:          k8stypes.UID(uuid.NewRandom().String()),
 			RequestURI:       req.RequestURI,
@@ -130,7 +132,7 @@ func newAuditLog(writer *LogWriter, req *http.Request, keysToConcealRegex *regex
 
 	contentType := req.Header.Get("Content-Type")
 	loginReq := isLoginRequest(req.RequestURI)
-	if writer.Level >= LevelRequest || loginReq {
+	if level >= LevelRequest || loginReq {
 		if bodyMethods[req.Method] && strings.HasPrefix(contentType, contentTypeJSON) {
 			reqBody, err := readBodyWithoutLosingContent(req)
 			if err != nil {
@@ -142,7 +144,7 @@ func newAuditLog(writer *LogWriter, req *http.Request, keysToConcealRegex *regex
 					auditLog.log.UserLoginName = loginName
 				}
 			}
-			if writer.Level >= LevelRequest {
+			if level >= LevelRequest {
 				auditLog.reqBody = reqBody
 			}
 		}
@@ -190,7 +192,7 @@ func (a *auditLog) write(userInfo *User, reqHeaders, resHeaders http.Header, res
 
 	compactBuffer.WriteString("\n")
 
-	_, err = a.writer.Output.Write(compactBuffer.Bytes())
+	err = a.writer.write(compactBuffer.Bytes())
 	if err != nil {
 		return fmt.Errorf("failed to write log to output: %w", err)
 	}
@@ -200,7 +202,7 @@ func (a *auditLog) write(userInfo *User, reqHeaders, resHeaders http.Header, res
 
 // writeRequest attempts to write the API request to the log message.
 func (a *auditLog) writeRequest(buf *bytes.Buffer) {
-	if a.writer.Level < LevelRequest || len(a.reqBody) == 0 {
+	if a.level < LevelRequest || len(a.reqBody) == 0 {
 		return
 	}
 
@@ -210,7 +212,7 @@ func (a *auditLog) writeRequest(buf *bytes.Buffer) {
 
 // writeResponse attempt to write the API response to the log message.
 func (a *auditLog) writeResponse(buf *bytes.Buffer, resHeaders http.Header, resBody []byte) (err error) {
-	if a.writer.Level < LevelRequestResponse || resHeaders.Get("Content-Type") != contentTypeJSON || len(resBody) == 0 {
+	if a.level < LevelRequestResponse || resHeaders.Get("Content-Type") != contentTypeJSON || len(resBody) == 0 {
 		return nil
 	}
 
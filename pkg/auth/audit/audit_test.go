@@ -178,7 +178,7 @@ func (a *AuditTest) TestCompression() {
 	req, err := http.NewRequest(http.MethodGet, "/test", nil)
 	a.Require().NoErrorf(err, "Failed to create request: %v", err)
 
-	auditLog, err := newAuditLog(writer, req, sensitiveRegex)
+	auditLog, err := newAuditLog(writer, LevelRequestResponse, req, sensitiveRegex)
 	a.Require().NoErrorf(err, "Failed to create AuditLog: %v", err)
 
 	const testString = "{\"test\":\"response\"}"
@@ -295,7 +295,7 @@ func (a *AuditTest) TestCompression() {
 	for i := range tests {
 		test := tests[i]
 		a.Run(test.name, func() {
-			writer.Level = test.level
+			auditLog.level = test.level
 			auditLog.reqBody = []byte(test.reqBody)
 			// write the test to the audit logger
 			err := auditLog.write(nil, req.Header, test.respHeader, test.returnCode, test.respBody)
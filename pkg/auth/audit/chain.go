@@ -0,0 +1,303 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCheckpointInterval = 1000
+	lastLineChunkSize         = 64 * 1024
+
+	// CheckpointStart is written as the first record of a new hash chain.
+	CheckpointStart = "start"
+	// CheckpointResume is written when Rancher continues the chain found at the end of an existing log file.
+	CheckpointResume = "resume"
+	// CheckpointPeriodic is written every CheckpointInterval records.
+	CheckpointPeriodic = "periodic"
+	// CheckpointStop is written when Rancher shuts down.
+	CheckpointStop = "stop"
+)
+
+// ChainOptions configures tamper evident audit logging. When enabled every record carries a sequence
+// number, the hash of the previous record and its own hash, and signed checkpoint records are written periodically.
+type ChainOptions struct {
+	Enabled bool
+	// SigningKeyPath is a PEM encoded PKCS #8 ed25519 private key used to sign checkpoint records.
+	// Checkpoints are written unsigned when it is empty.
+	SigningKeyPath string
+	// CheckpointInterval is the number of records between checkpoints.
+	CheckpointInterval int
+}
+
+// chainFields are the fields added to every record in the hash chain.
+type chainFields struct {
+	Sequence     uint64 `json:"sequence"`
+	PreviousHash string `json:"previousHash"`
+	Hash         string `json:"hash,omitempty"`
+}
+
+// checkpoint is a record that signs the state of the hash chain.
+type checkpoint struct {
+	Checkpoint string `json:"checkpoint"`
+	Timestamp  string `json:"timestamp"`
+	Signature  string `json:"signature,omitempty"`
+}
+
+type hashChain struct {
+	lock            sync.Mutex
+	key             ed25519.PrivateKey
+	interval        int
+	sequence        uint64
+	lastHash        string
+	resumed         bool
+	started         bool
+	sinceCheckpoint int
+}
+
+// EnableHashChain chains every record written by l to the previous one. If the log file
+// already ends with a chained record, the chain is continued from it.
+func (l *LogWriter) EnableHashChain(opts ChainOptions) error {
+	if l == nil || !opts.Enabled {
+		return nil
+	}
+
+	chain := &hashChain{
+		interval: opts.CheckpointInterval,
+	}
+	if chain.interval <= 0 {
+		chain.interval = defaultCheckpointInterval
+	}
+
+	if opts.SigningKeyPath != "" {
+		key, err := LoadSigningKey(opts.SigningKeyPath)
+		if err != nil {
+			return err
+		}
+		chain.key = key
+	}
+
+	if l.path != "" {
+		if err := chain.resume(l.path); err != nil {
+			return fmt.Errorf("failed to resume audit log hash chain from %s: %w", l.path, err)
+		}
+	}
+
+	l.chain = chain
+	return nil
+}
+
+// LoadSigningKey reads a PEM encoded PKCS #8 ed25519 private key.
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse audit log signing key %s: %w", path, err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("audit log signing key %s is a %T, not an ed25519 key", path, key)
+	}
+	return edKey, nil
+}
+
+// LoadVerificationKey reads a PEM encoded ed25519 public key, or derives it from a PEM encoded private key.
+func LoadVerificationKey(path string) (ed25519.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type == "PRIVATE KEY" {
+		private, err := LoadSigningKey(path)
+		if err != nil {
+			return nil, err
+		}
+		return private.Public().(ed25519.PublicKey), nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse audit log verification key %s: %w", path, err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("audit log verification key %s is a %T, not an ed25519 key", path, key)
+	}
+	return edKey, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
+
+// write appends the chain fields to record and writes it to out, preceded by a start or resume
+// checkpoint for the first record and followed by a periodic checkpoint every interval records.
+func (c *hashChain) write(out io.Writer, record []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.started {
+		c.started = true
+		kind := CheckpointStart
+		if c.resumed {
+			kind = CheckpointResume
+		}
+		if err := c.writeCheckpoint(out, kind); err != nil {
+			return err
+		}
+	}
+
+	if _, err := out.Write(c.link(record)); err != nil {
+		return err
+	}
+
+	c.sinceCheckpoint++
+	if c.sinceCheckpoint >= c.interval {
+		return c.writeCheckpoint(out, CheckpointPeriodic)
+	}
+	return nil
+}
+
+// stop writes a final checkpoint so the last records of the log are covered by a signature.
+func (c *hashChain) stop(out io.Writer) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.started || c.sinceCheckpoint == 0 {
+		return nil
+	}
+	return c.writeCheckpoint(out, CheckpointStop)
+}
+
+func (c *hashChain) writeCheckpoint(out io.Writer, kind string) error {
+	cp := checkpoint{
+		Checkpoint: kind,
+		Timestamp:  time.Now().Format(time.RFC3339),
+	}
+	if c.key != nil {
+		cp.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(c.key, checkpointMessage(c.sequence+1, c.lastHash)))
+	}
+
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log checkpoint: %w", err)
+	}
+	c.sinceCheckpoint = 0
+	if _, err := out.Write(c.link(data)); err != nil {
+		return fmt.Errorf("failed to write audit log checkpoint: %w", err)
+	}
+	return nil
+}
+
+// link adds the sequence number and previous hash to the JSON object in record, then appends the hash of the result.
+func (c *hashChain) link(record []byte) []byte {
+	c.sequence++
+
+	body := chainBody(record, c.sequence, c.lastHash)
+	sum := sha256.Sum256(body)
+	c.lastHash = hex.EncodeToString(sum[:])
+
+	line := make([]byte, 0, len(body)+len(c.lastHash)+12)
+	line = append(line, body[:len(body)-1]...)
+	line = append(line, `,"hash":"`...)
+	line = append(line, c.lastHash...)
+	line = append(line, "\"}\n"...)
+	return line
+}
+
+// resume continues the chain from the last record in the file at path.
+func (c *hashChain) resume(path string) error {
+	line, err := lastLine(path)
+	if err != nil || len(line) == 0 {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	var fields chainFields
+	if err := json.Unmarshal(line, &fields); err != nil || fields.Hash == "" {
+		// the file was written without the hash chain, start a new chain
+		return nil
+	}
+	c.sequence = fields.Sequence
+	c.lastHash = fields.Hash
+	c.resumed = true
+	return nil
+}
+
+func chainBody(record []byte, sequence uint64, previousHash string) []byte {
+	record = bytes.TrimSuffix(bytes.TrimSpace(record), []byte("}"))
+
+	body := make([]byte, 0, len(record)+len(previousHash)+48)
+	body = append(body, record...)
+	if !bytes.Equal(record, []byte("{")) {
+		body = append(body, ',')
+	}
+	body = append(body, fmt.Sprintf(`"sequence":%d,"previousHash":"%s"}`, sequence, previousHash)...)
+	return body
+}
+
+// checkpointMessage is the data signed by a checkpoint: its own sequence number and the hash of the record before it.
+func checkpointMessage(sequence uint64, previousHash string) []byte {
+	return []byte(fmt.Sprintf("%d:%s", sequence, previousHash))
+}
+
+// lastLine returns the last non-empty line of the file at path.
+func lastLine(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tail   []byte
+		offset = info.Size()
+	)
+	for offset > 0 {
+		size := int64(lastLineChunkSize)
+		if offset < size {
+			size = offset
+		}
+		offset -= size
+
+		chunk := make([]byte, size)
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+
+		trimmed := bytes.TrimRight(tail, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+	}
+	return bytes.TrimRight(tail, "\n"), nil
+}
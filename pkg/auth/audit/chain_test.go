@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSigningKey(t *testing.T, dir string) (string, ed25519.PublicKey) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	return path, public
+}
+
+func writeChain(t *testing.T, writer *LogWriter, records int) {
+	for i := 0; i < records; i++ {
+		require.NoError(t, writer.write([]byte(fmt.Sprintf("{\"auditID\":\"%d\"}\n", i))))
+	}
+}
+
+func verify(t *testing.T, public ed25519.PublicKey, data []byte) *ChainVerifier {
+	verifier := &ChainVerifier{PublicKey: public}
+	require.NoError(t, verifier.Verify("audit.log", bytes.NewReader(data)))
+	return verifier
+}
+
+func TestHashChain(t *testing.T) {
+	dir := t.TempDir()
+	keyPath, public := writeSigningKey(t, dir)
+
+	var buf bytes.Buffer
+	writer := &LogWriter{Level: LevelMetadata, Output: nopCloser{Writer: &buf}}
+	require.NoError(t, writer.EnableHashChain(ChainOptions{Enabled: true, SigningKeyPath: keyPath, CheckpointInterval: 2}))
+
+	writeChain(t, writer, 5)
+	require.NoError(t, writer.chain.stop(writer.Output))
+
+	verifier := verify(t, public, buf.Bytes())
+	assert.Empty(t, verifier.Problems)
+	assert.Equal(t, 5, verifier.Records)
+	// start, two periodic and stop
+	assert.Equal(t, 4, verifier.Checkpoints)
+	assert.Equal(t, 0, verifier.Unprotected)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 9)
+	assert.Contains(t, lines[1], `"auditID":"0","sequence":2,"previousHash":"`)
+
+	t.Run("modified record", func(t *testing.T) {
+		modified := strings.Replace(buf.String(), `"auditID":"1"`, `"auditID":"x"`, 1)
+		verifier := verify(t, public, []byte(modified))
+		require.Len(t, verifier.Problems, 1)
+		assert.Contains(t, verifier.Problems[0].Message, "was modified")
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		removed := append(append([]string{}, lines[:3]...), lines[4:]...)
+		verifier := verify(t, public, []byte(strings.Join(removed, "\n")))
+		require.Len(t, verifier.Problems, 1)
+		assert.Contains(t, verifier.Problems[0].Message, "sequence gap")
+		assert.Equal(t, 4, verifier.Problems[0].Line)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		verifier := verify(t, other, buf.Bytes())
+		assert.Len(t, verifier.Problems, 4)
+		assert.Equal(t, 5, verifier.Unprotected)
+	})
+}
+
+func TestHashChainResume(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.log")
+
+	writer := NewLogWriter(path, LevelMetadata, 1, 1, 100)
+	require.NoError(t, writer.EnableHashChain(ChainOptions{Enabled: true}))
+	writeChain(t, writer, 3)
+	require.NoError(t, writer.Output.Close())
+
+	// a new writer, as after a restart, continues the chain found in the file
+	writer = NewLogWriter(path, LevelMetadata, 1, 1, 100)
+	require.NoError(t, writer.EnableHashChain(ChainOptions{Enabled: true}))
+	writeChain(t, writer, 2)
+	require.NoError(t, writer.Output.Close())
+
+	files, err := RotatedFiles(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{path}, files)
+
+	verifier := &ChainVerifier{}
+	require.NoError(t, verifyFile(verifier, path))
+	assert.Empty(t, verifier.Problems)
+	assert.Equal(t, 5, verifier.Records)
+	assert.Equal(t, 2, verifier.Checkpoints)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"checkpoint":"resume"`)
+}
+
+func TestRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"rancher-api-audit.log",
+		"rancher-api-audit-2022-01-02T00-00-00.000.log.gz",
+		"rancher-api-audit-2022-01-01T00-00-00.000.log",
+		"rancher-api-audit-other.log",
+		"unrelated.log",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+
+	files, err := RotatedFiles(filepath.Join(dir, "rancher-api-audit.log"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "rancher-api-audit-2022-01-01T00-00-00.000.log"),
+		filepath.Join(dir, "rancher-api-audit-2022-01-02T00-00-00.000.log.gz"),
+		filepath.Join(dir, "rancher-api-audit.log"),
+	}, files)
+}
@@ -27,6 +27,7 @@ func NewAuditLogMiddleware(auditWriter *LogWriter) (func(http.Handler) http.Hand
 			next:            next,
 			auditWriter:     auditWriter,
 			sanitizingRegex: sensitiveRegex,
+			policy:          newPolicyLoader(),
 			errMap:          make(map[string]time.Time),
 			errLock:         &sync.Mutex{},
 		}
@@ -56,6 +57,7 @@ type auditHandler struct {
 	next            http.Handler
 	auditWriter     *LogWriter
 	sanitizingRegex *regexp.Regexp
+	policy          *policyLoader
 	errMap          map[string]time.Time
 	errLock         *sync.Mutex
 }
@@ -71,7 +73,13 @@ func (h auditHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	context := context.WithValue(req.Context(), userKey, user)
 	req = req.WithContext(context)
 
-	auditLog, err := newAuditLog(h.auditWriter, req, h.sanitizingRegex)
+	level := h.policy.Policy().LevelFor(req, user, h.auditWriter.Level)
+	if level == LevelNull {
+		h.next.ServeHTTP(rw, req)
+		return
+	}
+
+	auditLog, err := newAuditLog(h.auditWriter, level, req, h.sanitizingRegex)
 	if err != nil {
 		util.ReturnHTTPError(rw, req, http.StatusInternalServerError, err.Error())
 		return
@@ -3,12 +3,16 @@
 import (
 	"context"
 
+	"github.com/sirupsen/logrus"
 	lumberjack "gopkg.in/natefinch/lumberjack.v2"
 )
 
 type LogWriter struct {
 	Level  Level
-	Output *lumberjack.Logger
+	Output Sink
+
+	path  string
+	chain *hashChain
 }
 
 func (l *LogWriter) Start(ctx context.Context) {
@@ -17,22 +21,53 @@ func (l *LogWriter) Start(ctx context.Context) {
 	}
 	go func() {
 		<-ctx.Done()
+		if l.chain != nil {
+			if err := l.chain.stop(l.Output); err != nil {
+				logrus.Warnf("Failed to write final audit log checkpoint: %v", err)
+			}
+		}
 		l.Output.Close()
 	}()
 }
 
-func NewLogWriter(path string, level Level, maxAge, maxBackup, maxSize int) *LogWriter {
-	if path == "" || level == LevelNull {
+// write writes a single record to the output, adding it to the hash chain if it is enabled.
+func (l *LogWriter) write(record []byte) error {
+	if l.chain != nil {
+		return l.chain.write(l.Output, record)
+	}
+	_, err := l.Output.Write(record)
+	return err
+}
+
+// NewLogWriter returns a LogWriter that writes to a rotated file at path, if set, and to every
+// additional sink. Nil is returned when auditing is disabled or there is nowhere to write to.
+func NewLogWriter(path string, level Level, maxAge, maxBackup, maxSize int, sinks ...Sink) *LogWriter {
+	if level == LevelNull || (path == "" && len(sinks) == 0) {
 		return nil
 	}
 
-	return &LogWriter{
-		Level: level,
-		Output: &lumberjack.Logger{
+	var outputs multiSink
+	if path != "" {
+		outputs = append(outputs, &lumberjack.Logger{
 			Filename:   path,
 			MaxAge:     maxAge,
 			MaxBackups: maxBackup,
 			MaxSize:    maxSize,
-		},
+		})
+	}
+	outputs = append(outputs, sinks...)
+
+	if len(outputs) == 1 {
+		return &LogWriter{
+			Level:  level,
+			Output: outputs[0],
+			path:   path,
+		}
+	}
+
+	return &LogWriter{
+		Level:  level,
+		Output: outputs,
+		path:   path,
 	}
 }
@@ -0,0 +1,242 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// Level names used in audit policies.
+const (
+	LevelNameNone            = "None"
+	LevelNameMetadata        = "Metadata"
+	LevelNameRequest         = "Request"
+	LevelNameRequestResponse = "RequestResponse"
+)
+
+// Policy is an ordered list of rules deciding the audit level of a request.
+// The first rule that matches a request determines its level. Requests that
+// match no rule are logged at the level the LogWriter was configured with.
+type Policy struct {
+	Rules []PolicyRule `json:"rules,omitempty"`
+}
+
+// PolicyRule matches requests and assigns them an audit level. Every non-empty
+// matcher must match for the rule to apply; an empty matcher matches everything.
+type PolicyRule struct {
+	// Level is one of None, Metadata, Request or RequestResponse.
+	Level string `json:"level"`
+	// Users matches the name of the user making the request.
+	Users []string `json:"users,omitempty"`
+	// UserGroups matches if the user is a member of any of the groups.
+	UserGroups []string `json:"userGroups,omitempty"`
+	// Methods matches the HTTP method of the request.
+	Methods []string `json:"methods,omitempty"`
+	// URIPrefixes matches the beginning of the request URI, including the query.
+	URIPrefixes []string `json:"uriPrefixes,omitempty"`
+	// Resources matches the Norman (/v3) or Steve (/v1) type of the request, for example
+	// "tokens" or "management.cattle.io.clusters". Kubernetes API requests are matched as
+	// "<resource>" for the core group and "<group>.<resource>" otherwise, the same way Steve names them.
+	Resources []string `json:"resources,omitempty"`
+
+	level Level
+}
+
+// ParseLevel converts a level name used in audit policies to a Level.
+func ParseLevel(name string) (Level, error) {
+	switch name {
+	case LevelNameNone:
+		return LevelNull, nil
+	case LevelNameMetadata:
+		return LevelMetadata, nil
+	case LevelNameRequest:
+		return LevelRequest, nil
+	case LevelNameRequestResponse:
+		return LevelRequestResponse, nil
+	}
+	return LevelNull, fmt.Errorf("invalid audit level %q", name)
+}
+
+// ParsePolicy parses an audit policy in JSON or YAML format.
+func ParsePolicy(data string) (*Policy, error) {
+	policy := &Policy{}
+	if strings.TrimSpace(data) == "" {
+		return policy, nil
+	}
+	if err := yaml.Unmarshal([]byte(data), policy); err != nil {
+		return nil, fmt.Errorf("failed to parse audit policy: %w", err)
+	}
+	for i := range policy.Rules {
+		level, err := ParseLevel(policy.Rules[i].Level)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		policy.Rules[i].level = level
+	}
+	return policy, nil
+}
+
+// LevelFor returns the audit level of the request made by user. If no rule matches defaultLevel is returned.
+func (p *Policy) LevelFor(req *http.Request, user *User, defaultLevel Level) Level {
+	if p == nil || len(p.Rules) == 0 {
+		return defaultLevel
+	}
+
+	uri := req.RequestURI
+	if uri == "" {
+		uri = req.URL.RequestURI()
+	}
+	resource := resourceFromPath(req.URL.Path)
+
+	for _, rule := range p.Rules {
+		if rule.matches(req.Method, uri, resource, user) {
+			return rule.level
+		}
+	}
+	return defaultLevel
+}
+
+func (r *PolicyRule) matches(method, uri, resource string, user *User) bool {
+	if len(r.Methods) > 0 && !containsFold(r.Methods, method) {
+		return false
+	}
+	if len(r.URIPrefixes) > 0 && !hasAnyPrefix(uri, r.URIPrefixes) {
+		return false
+	}
+	if len(r.Resources) > 0 && (resource == "" || !containsFold(r.Resources, resource)) {
+		return false
+	}
+	if len(r.Users) > 0 && (user == nil || !isExist(r.Users, user.Name)) {
+		return false
+	}
+	if len(r.UserGroups) > 0 {
+		if user == nil {
+			return false
+		}
+		var found bool
+		for _, group := range user.Group {
+			if isExist(r.UserGroups, group) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceFromPath returns the resource type addressed by a Norman, Steve or Kubernetes API path.
+func resourceFromPath(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+
+	switch parts[0] {
+	case "v3", "v3-public":
+		// /v3/cluster/<id>/<type> and /v3/project/<id>/<type> are scoped to a cluster or project
+		if (parts[1] == "cluster" || parts[1] == "project") && len(parts) >= 4 {
+			return parts[3]
+		}
+		return parts[1]
+	case "v1":
+		return parts[1]
+	case "k8s":
+		// /k8s/clusters/<id>/<kubernetes api path>
+		if len(parts) >= 4 && parts[1] == "clusters" {
+			return kubernetesResource(parts[3:])
+		}
+		return ""
+	}
+	return kubernetesResource(parts)
+}
+
+// kubernetesResource returns the resource of an /api or /apis path using Steve's group.resource naming.
+func kubernetesResource(parts []string) string {
+	var group string
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		group = parts[1]
+		parts = parts[3:]
+	default:
+		return ""
+	}
+
+	resource := parts[0]
+	if resource == "namespaces" && len(parts) >= 3 {
+		resource = parts[2]
+	}
+	if group == "" {
+		return resource
+	}
+	return group + "." + resource
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// policyLoader parses the audit policy setting and caches the result until the setting changes.
+type policyLoader struct {
+	get func() string
+
+	lock   sync.RWMutex
+	raw    string
+	policy *Policy
+}
+
+func newPolicyLoader() *policyLoader {
+	return &policyLoader{
+		get: settings.AuditLogPolicy.Get,
+	}
+}
+
+// Policy returns the current audit policy. An invalid policy is reported once and ignored,
+// so every request is logged at the default level until it is fixed.
+func (p *policyLoader) Policy() *Policy {
+	raw := p.get()
+
+	p.lock.RLock()
+	if raw == p.raw {
+		defer p.lock.RUnlock()
+		return p.policy
+	}
+	p.lock.RUnlock()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if raw == p.raw {
+		return p.policy
+	}
+
+	policy, err := ParsePolicy(raw)
+	if err != nil {
+		logrus.Errorf("Ignoring invalid audit log policy in setting %s: %v", settings.AuditLogPolicy.Name, err)
+	}
+	p.raw = raw
+	p.policy = policy
+	return policy
+}
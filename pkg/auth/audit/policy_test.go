@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/v3/tokens", want: "tokens"},
+		{path: "/v3/tokens/token-abc", want: "tokens"},
+		{path: "/v3-public/localProviders/local", want: "localProviders"},
+		{path: "/v3/project/c-abc:p-xyz/workloads/deployment:default:nginx", want: "workloads"},
+		{path: "/v3/cluster/c-abc/namespaces", want: "namespaces"},
+		{path: "/v1/management.cattle.io.clusters/c-abc", want: "management.cattle.io.clusters"},
+		{path: "/v1/pods/default/nginx", want: "pods"},
+		{path: "/k8s/clusters/c-abc/api/v1/namespaces/default/pods/nginx", want: "pods"},
+		{path: "/k8s/clusters/c-abc/api/v1/namespaces/default", want: "namespaces"},
+		{path: "/k8s/clusters/c-abc/apis/apps/v1/namespaces/default/deployments", want: "apps.deployments"},
+		{path: "/apis/management.cattle.io/v3/clusters", want: "management.cattle.io.clusters"},
+		{path: "/v3", want: ""},
+		{path: "/healthz", want: ""},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, resourceFromPath(test.path), test.path)
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy("")
+	require.NoError(t, err)
+	assert.Empty(t, policy.Rules)
+
+	_, err = ParsePolicy(`{"rules":[{"level":"Everything"}]}`)
+	assert.Error(t, err)
+
+	_, err = ParsePolicy(`rules: [`)
+	assert.Error(t, err)
+
+	policy, err = ParsePolicy(`
+rules:
+- level: RequestResponse
+  resources: ["tokens"]
+- level: None
+  methods: ["GET"]
+  uriPrefixes: ["/v1/"]
+`)
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 2)
+	assert.Equal(t, LevelRequestResponse, policy.Rules[0].level)
+	assert.Equal(t, LevelNull, policy.Rules[1].level)
+}
+
+func TestPolicyLevelFor(t *testing.T) {
+	policy, err := ParsePolicy(`{"rules":[
+		{"level":"None","users":["system:serviceaccount:cattle-system:rancher"]},
+		{"level":"RequestResponse","resources":["tokens"]},
+		{"level":"Request","userGroups":["admins"],"methods":["PUT","POST"],"resources":["management.cattle.io.clusters","clusters"]},
+		{"level":"None","methods":["GET"],"uriPrefixes":["/v1/"]}
+	]}`)
+	require.NoError(t, err)
+
+	admin := &User{Name: "u-admin", Group: []string{"system:authenticated", "admins"}}
+	user := &User{Name: "u-user", Group: []string{"system:authenticated"}}
+
+	tests := []struct {
+		name   string
+		method string
+		uri    string
+		user   *User
+		want   Level
+	}{
+		{
+			name:   "ignored user",
+			method: http.MethodPost,
+			uri:    "/v3/tokens",
+			user:   &User{Name: "system:serviceaccount:cattle-system:rancher"},
+			want:   LevelNull,
+		},
+		{
+			name:   "token request",
+			method: http.MethodPost,
+			uri:    "/v3/tokens?action=logout",
+			user:   user,
+			want:   LevelRequestResponse,
+		},
+		{
+			name:   "cluster edit by admin",
+			method: http.MethodPut,
+			uri:    "/v3/clusters/c-abc",
+			user:   admin,
+			want:   LevelRequest,
+		},
+		{
+			name:   "cluster edit by other user falls back to default",
+			method: http.MethodPut,
+			uri:    "/v1/management.cattle.io.clusters/c-abc",
+			user:   user,
+			want:   LevelMetadata,
+		},
+		{
+			name:   "steve watch",
+			method: http.MethodGet,
+			uri:    "/v1/subscribe",
+			user:   admin,
+			want:   LevelNull,
+		},
+		{
+			name:   "no match",
+			method: http.MethodGet,
+			uri:    "/v3/settings",
+			user:   user,
+			want:   LevelMetadata,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, test.uri, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, policy.LevelFor(req, test.user, LevelMetadata))
+		})
+	}
+
+	var nilPolicy *Policy
+	req, err := http.NewRequest(http.MethodGet, "/v3/tokens", nil)
+	require.NoError(t, err)
+	assert.Equal(t, LevelRequest, nilPolicy.LevelFor(req, user, LevelRequest))
+}
+
+func TestPolicyLoader(t *testing.T) {
+	value := `{"rules":[{"level":"None"}]}`
+	loader := &policyLoader{get: func() string { return value }}
+
+	first := loader.Policy()
+	require.NotNil(t, first)
+	assert.Len(t, first.Rules, 1)
+	assert.Same(t, first, loader.Policy(), "expected the parsed policy to be cached")
+
+	value = `{"rules":[{"level":"None"},{"level":"Metadata"}]}`
+	assert.Len(t, loader.Policy().Rules, 2, "expected the policy to be reloaded after the setting changed")
+
+	value = `{"rules":[{"level":"bogus"}]}`
+	assert.Nil(t, loader.Policy(), "expected an invalid policy to be ignored")
+}
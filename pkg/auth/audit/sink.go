@@ -0,0 +1,205 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const defaultSinkBufferSize = 10000
+
+// ErrSinkBufferFull is returned when an asynchronous sink cannot keep up and a record has to be dropped.
+var ErrSinkBufferFull = errors.New("audit log sink buffer is full, dropping record")
+
+// Sink is a destination for audit log records. Every call to Write receives exactly one
+// newline terminated JSON record.
+type Sink interface {
+	io.WriteCloser
+}
+
+// SinkOptions configures the audit log destinations used in addition to the log file.
+type SinkOptions struct {
+	// WebhookURL is an HTTP(S) endpoint records are POSTed to in batches.
+	WebhookURL string
+	// SyslogAddress is a tcp:// or tls:// address of an RFC5424 syslog receiver.
+	SyslogAddress string
+	// Stdout enables writing records to the standard output of the Rancher process.
+	Stdout bool
+	// BufferSize is the number of records each sink can queue before records are dropped.
+	BufferSize int
+}
+
+// NewSinks creates the sinks described by opts. Every sink is wrapped in an asynchronous
+// buffered writer so that a slow destination does not block API requests.
+func NewSinks(opts SinkOptions) ([]Sink, error) {
+	var sinks []Sink
+
+	if opts.Stdout {
+		sinks = append(sinks, NewAsyncSink("stdout", nopCloser{Writer: os.Stdout}, opts.BufferSize))
+	}
+
+	if opts.WebhookURL != "" {
+		webhook, err := NewWebhookSink(WebhookConfig{URL: opts.WebhookURL})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewAsyncSink("webhook", webhook, opts.BufferSize))
+	}
+
+	if opts.SyslogAddress != "" {
+		syslog, err := NewSyslogSink(SyslogConfig{Address: opts.SyslogAddress})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewAsyncSink("syslog", syslog, opts.BufferSize))
+	}
+
+	return sinks, nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// multiSink writes every record to all of its sinks.
+type multiSink []Sink
+
+func (m multiSink) Write(p []byte) (int, error) {
+	var errs []error
+	for _, sink := range m {
+		if _, err := sink.Write(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return 0, joinErrors(errs)
+	}
+	return len(p), nil
+}
+
+func (m multiSink) Close() error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+	return nil
+}
+
+func joinErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	err := errs[0]
+	for _, e := range errs[1:] {
+		// Using %v for the additional errors because you can currently only wrap one error.
+		err = fmt.Errorf("%w; %v", err, e)
+	}
+	return err
+}
+
+// asyncSink queues records in memory and writes them to the wrapped sink from a separate goroutine.
+type asyncSink struct {
+	name    string
+	sink    Sink
+	records chan []byte
+	done    chan struct{}
+
+	closeOnce sync.Once
+	lock      sync.RWMutex
+	closed    bool
+
+	errLock  sync.Mutex
+	lastErr  time.Time
+	dropped  int
+	lastDrop time.Time
+}
+
+// NewAsyncSink wraps sink in a buffered writer. Write never blocks; once bufferSize records are
+// queued, further records are dropped and ErrSinkBufferFull is returned.
+func NewAsyncSink(name string, sink Sink, bufferSize int) Sink {
+	if bufferSize <= 0 {
+		bufferSize = defaultSinkBufferSize
+	}
+	a := &asyncSink{
+		name:    name,
+		sink:    sink,
+		records: make(chan []byte, bufferSize),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *asyncSink) Write(p []byte) (int, error) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.closed {
+		return 0, fmt.Errorf("audit log sink %s is closed", a.name)
+	}
+
+	// The caller may reuse p after Write returns.
+	record := make([]byte, len(p))
+	copy(record, p)
+
+	select {
+	case a.records <- record:
+		return len(p), nil
+	default:
+		a.recordDrop()
+		return 0, fmt.Errorf("%w: %s", ErrSinkBufferFull, a.name)
+	}
+}
+
+// Close stops accepting records, waits for queued records to be written and closes the wrapped sink.
+func (a *asyncSink) Close() error {
+	a.closeOnce.Do(func() {
+		a.lock.Lock()
+		a.closed = true
+		close(a.records)
+		a.lock.Unlock()
+	})
+	<-a.done
+	return a.sink.Close()
+}
+
+func (a *asyncSink) run() {
+	defer close(a.done)
+	for record := range a.records {
+		if _, err := a.sink.Write(record); err != nil {
+			a.logError(err)
+		}
+	}
+}
+
+func (a *asyncSink) recordDrop() {
+	a.errLock.Lock()
+	defer a.errLock.Unlock()
+	a.dropped++
+	if time.Since(a.lastDrop) > errorDebounceTime {
+		logrus.Warnf("Audit log sink %s is not keeping up, dropped %d records", a.name, a.dropped)
+		a.dropped = 0
+		a.lastDrop = time.Now()
+	}
+}
+
+// logError logs write failures at most every errorDebounceTime so an unreachable sink does not flood the rancher logs.
+func (a *asyncSink) logError(err error) {
+	a.errLock.Lock()
+	defer a.errLock.Unlock()
+	if time.Since(a.lastErr) > errorDebounceTime {
+		logrus.Warnf("Failed to write audit log to sink %s: %v", a.name, err)
+		a.lastErr = time.Now()
+	}
+}
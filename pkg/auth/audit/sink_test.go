@@ -0,0 +1,189 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockingSink struct {
+	release chan struct{}
+	lock    sync.Mutex
+	buf     bytes.Buffer
+}
+
+func (b *blockingSink) Write(p []byte) (int, error) {
+	<-b.release
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *blockingSink) Close() error { return nil }
+
+func TestAsyncSinkDoesNotBlock(t *testing.T) {
+	slow := &blockingSink{release: make(chan struct{})}
+	sink := NewAsyncSink("test", slow, 1)
+
+	// the first record is picked up by the writer goroutine, the second fills the buffer
+	_, err := sink.Write([]byte("1\n"))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(sink.(*asyncSink).records) == 0 }, time.Second, time.Millisecond)
+	_, err = sink.Write([]byte("2\n"))
+	require.NoError(t, err)
+
+	_, err = sink.Write([]byte("3\n"))
+	assert.True(t, errors.Is(err, ErrSinkBufferFull), "expected buffer full error, got %v", err)
+
+	close(slow.release)
+	require.NoError(t, sink.Close())
+	assert.Equal(t, "1\n2\n", slow.buf.String())
+
+	_, err = sink.Write([]byte("4\n"))
+	assert.Error(t, err, "expected writes after close to fail")
+}
+
+func TestMultiSink(t *testing.T) {
+	var a, b bytes.Buffer
+	failing := nopCloser{Writer: failingWriter{}}
+	m := multiSink{nopCloser{Writer: &a}, failing, nopCloser{Writer: &b}}
+
+	_, err := m.Write([]byte("record\n"))
+	assert.Error(t, err)
+	assert.Equal(t, "record\n", a.String())
+	assert.Equal(t, "record\n", b.String())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWebhookSink(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		attempts int
+		received []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts++
+		// fail the first attempt to exercise the retry
+		if attempts == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, contentTypeNDJSON, req.Header.Get("Content-Type"))
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		received = append(received, string(body))
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(WebhookConfig{
+		URL:          server.URL,
+		BatchSize:    2,
+		FlushPeriod:  time.Hour,
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	for _, record := range []string{`{"a":1}`, `{"a":2}`, `{"a":3}`} {
+		_, err = sink.Write([]byte(record + "\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, sink.Close())
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []string{"{\"a\":1}\n{\"a\":2}\n", "{\"a\":3}\n"}, received)
+}
+
+func TestWebhookSinkInvalidURL(t *testing.T) {
+	_, err := NewWebhookSink(WebhookConfig{URL: "ftp://example.com"})
+	assert.Error(t, err)
+}
+
+func TestSyslogSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	messages := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			// octet counting framing: "<length> <message>"
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err := io.ReadFull(reader, msg); err != nil {
+				return
+			}
+			messages <- string(msg)
+		}
+	}()
+
+	sink, err := NewSyslogSink(SyslogConfig{Address: "tcp://" + listener.Addr().String(), Hostname: "rancher-0"})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	for _, record := range []string{`{"a":1}`, `{"a":2}`} {
+		_, err = sink.Write([]byte(record + "\n"))
+		require.NoError(t, err)
+	}
+
+	for _, record := range []string{`{"a":1}`, `{"a":2}`} {
+		select {
+		case msg := <-messages:
+			assert.True(t, strings.HasPrefix(msg, "<110>1 "), "unexpected priority in %q", msg)
+			assert.Contains(t, msg, " rancher-0 rancher ")
+			assert.True(t, strings.HasSuffix(msg, " audit - "+record), "unexpected message %q", msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for syslog message")
+		}
+	}
+}
+
+func TestSyslogSinkInvalidAddress(t *testing.T) {
+	_, err := NewSyslogSink(SyslogConfig{Address: "udp://127.0.0.1:514"})
+	assert.Error(t, err)
+	_, err = NewSyslogSink(SyslogConfig{Address: "tcp://"})
+	assert.Error(t, err)
+}
+
+func TestNewLogWriter(t *testing.T) {
+	assert.Nil(t, NewLogWriter("", LevelMetadata, 1, 1, 1), "expected nil writer without destinations")
+	assert.Nil(t, NewLogWriter("", LevelNull, 1, 1, 1, nopCloser{Writer: io.Discard}), "expected nil writer when disabled")
+
+	var buf bytes.Buffer
+	writer := NewLogWriter("", LevelMetadata, 1, 1, 1, nopCloser{Writer: &buf})
+	require.NotNil(t, writer)
+	_, err := writer.Output.Write([]byte("record\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "record\n", buf.String())
+}
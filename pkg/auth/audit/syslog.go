@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// syslogFacilityLogAudit is the RFC5424 "log audit" facility.
+	syslogFacilityLogAudit = 13
+	// syslogSeverityInfo is the RFC5424 "informational" severity.
+	syslogSeverityInfo = 6
+	syslogAppName      = "rancher"
+	syslogMsgID        = "audit"
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+)
+
+// SyslogConfig configures a syslog sink.
+type SyslogConfig struct {
+	// Address of the receiver in the form tcp://host:port or tls://host:port.
+	Address string
+	// TLSConfig is used for tls:// addresses. The system roots are used when nil.
+	TLSConfig *tls.Config
+	// Hostname is reported in the HOSTNAME field, the OS hostname is used when empty.
+	Hostname string
+}
+
+type syslogSink struct {
+	network  string
+	address  string
+	tls      *tls.Config
+	hostname string
+	procID   string
+
+	lock sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogSink returns a sink that sends every record as an RFC5424 message over TCP or TLS
+// using octet counting framing (RFC6587). The connection is re-established on the next write after a failure.
+func NewSyslogSink(config SyslogConfig) (Sink, error) {
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit log syslog address: %w", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid audit log syslog address %q: missing host", config.Address)
+	}
+
+	s := &syslogSink{
+		network:  u.Scheme,
+		address:  u.Host,
+		hostname: config.Hostname,
+		procID:   fmt.Sprint(os.Getpid()),
+	}
+
+	switch u.Scheme {
+	case "tcp":
+	case "tls":
+		s.tls = config.TLSConfig
+		if s.tls == nil {
+			s.tls = &tls.Config{}
+		}
+		if s.tls.ServerName == "" {
+			s.tls = s.tls.Clone()
+			s.tls.ServerName = u.Hostname()
+		}
+	default:
+		return nil, fmt.Errorf("invalid audit log syslog address %q: scheme must be tcp or tls", config.Address)
+	}
+
+	if s.hostname == "" {
+		s.hostname, _ = os.Hostname()
+		if s.hostname == "" {
+			s.hostname = "-"
+		}
+	}
+
+	return s, nil
+}
+
+func (s *syslogSink) Write(p []byte) (int, error) {
+	msg := s.format(time.Now(), p)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return 0, err
+		}
+	}
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if _, err := s.conn.Write(msg); err != nil {
+		s.conn.Close()
+		s.conn = nil
+		return 0, fmt.Errorf("failed to write to syslog %s: %w", s.address, err)
+	}
+	return len(p), nil
+}
+
+func (s *syslogSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+func (s *syslogSink) connect() error {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if s.tls != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", s.address, s.tls)
+	} else {
+		conn, err = dialer.Dial("tcp", s.address)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog %s: %w", s.address, err)
+	}
+	s.conn = conn
+	return nil
+}
+
+// format builds an octet counted RFC5424 message with the audit record as the MSG part.
+func (s *syslogSink) format(ts time.Time, record []byte) []byte {
+	record = bytes.TrimSuffix(record, []byte("\n"))
+	msg := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		syslogFacilityLogAudit*8+syslogSeverityInfo,
+		ts.UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		syslogAppName,
+		s.procID,
+		syslogMsgID,
+		record)
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
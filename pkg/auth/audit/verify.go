@@ -0,0 +1,265 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/pkg/reexec"
+	"github.com/urfave/cli"
+)
+
+const (
+	// lumberjackBackupTimeFormat is the timestamp lumberjack adds to the name of rotated files.
+	lumberjackBackupTimeFormat = "2006-01-02T15-04-05.000"
+	maxRecordSize              = 64 * 1024 * 1024
+)
+
+var hashSuffix = regexp.MustCompile(`,"hash":"([0-9a-f]{64})"\}$`)
+
+// VerifyProblem describes a record that breaks the hash chain.
+type VerifyProblem struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (p VerifyProblem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+}
+
+// ChainVerifier checks the hash chain of audit log files. Files must be passed to Verify oldest first.
+type ChainVerifier struct {
+	// PublicKey verifies checkpoint signatures. Signatures are not checked when it is nil.
+	PublicKey ed25519.PublicKey
+
+	Records     int
+	Checkpoints int
+	// Unprotected is the number of records after the last signed checkpoint.
+	Unprotected int
+	Problems    []VerifyProblem
+
+	sequence uint64
+	lastHash string
+	linked   bool
+}
+
+// Verify checks every record read from r. name is only used to report problems.
+func (v *ChainVerifier) Verify(name string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		v.verifyLine(name, lineNumber, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return nil
+}
+
+func (v *ChainVerifier) verifyLine(name string, lineNumber int, line []byte) {
+	problem := func(format string, args ...interface{}) {
+		v.Problems = append(v.Problems, VerifyProblem{File: name, Line: lineNumber, Message: fmt.Sprintf(format, args...)})
+	}
+
+	match := hashSuffix.FindSubmatchIndex(line)
+	if match == nil {
+		problem("record is not part of the hash chain")
+		v.linked = false
+		return
+	}
+	hash := string(line[match[2]:match[3]])
+	body := append(append([]byte{}, line[:match[0]]...), '}')
+
+	var fields struct {
+		chainFields
+		checkpoint
+	}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		problem("record is not valid JSON: %v", err)
+		v.linked = false
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	if hex.EncodeToString(sum[:]) != hash {
+		problem("record %d was modified: hash does not match its content", fields.Sequence)
+	}
+
+	switch {
+	case fields.PreviousHash == "" && fields.Sequence == 1:
+		if v.linked {
+			problem("a new hash chain was started, records before it cannot be linked to it")
+		}
+	case !v.linked:
+		// first record seen, older records were rotated away or are not part of the verified files
+	case fields.Sequence != v.sequence+1:
+		problem("sequence gap: expected record %d, found %d", v.sequence+1, fields.Sequence)
+	case fields.PreviousHash != v.lastHash:
+		problem("record %d does not link to the previous record, records were removed or reordered", fields.Sequence)
+	}
+
+	if fields.Checkpoint != "" {
+		v.Checkpoints++
+		v.verifyCheckpoint(fields.Sequence, fields.PreviousHash, fields.Signature, problem)
+	} else {
+		v.Records++
+		v.Unprotected++
+	}
+
+	v.sequence = fields.Sequence
+	v.lastHash = hash
+	v.linked = true
+}
+
+func (v *ChainVerifier) verifyCheckpoint(sequence uint64, previousHash, signature string, problem func(string, ...interface{})) {
+	if v.PublicKey == nil {
+		return
+	}
+	if signature == "" {
+		problem("checkpoint %d is not signed", sequence)
+		return
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !ed25519.Verify(v.PublicKey, checkpointMessage(sequence, previousHash), sig) {
+		problem("checkpoint %d has an invalid signature", sequence)
+		return
+	}
+	v.Unprotected = 0
+}
+
+// RotatedFiles returns the files lumberjack rotated path into followed by path itself, oldest first.
+func RotatedFiles(path string) ([]string, error) {
+	dir := filepath.Dir(path)
+	filename := filepath.Base(path)
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)] + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		timestamp := strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ext)
+		timestamp = strings.TrimPrefix(timestamp, prefix)
+		if len(timestamp) != len(lumberjackBackupTimeFormat) {
+			continue
+		}
+		backups = append(backups, filepath.Join(dir, name))
+	}
+	// the timestamp format sorts chronologically
+	sort.Strings(backups)
+
+	if _, err := os.Stat(path); err == nil {
+		backups = append(backups, path)
+	}
+	return backups, nil
+}
+
+func verifyFile(v *ChainVerifier, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("failed to decompress %s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return v.Verify(path, r)
+}
+
+// RegisterVerifyCommand registers the verify-audit-log command that checks the hash chain of audit log files offline.
+func RegisterVerifyCommand() {
+	reexec.Register("/usr/bin/verify-audit-log", verifyAuditLog)
+	reexec.Register("verify-audit-log", verifyAuditLog)
+}
+
+func verifyAuditLog() {
+	app := cli.NewApp()
+	app.Usage = "Verify the hash chain of the Rancher API audit log"
+	app.ArgsUsage = "[audit log path]"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "key",
+			Usage: "PEM encoded ed25519 public or private key used to verify checkpoint signatures",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		path := c.Args().First()
+		if path == "" {
+			path = "/var/log/auditlog/rancher-api-audit.log"
+		}
+
+		verifier := &ChainVerifier{}
+		if keyPath := c.String("key"); keyPath != "" {
+			key, err := LoadVerificationKey(keyPath)
+			if err != nil {
+				return err
+			}
+			verifier.PublicKey = key
+		}
+
+		files, err := RotatedFiles(path)
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("no audit log files found for %s", path)
+		}
+		for _, file := range files {
+			if err := verifyFile(verifier, file); err != nil {
+				return err
+			}
+		}
+
+		for _, problem := range verifier.Problems {
+			fmt.Fprintln(os.Stdout, problem)
+		}
+		fmt.Fprintf(os.Stdout, "Verified %d records and %d checkpoints in %d files\n", verifier.Records, verifier.Checkpoints, len(files))
+		if verifier.PublicKey != nil && verifier.Unprotected > 0 {
+			fmt.Fprintf(os.Stdout, "%d records after the last signed checkpoint are not covered by a signature\n", verifier.Unprotected)
+		}
+		if len(verifier.Problems) > 0 {
+			return fmt.Errorf("found %d problems in the audit log hash chain", len(verifier.Problems))
+		}
+		return nil
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	contentTypeNDJSON          = "application/x-ndjson"
+	defaultWebhookBatchSize    = 100
+	defaultWebhookFlushPeriod  = 5 * time.Second
+	defaultWebhookMaxRetries   = 5
+	defaultWebhookRetryBackoff = time.Second
+	defaultWebhookTimeout      = 10 * time.Second
+)
+
+// WebhookConfig configures a webhook sink.
+type WebhookConfig struct {
+	// URL records are POSTed to as newline delimited JSON.
+	URL string
+	// BatchSize is the maximum number of records sent in a single request.
+	BatchSize int
+	// FlushPeriod is the maximum time a record is held before the batch is sent.
+	FlushPeriod time.Duration
+	// MaxRetries is the number of times a failed batch is retried before it is dropped.
+	// Zero uses the default, a negative value disables retries.
+	MaxRetries int
+	// RetryBackoff is the initial wait between retries, doubled after every attempt.
+	RetryBackoff time.Duration
+	// Client is the HTTP client used to send batches.
+	Client *http.Client
+}
+
+type webhookSink struct {
+	config WebhookConfig
+
+	lock  sync.Mutex
+	batch bytes.Buffer
+	count int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewWebhookSink returns a sink that batches records and POSTs them to an HTTP endpoint,
+// retrying with an exponential backoff when the endpoint fails.
+func NewWebhookSink(config WebhookConfig) (Sink, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit log webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid audit log webhook URL %q: scheme must be http or https", config.URL)
+	}
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultWebhookBatchSize
+	}
+	if config.FlushPeriod <= 0 {
+		config.FlushPeriod = defaultWebhookFlushPeriod
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	} else if config.MaxRetries == 0 {
+		config.MaxRetries = defaultWebhookMaxRetries
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = defaultWebhookRetryBackoff
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	w := &webhookSink{
+		config: config,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *webhookSink) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	w.batch.Write(p)
+	w.count++
+	full := w.count >= w.config.BatchSize
+	w.lock.Unlock()
+
+	if full {
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Close sends any pending records and stops the periodic flush.
+func (w *webhookSink) Close() error {
+	close(w.stop)
+	<-w.done
+	return w.flush()
+}
+
+func (w *webhookSink) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.config.FlushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// errors are already reported by the failed send, the batch is dropped after MaxRetries
+			_ = w.flush()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *webhookSink) flush() error {
+	w.lock.Lock()
+	if w.count == 0 {
+		w.lock.Unlock()
+		return nil
+	}
+	body := make([]byte, w.batch.Len())
+	copy(body, w.batch.Bytes())
+	count := w.count
+	w.batch.Reset()
+	w.count = 0
+	w.lock.Unlock()
+
+	var err error
+	backoff := w.config.RetryBackoff
+	for attempt := 0; attempt <= w.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if err = w.send(body); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to send %d audit log records to webhook after %d attempts: %w", count, w.config.MaxRetries+1, err)
+}
+
+func (w *webhookSink) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeNDJSON)
+
+	resp, err := w.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package passwordpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+)
+
+// The failed logins of a user are kept in annotations on the user, so every Rancher replica sees the same count.
+const (
+	FailedLoginsAnnotation      = "auth.cattle.io/failed-logins"
+	FailedLoginsSinceAnnotation = "auth.cattle.io/failed-logins-since"
+	LockedUntilAnnotation       = "auth.cattle.io/locked-until"
+
+	lockedReason = "TooManyFailedLogins"
+)
+
+// Lockout locks out users after MaxAttempts failed logins within Window, for Duration.
+type Lockout struct {
+	MaxAttempts int
+	Window      time.Duration
+	Duration    time.Duration
+}
+
+// LockoutFromSettings returns the lockout configured in the account-lockout-* settings.
+func LockoutFromSettings() Lockout {
+	return Lockout{
+		MaxAttempts: settings.AccountLockoutMaxAttempts.GetInt(),
+		Window:      time.Duration(settings.AccountLockoutWindowMinutes.GetInt()) * time.Minute,
+		Duration:    time.Duration(settings.AccountLockoutDurationMinutes.GetInt()) * time.Minute,
+	}
+}
+
+// Enabled returns true if users are locked out at all.
+func (l Lockout) Enabled() bool {
+	return l.MaxAttempts > 0
+}
+
+// LockedUntil returns the time the user is locked out until, or false if the user isn't locked out at now.
+func LockedUntil(user *v32.User, now time.Time) (time.Time, bool) {
+	until, err := time.Parse(time.RFC3339, user.Annotations[LockedUntilAnnotation])
+	if err != nil || !now.Before(until) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// RecordFailure counts a failed login of the user and locks it out once the maximum number of attempts
+// within the window is reached. It returns true if the user got locked out.
+func (l Lockout) RecordFailure(user *v32.User, now time.Time) bool {
+	if !l.Enabled() {
+		return false
+	}
+	if _, locked := LockedUntil(user, now); locked {
+		return false
+	}
+
+	if user.Annotations == nil {
+		user.Annotations = map[string]string{}
+	}
+	if _, ok := user.Annotations[LockedUntilAnnotation]; ok {
+		// the previous lockout expired
+		delete(user.Annotations, LockedUntilAnnotation)
+		v32.UserConditionLocked.False(user)
+		v32.UserConditionLocked.Reason(user, "")
+		v32.UserConditionLocked.Message(user, "")
+	}
+
+	count, _ := strconv.Atoi(user.Annotations[FailedLoginsAnnotation])
+	since, err := time.Parse(time.RFC3339, user.Annotations[FailedLoginsSinceAnnotation])
+	if err != nil || now.Sub(since) > l.Window {
+		count = 0
+		since = now
+	}
+	count++
+
+	if count < l.MaxAttempts {
+		user.Annotations[FailedLoginsAnnotation] = strconv.Itoa(count)
+		user.Annotations[FailedLoginsSinceAnnotation] = since.UTC().Format(time.RFC3339)
+		return false
+	}
+
+	until := now.Add(l.Duration).UTC()
+	delete(user.Annotations, FailedLoginsAnnotation)
+	delete(user.Annotations, FailedLoginsSinceAnnotation)
+	user.Annotations[LockedUntilAnnotation] = until.Format(time.RFC3339)
+	v32.UserConditionLocked.True(user)
+	v32.UserConditionLocked.Reason(user, lockedReason)
+	v32.UserConditionLocked.Message(user, fmt.Sprintf("locked out after %d failed logins until %s", count, until.Format(time.RFC3339)))
+	return true
+}
+
+// Reset clears the failed logins of the user after a successful login, and marks an expired lockout as
+// no longer in effect. It returns true if the user was changed.
+func Reset(user *v32.User) bool {
+	changed := false
+	for _, annotation := range []string{FailedLoginsAnnotation, FailedLoginsSinceAnnotation, LockedUntilAnnotation} {
+		if _, ok := user.Annotations[annotation]; ok {
+			delete(user.Annotations, annotation)
+			changed = true
+		}
+	}
+	if v32.UserConditionLocked.IsTrue(user) {
+		v32.UserConditionLocked.False(user)
+		v32.UserConditionLocked.Reason(user, "")
+		v32.UserConditionLocked.Message(user, "")
+		changed = true
+	}
+	return changed
+}
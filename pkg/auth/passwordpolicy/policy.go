@@ -0,0 +1,193 @@
+// Package passwordpolicy enforces the password complexity, history and age settings for local users
+// and tracks failed logins to lock out local users.
+package passwordpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/settings"
+	"golang.org/x/crypto/bcrypt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const historySecretField = "passwordhistory"
+
+// Complexity lists the classes of characters a password must contain.
+type Complexity struct {
+	UpperCase        bool
+	LowerCase        bool
+	Digit            bool
+	SpecialCharacter bool
+}
+
+// ComplexityFromSettings returns the complexity rules configured in the password-require-* settings.
+func ComplexityFromSettings() Complexity {
+	return Complexity{
+		UpperCase:        isTrue(settings.PasswordRequireUpperCase.Get()),
+		LowerCase:        isTrue(settings.PasswordRequireLowerCase.Get()),
+		Digit:            isTrue(settings.PasswordRequireDigit.Get()),
+		SpecialCharacter: isTrue(settings.PasswordRequireSpecialCharacter.Get()),
+	}
+}
+
+// Validate returns an error listing every character class the password is missing.
+func (c Complexity) Validate(pass string) error {
+	var upper, lower, digit, special bool
+	for _, r := range pass {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			special = true
+		}
+	}
+
+	var missing []string
+	if c.UpperCase && !upper {
+		missing = append(missing, "an upper case letter")
+	}
+	if c.LowerCase && !lower {
+		missing = append(missing, "a lower case letter")
+	}
+	if c.Digit && !digit {
+		missing = append(missing, "a digit")
+	}
+	if c.SpecialCharacter && !special {
+		missing = append(missing, "a special character")
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("Password must contain %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// History holds the hashes of the previous passwords of a user and when the password last changed.
+type History struct {
+	// Hashes are the bcrypt hashes of the most recent passwords, newest first.
+	Hashes []string `json:"hashes,omitempty"`
+	// ChangedAt is the time the current password was set.
+	ChangedAt time.Time `json:"changedAt,omitempty"`
+}
+
+// Contains returns true if pass matches one of the previous passwords.
+func (h *History) Contains(pass string) bool {
+	for _, hash := range h.Hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(pass)) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Record adds the hash of a new password, keeping at most count hashes.
+func (h *History) Record(hash string, count int, now time.Time) {
+	h.ChangedAt = now.UTC()
+	h.Hashes = append([]string{hash}, h.Hashes...)
+	if count < 0 {
+		count = 0
+	}
+	if len(h.Hashes) > count {
+		h.Hashes = h.Hashes[:count]
+	}
+}
+
+// Expired returns true if the password is older than maxAge. Passwords without a recorded change
+// are as old as the user, which is passed as created.
+func (h *History) Expired(maxAge time.Duration, created, now time.Time) bool {
+	if maxAge <= 0 {
+		return false
+	}
+	changedAt := h.ChangedAt
+	if changedAt.IsZero() {
+		changedAt = created
+	}
+	return now.Sub(changedAt) > maxAge
+}
+
+// MaxAge returns the maximum password age configured in the password-max-age-days setting, or 0 if passwords don't expire.
+func MaxAge() time.Duration {
+	return time.Duration(settings.PasswordMaxAgeDays.GetInt()) * 24 * time.Hour
+}
+
+// HistoryStore keeps the password history of each user in a secret in the global data namespace.
+type HistoryStore struct {
+	Secrets corev1.SecretInterface
+}
+
+// Get returns the password history of the user, or an empty history if none was recorded.
+func (s *HistoryStore) Get(userName string) (*History, error) {
+	secret, err := s.Secrets.GetNamespaced(common.SecretsNamespace, historySecretName(userName), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &History{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get password history of user %s: %w", userName, err)
+	}
+
+	history := &History{}
+	if err := json.Unmarshal(secret.Data[historySecretField], history); err != nil {
+		return nil, fmt.Errorf("failed to read password history of user %s: %w", userName, err)
+	}
+	return history, nil
+}
+
+// Check returns an error if pass is one of the passwords in the history of the user.
+func (s *HistoryStore) Check(userName, pass string) error {
+	if settings.PasswordHistoryCount.GetInt() <= 0 {
+		return nil
+	}
+	history, err := s.Get(userName)
+	if err != nil {
+		return err
+	}
+	if history.Contains(pass) {
+		return errors.Errorf("Password must not be one of the last %d passwords", settings.PasswordHistoryCount.GetInt())
+	}
+	return nil
+}
+
+// Record adds the hash of the new password of the user to its history.
+func (s *HistoryStore) Record(userName, hash string) error {
+	history, err := s.Get(userName)
+	if err != nil {
+		return err
+	}
+	history.Record(hash, settings.PasswordHistoryCount.GetInt(), time.Now())
+
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	if err := common.SaveSecretField(s.Secrets, userName, historySecretField, data); err != nil {
+		return fmt.Errorf("failed to save password history of user %s: %w", userName, err)
+	}
+	return nil
+}
+
+// Delete removes the password history of the user.
+func (s *HistoryStore) Delete(userName string) error {
+	err := common.DeleteSecret(s.Secrets, userName, historySecretField)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func historySecretName(userName string) string {
+	return fmt.Sprintf("%s-%s", userName, historySecretField)
+}
+
+func isTrue(value string) bool {
+	return strings.EqualFold(value, "true")
+}
@@ -0,0 +1,81 @@
+package passwordpolicy
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestComplexityValidate(t *testing.T) {
+	all := Complexity{UpperCase: true, LowerCase: true, Digit: true, SpecialCharacter: true}
+
+	assert.NoError(t, Complexity{}.Validate("password"))
+	assert.NoError(t, all.Validate("Pa55word!"))
+	assert.NoError(t, all.Validate("Пароль-2"))
+
+	err := all.Validate("password")
+	require.Error(t, err)
+	assert.Equal(t, "Password must contain an upper case letter, a digit, a special character", err.Error())
+
+	assert.Error(t, Complexity{LowerCase: true}.Validate("PASSWORD1"))
+}
+
+func TestHistory(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := &History{}
+	for _, pass := range []string{"first", "second", "third"} {
+		hash, err := bcrypt.GenerateFromPassword([]byte(pass), bcrypt.MinCost)
+		require.NoError(t, err)
+		history.Record(string(hash), 2, now)
+	}
+
+	assert.Len(t, history.Hashes, 2)
+	assert.True(t, history.Contains("third"))
+	assert.True(t, history.Contains("second"))
+	assert.False(t, history.Contains("first"), "passwords beyond the history count can be reused")
+
+	assert.False(t, history.Expired(0, now, now.Add(1000*time.Hour)))
+	assert.False(t, history.Expired(24*time.Hour, time.Time{}, now.Add(23*time.Hour)))
+	assert.True(t, history.Expired(24*time.Hour, time.Time{}, now.Add(25*time.Hour)))
+
+	// without a recorded change the password is as old as the user
+	assert.True(t, (&History{}).Expired(24*time.Hour, now, now.Add(25*time.Hour)))
+}
+
+func TestLockout(t *testing.T) {
+	lockout := Lockout{MaxAttempts: 3, Window: 15 * time.Minute, Duration: 30 * time.Minute}
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := &v32.User{}
+
+	assert.False(t, lockout.RecordFailure(user, now))
+	assert.False(t, lockout.RecordFailure(user, now.Add(time.Minute)))
+	assert.Equal(t, "2", user.Annotations[FailedLoginsAnnotation])
+
+	// failed logins outside the window start a new count
+	assert.False(t, lockout.RecordFailure(user, now.Add(20*time.Minute)))
+	assert.Equal(t, "1", user.Annotations[FailedLoginsAnnotation])
+	assert.False(t, lockout.RecordFailure(user, now.Add(21*time.Minute)))
+	assert.True(t, lockout.RecordFailure(user, now.Add(22*time.Minute)))
+
+	until, locked := LockedUntil(user, now.Add(23*time.Minute))
+	assert.True(t, locked)
+	assert.Equal(t, now.Add(52*time.Minute), until)
+	assert.True(t, v32.UserConditionLocked.IsTrue(user))
+	assert.Equal(t, lockedReason, v32.UserConditionLocked.GetReason(user))
+
+	// the lockout ends on its own
+	_, locked = LockedUntil(user, now.Add(53*time.Minute))
+	assert.False(t, locked)
+
+	assert.True(t, Reset(user))
+	assert.False(t, v32.UserConditionLocked.IsTrue(user))
+	assert.Empty(t, user.Annotations)
+	assert.False(t, Reset(user))
+
+	assert.False(t, Lockout{}.RecordFailure(user, now), "lockout is disabled without a maximum number of attempts")
+	assert.Empty(t, user.Annotations)
+}
@@ -0,0 +1,380 @@
+// Package permissions computes the effective permissions of users and groups from their global role bindings, cluster
+// and project role template bindings, for access reviews.
+package permissions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	mgmtv3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rbacv1 "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1"
+	"github.com/rancher/rancher/pkg/rbac"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	k8srbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	globalRoleBindingType          = "globalRoleBinding"
+	clusterRoleTemplateBindingType = "clusterRoleTemplateBinding"
+	projectRoleTemplateBindingType = "projectRoleTemplateBinding"
+)
+
+var scopeOrder = map[string]int{
+	v3.PermissionScopeGlobal:  0,
+	v3.PermissionScopeCluster: 1,
+	v3.PermissionScopeProject: 2,
+}
+
+// Reviewer expands the bindings of a scope into the rules they grant.
+type Reviewer struct {
+	GlobalRoleBindings mgmtv3.GlobalRoleBindingLister
+	GlobalRoles        mgmtv3.GlobalRoleLister
+	CRTBs              mgmtv3.ClusterRoleTemplateBindingLister
+	PRTBs              mgmtv3.ProjectRoleTemplateBindingLister
+	RoleTemplates      mgmtv3.RoleTemplateLister
+	Users              mgmtv3.UserLister
+	UserAttributes     mgmtv3.UserAttributeLister
+	ClusterRoles       rbacv1.ClusterRoleLister
+}
+
+func NewReviewer(management *config.ScaledContext) *Reviewer {
+	return &Reviewer{
+		GlobalRoleBindings: management.Management.GlobalRoleBindings("").Controller().Lister(),
+		GlobalRoles:        management.Management.GlobalRoles("").Controller().Lister(),
+		CRTBs:              management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+		PRTBs:              management.Management.ProjectRoleTemplateBindings("").Controller().Lister(),
+		RoleTemplates:      management.Management.RoleTemplates("").Controller().Lister(),
+		Users:              management.Management.Users("").Controller().Lister(),
+		UserAttributes:     management.Management.UserAttributes("").Controller().Lister(),
+		ClusterRoles:       management.RBAC.ClusterRoles("").Controller().Lister(),
+	}
+}
+
+// ListFilter returns whether the reviewer can list the bindings of a resource in a namespace, bindings the reviewer
+// can't list are left out of reviews.
+type ListFilter func(resource, namespace string) bool
+
+// AllowAll lets a review include all bindings in scope.
+func AllowAll(resource, namespace string) bool {
+	return true
+}
+
+// EffectivePermissions returns the rules granted to the user or group of the input, directly or through the groups of
+// the user, in the cluster or project of the input.
+func (r *Reviewer) EffectivePermissions(input v3.EffectivePermissionsInput, canList ListFilter) ([]v3.GrantedPermission, error) {
+	if (input.UserName == "") == (input.GroupPrincipalName == "") {
+		return nil, fmt.Errorf("must specify a user [userId] OR a group [groupPrincipalId]")
+	}
+
+	matches := func(p *v3.GrantedPermission) bool {
+		return p.GroupPrincipalName == input.GroupPrincipalName
+	}
+	if input.UserName != "" {
+		principals, groups, err := r.userPrincipals(input.UserName)
+		if err != nil {
+			return nil, err
+		}
+		matches = func(p *v3.GrantedPermission) bool {
+			return (p.UserName != "" && p.UserName == input.UserName) || principals[p.UserPrincipalName] || groups[p.GroupPrincipalName]
+		}
+	}
+
+	grants, err := r.grants(input.ClusterName, input.ProjectName, canList)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []v3.GrantedPermission
+	for i := range grants {
+		if matches(&grants[i]) {
+			result = append(result, grants[i])
+		}
+	}
+	return result, nil
+}
+
+// WhoCan returns the rules allowing the verb of the input on its resource, along with the subjects they are granted to,
+// in the cluster or project of the input.
+func (r *Reviewer) WhoCan(input v3.WhoCanInput, canList ListFilter) ([]v3.GrantedPermission, error) {
+	if input.Verb == "" || input.Resource == "" {
+		return nil, fmt.Errorf("must specify a [verb] and a [resource]")
+	}
+
+	grants, err := r.grants(input.ClusterName, input.ProjectName, canList)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []v3.GrantedPermission
+	for _, grant := range grants {
+		if RuleAllows(grant.Rule, input.Verb, input.APIGroup, input.Resource, input.ResourceName) {
+			result = append(result, grant)
+		}
+	}
+	return result, nil
+}
+
+// RuleAllows returns whether the rule allows the verb on resources of the API group, taking wildcards into account. A
+// rule restricted to resource names only allows the verb on the named resources, so it never allows it on all of them.
+func RuleAllows(rule k8srbacv1.PolicyRule, verb, apiGroup, resource, resourceName string) bool {
+	if !contains(rule.Verbs, verb) || !contains(rule.APIGroups, apiGroup) || !contains(rule.Resources, resource) {
+		return false
+	}
+	if len(rule.ResourceNames) == 0 {
+		return true
+	}
+	for _, name := range rule.ResourceNames {
+		if resourceName != "" && name == resourceName {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == k8srbacv1.ResourceAll {
+			return true
+		}
+	}
+	return false
+}
+
+// userPrincipals returns the principals of the user and the group principals it was last seen a member of.
+func (r *Reviewer) userPrincipals(userName string) (map[string]bool, map[string]bool, error) {
+	user, err := r.Users.Get("", userName)
+	if err != nil {
+		return nil, nil, err
+	}
+	principals := map[string]bool{}
+	for _, id := range user.PrincipalIDs {
+		principals[id] = true
+	}
+
+	groups := map[string]bool{}
+	attribs, err := r.UserAttributes.Get("", userName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if attribs != nil {
+		for _, provider := range attribs.GroupPrincipals {
+			for _, group := range provider.Items {
+				groups[group.Name] = true
+			}
+		}
+	}
+	return principals, groups, nil
+}
+
+// grants expands the active bindings in scope that the reviewer can list into their rules. Global role bindings are
+// always in scope, cluster role template bindings of a project's cluster are in scope of the project since they apply
+// to all its namespaces.
+func (r *Reviewer) grants(clusterName, projectName string, canList ListFilter) ([]v3.GrantedPermission, error) {
+	if projectName != "" {
+		parts := strings.SplitN(projectName, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid project id %s", projectName)
+		}
+		if clusterName != "" && clusterName != parts[0] {
+			return nil, fmt.Errorf("project %s is not in cluster %s", projectName, clusterName)
+		}
+		clusterName = parts[0]
+	}
+
+	now := time.Now()
+	expander := &roleExpander{reviewer: r, roleTemplates: map[string][]sourcedRule{}}
+	var grants []v3.GrantedPermission
+
+	var grbs []*v3.GlobalRoleBinding
+	if canList("globalrolebindings", "") {
+		var err error
+		if grbs, err = r.GlobalRoleBindings.List("", labels.Everything()); err != nil {
+			return nil, err
+		}
+	}
+	for _, grb := range grbs {
+		if active, _ := rbac.BindingActive(grb.NotBefore, grb.ExpiresAt, now); !active {
+			continue
+		}
+		gr, err := r.GlobalRoles.Get("", grb.GlobalRoleName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		base := v3.GrantedPermission{
+			UserName:           grb.UserName,
+			GroupPrincipalName: grb.GroupPrincipalName,
+			Scope:              v3.PermissionScopeGlobal,
+			BindingType:        globalRoleBindingType,
+			BindingName:        grb.Name,
+			RoleName:           gr.Name,
+		}
+		for _, rule := range gr.Rules {
+			grants = append(grants, withRule(base, sourcedRule{source: gr.Name, rule: rule}))
+		}
+	}
+
+	crtbs, err := r.CRTBs.List(clusterName, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, crtb := range crtbs {
+		if !canList("clusterroletemplatebindings", crtb.Namespace) {
+			continue
+		}
+		if active, _ := rbac.BindingActive(crtb.NotBefore, crtb.ExpiresAt, now); !active {
+			continue
+		}
+		rules, err := expander.rules(crtb.RoleTemplateName)
+		if err != nil {
+			return nil, err
+		}
+		base := v3.GrantedPermission{
+			UserName:           crtb.UserName,
+			UserPrincipalName:  crtb.UserPrincipalName,
+			GroupName:          crtb.GroupName,
+			GroupPrincipalName: crtb.GroupPrincipalName,
+			Scope:              v3.PermissionScopeCluster,
+			ClusterName:        crtb.ClusterName,
+			BindingType:        clusterRoleTemplateBindingType,
+			BindingName:        crtb.Namespace + ":" + crtb.Name,
+			RoleName:           crtb.RoleTemplateName,
+		}
+		for _, rule := range rules {
+			grants = append(grants, withRule(base, rule))
+		}
+	}
+
+	prtbs, err := r.PRTBs.List("", labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, prtb := range prtbs {
+		if projectName != "" && prtb.ProjectName != projectName {
+			continue
+		}
+		if clusterName != "" && !strings.HasPrefix(prtb.ProjectName, clusterName+":") {
+			continue
+		}
+		if !canList("projectroletemplatebindings", prtb.Namespace) {
+			continue
+		}
+		if active, _ := rbac.BindingActive(prtb.NotBefore, prtb.ExpiresAt, now); !active {
+			continue
+		}
+		rules, err := expander.rules(prtb.RoleTemplateName)
+		if err != nil {
+			return nil, err
+		}
+		base := v3.GrantedPermission{
+			UserName:           prtb.UserName,
+			UserPrincipalName:  prtb.UserPrincipalName,
+			GroupName:          prtb.GroupName,
+			GroupPrincipalName: prtb.GroupPrincipalName,
+			Scope:              v3.PermissionScopeProject,
+			ClusterName:        strings.SplitN(prtb.ProjectName, ":", 2)[0],
+			ProjectName:        prtb.ProjectName,
+			BindingType:        projectRoleTemplateBindingType,
+			BindingName:        prtb.Namespace + ":" + prtb.Name,
+			RoleName:           prtb.RoleTemplateName,
+		}
+		for _, rule := range rules {
+			grants = append(grants, withRule(base, rule))
+		}
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		if a.Scope != b.Scope {
+			return scopeOrder[a.Scope] < scopeOrder[b.Scope]
+		}
+		if a.ProjectName != b.ProjectName {
+			return a.ProjectName < b.ProjectName
+		}
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+		return a.BindingName < b.BindingName
+	})
+	return grants, nil
+}
+
+type sourcedRule struct {
+	source   string
+	external bool
+	rule     k8srbacv1.PolicyRule
+}
+
+func withRule(base v3.GrantedPermission, rule sourcedRule) v3.GrantedPermission {
+	base.Source = rule.source
+	base.External = rule.external
+	base.Rule = *rule.rule.DeepCopy()
+	return base
+}
+
+// roleExpander caches the expanded rules of role templates for a single review.
+type roleExpander struct {
+	reviewer      *Reviewer
+	roleTemplates map[string][]sourcedRule
+}
+
+// rules returns the rules of the role template, including the ones of the role templates it inherits through
+// roleTemplateNames and of the cluster role backing an external role template.
+func (e *roleExpander) rules(name string) ([]sourcedRule, error) {
+	if rules, ok := e.roleTemplates[name]; ok {
+		return rules, nil
+	}
+	rules, err := e.gather(name, nil, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	e.roleTemplates[name] = rules
+	return rules, nil
+}
+
+func (e *roleExpander) gather(name string, rules []sourcedRule, seen map[string]bool) ([]sourcedRule, error) {
+	seen[name] = true
+
+	rt, err := e.reviewer.RoleTemplates.Get("", name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logrus.Debugf("[permissions] skipping missing role template %s", name)
+			return rules, nil
+		}
+		return nil, err
+	}
+
+	if rt.External && rt.Context == "cluster" {
+		cr, err := e.reviewer.ClusterRoles.Get("", rt.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if cr != nil {
+			for _, rule := range cr.Rules {
+				rules = append(rules, sourcedRule{source: rt.Name, external: true, rule: rule})
+			}
+		}
+	}
+
+	for _, rule := range rt.Rules {
+		rules = append(rules, sourcedRule{source: rt.Name, rule: rule})
+	}
+
+	for _, inherited := range rt.RoleTemplateNames {
+		if seen[inherited] {
+			continue
+		}
+		if rules, err = e.gather(inherited, rules, seen); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
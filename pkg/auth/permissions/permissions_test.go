@@ -0,0 +1,201 @@
+package permissions
+
+import (
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	rbacfakes "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	podsRule     = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}
+	deployRule   = rbacv1.PolicyRule{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}}
+	nodesRule    = rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get"}}
+	settingsRule = rbacv1.PolicyRule{APIGroups: []string{"management.cattle.io"}, Resources: []string{"settings"}, Verbs: []string{"get"}}
+)
+
+func newTestReviewer() *Reviewer {
+	notFound := func(name string) error {
+		return apierrors.NewNotFound(schema.GroupResource{}, name)
+	}
+	roleTemplates := map[string]*v3.RoleTemplate{
+		"project-member": {ObjectMeta: metav1.ObjectMeta{Name: "project-member"}, Context: "project", Rules: []rbacv1.PolicyRule{podsRule}},
+		"project-owner": {ObjectMeta: metav1.ObjectMeta{Name: "project-owner"}, Context: "project", Rules: []rbacv1.PolicyRule{deployRule},
+			RoleTemplateNames: []string{"project-member"}},
+		"nodes-view": {ObjectMeta: metav1.ObjectMeta{Name: "nodes-view"}, Context: "cluster", External: true},
+	}
+
+	return &Reviewer{
+		GlobalRoleBindings: &fakes.GlobalRoleBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.GlobalRoleBinding, error) {
+				return []*v3.GlobalRoleBinding{
+					{ObjectMeta: metav1.ObjectMeta{Name: "grb-1"}, UserName: "u-1", GlobalRoleName: "user"},
+				}, nil
+			},
+		},
+		GlobalRoles: &fakes.GlobalRoleListerMock{
+			GetFunc: func(namespace string, name string) (*v3.GlobalRole, error) {
+				return &v3.GlobalRole{ObjectMeta: metav1.ObjectMeta{Name: name}, Rules: []rbacv1.PolicyRule{settingsRule}}, nil
+			},
+		},
+		CRTBs: &fakes.ClusterRoleTemplateBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.ClusterRoleTemplateBinding, error) {
+				return []*v3.ClusterRoleTemplateBinding{
+					{ObjectMeta: metav1.ObjectMeta{Name: "crtb-1", Namespace: "c-1"}, ClusterName: "c-1", GroupPrincipalName: "github_team://1", RoleTemplateName: "nodes-view"},
+				}, nil
+			},
+		},
+		PRTBs: &fakes.ProjectRoleTemplateBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.ProjectRoleTemplateBinding, error) {
+				return []*v3.ProjectRoleTemplateBinding{
+					{ObjectMeta: metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-1"}, ProjectName: "c-1:p-1", UserName: "u-1", RoleTemplateName: "project-owner"},
+					{ObjectMeta: metav1.ObjectMeta{Name: "prtb-2", Namespace: "p-2"}, ProjectName: "c-1:p-2", UserName: "u-2", RoleTemplateName: "project-member"},
+					{ObjectMeta: metav1.ObjectMeta{Name: "prtb-3", Namespace: "p-1"}, ProjectName: "c-1:p-1", UserName: "u-2", RoleTemplateName: "project-owner",
+						ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)}},
+				}, nil
+			},
+		},
+		RoleTemplates: &fakes.RoleTemplateListerMock{
+			GetFunc: func(namespace string, name string) (*v3.RoleTemplate, error) {
+				if rt, ok := roleTemplates[name]; ok {
+					return rt, nil
+				}
+				return nil, notFound(name)
+			},
+		},
+		Users: &fakes.UserListerMock{
+			GetFunc: func(namespace string, name string) (*v3.User, error) {
+				return &v3.User{ObjectMeta: metav1.ObjectMeta{Name: name}, PrincipalIDs: []string{"local://" + name}}, nil
+			},
+		},
+		UserAttributes: &fakes.UserAttributeListerMock{
+			GetFunc: func(namespace string, name string) (*v3.UserAttribute, error) {
+				if name != "u-1" {
+					return nil, notFound(name)
+				}
+				return &v3.UserAttribute{GroupPrincipals: map[string]v3.Principals{
+					"github": {Items: []v3.Principal{{ObjectMeta: metav1.ObjectMeta{Name: "github_team://1"}}}},
+				}}, nil
+			},
+		},
+		ClusterRoles: &rbacfakes.ClusterRoleListerMock{
+			GetFunc: func(namespace string, name string) (*rbacv1.ClusterRole, error) {
+				return &rbacv1.ClusterRole{Rules: []rbacv1.PolicyRule{nodesRule}}, nil
+			},
+		},
+	}
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	r := newTestReviewer()
+
+	permissions, err := r.EffectivePermissions(v3.EffectivePermissionsInput{UserName: "u-1"}, AllowAll)
+	require.NoError(t, err)
+	require.Len(t, permissions, 4)
+
+	// global role bindings come first
+	assert.Equal(t, v3.PermissionScopeGlobal, permissions[0].Scope)
+	assert.Equal(t, "grb-1", permissions[0].BindingName)
+	assert.Equal(t, settingsRule, permissions[0].Rule)
+
+	// the cluster role of an external role template, granted through a group of the user
+	assert.Equal(t, v3.PermissionScopeCluster, permissions[1].Scope)
+	assert.Equal(t, "c-1:crtb-1", permissions[1].BindingName)
+	assert.Equal(t, "github_team://1", permissions[1].GroupPrincipalName)
+	assert.True(t, permissions[1].External)
+	assert.Equal(t, nodesRule, permissions[1].Rule)
+
+	// the rules of the role template and of the role template it inherits
+	assert.Equal(t, "c-1:p-1", permissions[2].ProjectName)
+	assert.Equal(t, "project-owner", permissions[2].Source)
+	assert.Equal(t, deployRule, permissions[2].Rule)
+	assert.Equal(t, "project-owner", permissions[3].RoleName)
+	assert.Equal(t, "project-member", permissions[3].Source)
+	assert.Equal(t, podsRule, permissions[3].Rule)
+
+	// expired bindings grant nothing
+	permissions, err = r.EffectivePermissions(v3.EffectivePermissionsInput{UserName: "u-2", ProjectName: "c-1:p-1"}, AllowAll)
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+
+	permissions, err = r.EffectivePermissions(v3.EffectivePermissionsInput{GroupPrincipalName: "github_team://1", ProjectName: "c-1:p-2"}, AllowAll)
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "c-1:crtb-1", permissions[0].BindingName)
+
+	_, err = r.EffectivePermissions(v3.EffectivePermissionsInput{}, AllowAll)
+	assert.Error(t, err)
+	_, err = r.EffectivePermissions(v3.EffectivePermissionsInput{UserName: "u-1", ClusterName: "c-2", ProjectName: "c-1:p-1"}, AllowAll)
+	assert.Error(t, err)
+}
+
+func TestWhoCan(t *testing.T) {
+	r := newTestReviewer()
+
+	permissions, err := r.WhoCan(v3.WhoCanInput{Verb: "delete", APIGroup: "apps", Resource: "deployments", ProjectName: "c-1:p-1"}, AllowAll)
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "u-1", permissions[0].UserName)
+	assert.Equal(t, "p-1:prtb-1", permissions[0].BindingName)
+
+	permissions, err = r.WhoCan(v3.WhoCanInput{Verb: "list", Resource: "pods", ClusterName: "c-1"}, AllowAll)
+	require.NoError(t, err)
+	require.Len(t, permissions, 2)
+	assert.Equal(t, "u-1", permissions[0].UserName)
+	assert.Equal(t, "u-2", permissions[1].UserName)
+
+	permissions, err = r.WhoCan(v3.WhoCanInput{Verb: "list", Resource: "pods", ProjectName: "c-1:p-2"}, AllowAll)
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "u-2", permissions[0].UserName)
+
+	_, err = r.WhoCan(v3.WhoCanInput{Verb: "get"}, AllowAll)
+	assert.Error(t, err)
+}
+
+func TestReviewListFilter(t *testing.T) {
+	r := newTestReviewer()
+	var checked []string
+	projectOwner := func(resource, namespace string) bool {
+		checked = append(checked, resource+"/"+namespace)
+		return resource == "projectroletemplatebindings" && namespace == "p-1"
+	}
+
+	// bindings the reviewer can't list are left out, even in scope of the review
+	permissions, err := r.EffectivePermissions(v3.EffectivePermissionsInput{UserName: "u-1", ProjectName: "c-1:p-1"}, projectOwner)
+	require.NoError(t, err)
+	require.Len(t, permissions, 2)
+	assert.Equal(t, "p-1:prtb-1", permissions[0].BindingName)
+	assert.Equal(t, "p-1:prtb-1", permissions[1].BindingName)
+	assert.Contains(t, checked, "globalrolebindings/")
+	assert.Contains(t, checked, "clusterroletemplatebindings/c-1")
+
+	// without a scope only the bindings of the scopes the reviewer can list are returned
+	permissions, err = r.WhoCan(v3.WhoCanInput{Verb: "list", Resource: "pods"}, projectOwner)
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "p-1:prtb-1", permissions[0].BindingName)
+}
+
+func TestRuleAllows(t *testing.T) {
+	assert.True(t, RuleAllows(deployRule, "delete", "apps", "deployments", ""))
+	assert.False(t, RuleAllows(deployRule, "delete", "", "deployments", ""))
+	assert.False(t, RuleAllows(podsRule, "delete", "", "pods", ""))
+	assert.True(t, RuleAllows(rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}, "delete", "apps", "deployments", ""))
+
+	// rules restricted to resource names only allow the verb on those resources
+	namedRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"s-1"}, Verbs: []string{"get"}}
+	assert.True(t, RuleAllows(namedRule, "get", "", "secrets", "s-1"))
+	assert.False(t, RuleAllows(namedRule, "get", "", "secrets", "s-2"))
+	assert.False(t, RuleAllows(namedRule, "get", "", "secrets", ""))
+	assert.True(t, RuleAllows(podsRule, "get", "", "pods", "pod-1"))
+}
@@ -46,6 +46,31 @@ func CreateOrUpdateSecrets(secrets corev1.SecretInterface, secretInfo string, fi
 	return nil
 }
 
+// SaveSecretField stores data under field in the secret of the user. Unlike CreateOrUpdateSecrets it doesn't read
+// the secret from the cache and returns every failed write, for secrets that must not silently keep an old value.
+func SaveSecretField(secrets corev1.SecretInterface, userName, field string, data []byte) error {
+	name := fmt.Sprintf("%s-%s", userName, field)
+	secret, err := secrets.GetNamespaced(SecretsNamespace, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = secrets.Create(&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: SecretsNamespace,
+			},
+			Data: map[string][]byte{field: data},
+			Type: v1.SecretTypeOpaque,
+		})
+	} else if err == nil {
+		secret = secret.DeepCopy()
+		secret.Data = map[string][]byte{field: data}
+		_, err = secrets.Update(secret)
+	}
+	if err != nil {
+		return fmt.Errorf("error saving secret %s: %w", name, err)
+	}
+	return nil
+}
+
 func ReadFromSecret(secrets corev1.SecretInterface, secretInfo string, field string) (string, error) {
 	if strings.HasPrefix(secretInfo, SecretsNamespace) {
 		data, err := ReadFromSecretData(secrets, secretInfo)
@@ -4,21 +4,26 @@
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/httperror"
 	"github.com/rancher/norman/types"
+	"github.com/rancher/rancher/pkg/auth/passwordpolicy"
 	"github.com/rancher/rancher/pkg/auth/providers/common"
 	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/rancher/pkg/auth/totp"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
 	"github.com/rancher/rancher/pkg/types/config"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/bcrypt"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -27,16 +32,31 @@
 	gmPrincipalIndex      = "authn.management.cattle.io/groupmember-principalid-index"
 	userSearchIndex       = "authn.management.cattle.io/user-search-index"
 	groupSearchIndex      = "authn.management.cattle.io/group-search-index"
+	grbByUserIndex        = "authn.management.cattle.io/grb-by-user-index"
 	searchIndexDefaultLen = 6
 )
 
+// TOTPRequired is returned when a user with an enrolled TOTP second factor logs in without a code.
+var TOTPRequired = httperror.ErrorCode{Code: "TOTPRequired", Status: 401}
+
+// TOTPEnrollmentRequired is returned when a user that must enroll a TOTP second factor asks for a kubeconfig token,
+// which can't be limited to enrollment.
+var TOTPEnrollmentRequired = httperror.ErrorCode{Code: "TOTPEnrollmentRequired", Status: 403}
+
+// AccountLocked is returned when a user that is locked out after too many failed logins logs in with the right password.
+var AccountLocked = httperror.ErrorCode{Code: "AccountLocked", Status: 401}
+
 type Provider struct {
 	userLister   v3.UserLister
+	users        v3.UserInterface
 	groupLister  v3.GroupLister
 	userIndexer  cache.Indexer
 	gmIndexer    cache.Indexer
 	groupIndexer cache.Indexer
+	grbIndexer   cache.Indexer
 	tokenMGR     *tokens.Manager
+	totpStore    *totp.Store
+	historyStore *passwordpolicy.HistoryStore
 	invalidHash  []byte
 }
 
@@ -53,6 +73,10 @@ func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, tokenMGR *tok
 	gIndexers := map[string]cache.IndexFunc{groupSearchIndex: groupSearchIndexer}
 	gInformer.AddIndexers(gIndexers)
 
+	grbInformer := mgmtCtx.Management.GlobalRoleBindings("").Controller().Informer()
+	grbIndexers := map[string]cache.IndexFunc{grbByUserIndex: grbByUserIndexer}
+	grbInformer.AddIndexers(grbIndexers)
+
 	invalidHash, _ := bcrypt.GenerateFromPassword([]byte("invalid"), bcrypt.DefaultCost)
 
 	l := &Provider{
@@ -60,8 +84,12 @@ func Configure(ctx context.Context, mgmtCtx *config.ScaledContext, tokenMGR *tok
 		gmIndexer:    gmInformer.GetIndexer(),
 		groupLister:  mgmtCtx.Management.Groups("").Controller().Lister(),
 		groupIndexer: gInformer.GetIndexer(),
+		grbIndexer:   grbInformer.GetIndexer(),
 		userLister:   mgmtCtx.Management.Users("").Controller().Lister(),
+		users:        mgmtCtx.Management.Users(""),
 		tokenMGR:     tokenMGR,
+		totpStore:    &totp.Store{Secrets: mgmtCtx.Core.Secrets("")},
+		historyStore: &passwordpolicy.HistoryStore{Secrets: mgmtCtx.Core.Secrets("")},
 		invalidHash:  invalidHash,
 	}
 	return l
@@ -119,10 +147,25 @@ func (l *Provider) AuthenticateUser(ctx context.Context, input interface{}) (v3.
 		return v3.Principal{}, nil, "", authFailedError
 	}
 
+	// the password is checked even for locked out users, so a lockout is only revealed to those who know it
+	_, locked := passwordpolicy.LockedUntil(user, time.Now())
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(pwd)); err != nil {
 		logrus.Debugf("Authentication failed for User [%s]: %v", username, err)
+		if !locked {
+			l.recordFailedLogin(user.Name)
+		}
 		return v3.Principal{}, nil, "", authFailedError
 	}
+	if locked {
+		logrus.Debugf("Authentication failed for User [%s]: user is locked out", username)
+		return v3.Principal{}, nil, "", httperror.NewAPIError(AccountLocked, "account is locked after too many failed logins, try again later")
+	}
+
+	if err := l.checkTOTP(user, localInput.TOTPCode); err != nil {
+		return v3.Principal{}, nil, "", err
+	}
+
+	l.updateAfterLogin(user)
 
 	principalID := getLocalPrincipalID(user)
 	userPrincipal := l.toPrincipal("user", user.DisplayName, user.Username, principalID, nil)
@@ -136,6 +179,152 @@ func (l *Provider) AuthenticateUser(ctx context.Context, input interface{}) (v3.
 	return userPrincipal, groupPrincipals, "", nil
 }
 
+// checkTOTP requires a valid TOTP or recovery code from users that enrolled a second factor. For all other
+// users it records whether their global roles require them to enroll.
+func (l *Provider) checkTOTP(user *v3.User, code string) error {
+	if !user.TOTPEnabled {
+		l.updateMustEnrollTOTP(user)
+		return nil
+	}
+
+	if code == "" {
+		return httperror.NewAPIError(TOTPRequired, "TOTP code required")
+	}
+
+	enrollment, err := l.totpStore.Get(user.Name)
+	if err != nil {
+		return err
+	}
+	if !enrollment.CheckCode(code, time.Now()) {
+		logrus.Debugf("Authentication failed for User [%s]: invalid TOTP code", user.Username)
+		l.recordFailedLogin(user.Name)
+		return httperror.NewAPIError(httperror.Unauthorized, "authentication failed")
+	}
+
+	// the last used step or the used recovery code changed
+	return l.totpStore.Save(user.Name, enrollment)
+}
+
+// recordFailedLogin counts a failed login on the user object itself, so that all replicas share the count.
+func (l *Provider) recordFailedLogin(userName string) {
+	lockout := passwordpolicy.LockoutFromSettings()
+	if !lockout.Enabled() {
+		return
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		now := time.Now()
+		user, err := l.users.Get(userName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, locked := passwordpolicy.LockedUntil(user, now); locked {
+			return nil
+		}
+		if lockout.RecordFailure(user, now) {
+			logrus.Infof("User [%s] is locked out after %d failed logins", user.Name, lockout.MaxAttempts)
+		}
+		_, err = l.users.Update(user)
+		return err
+	})
+	if err != nil {
+		logrus.Warnf("Failed to record failed login of user [%s]: %v", userName, err)
+	}
+}
+
+// updateAfterLogin clears the failed logins of the user and requires a password change once the password
+// is older than the password-max-age-days setting.
+func (l *Provider) updateAfterLogin(user *v3.User) {
+	mustChangePassword := false
+	if maxAge := passwordpolicy.MaxAge(); maxAge > 0 && !user.MustChangePassword {
+		history, err := l.historyStore.Get(user.Name)
+		if err != nil {
+			logrus.Warnf("Failed to determine password age of user [%s]: %v", user.Name, err)
+		} else {
+			mustChangePassword = history.Expired(maxAge, user.CreationTimestamp.Time, time.Now())
+		}
+	}
+	if !mustChangePassword && !passwordpolicy.Reset(user.DeepCopy()) {
+		return
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		user, err := l.users.Get(user.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		changed := passwordpolicy.Reset(user)
+		if mustChangePassword && !user.MustChangePassword {
+			user.MustChangePassword = true
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+		_, err = l.users.Update(user)
+		return err
+	})
+	if err != nil {
+		logrus.Warnf("Failed to update user [%s] after login: %v", user.Name, err)
+	}
+}
+
+// updateMustEnrollTOTP sets MustEnrollTOTP on users that hold one of the global roles in the
+// local-totp-required-global-roles setting, and clears it once none of them apply anymore.
+func (l *Provider) updateMustEnrollTOTP(user *v3.User) {
+	required, err := l.totpRequired(user)
+	if err != nil {
+		logrus.Warnf("Failed to determine whether user [%s] must enroll a TOTP second factor: %v", user.Name, err)
+		return
+	}
+	if required == user.MustEnrollTOTP {
+		return
+	}
+
+	user = user.DeepCopy()
+	user.MustEnrollTOTP = required
+	if _, err := l.users.Update(user); err != nil {
+		logrus.Warnf("Failed to update TOTP enrollment requirement of user [%s]: %v", user.Name, err)
+	}
+}
+
+// MustEnrollTOTP returns true if the user holds a global role that requires a TOTP second factor and has not
+// enrolled one yet. Sessions of these users are limited to enrollment.
+func (l *Provider) MustEnrollTOTP(userName string) (bool, error) {
+	user, err := l.users.Get(userName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if user.TOTPEnabled {
+		return false, nil
+	}
+	return l.totpRequired(user)
+}
+
+func (l *Provider) totpRequired(user *v3.User) (bool, error) {
+	requiredRoles := settings.LocalTOTPRequiredGlobalRoles.Get()
+	if requiredRoles == "" {
+		return false, nil
+	}
+
+	objs, err := l.grbIndexer.ByIndex(grbByUserIndex, user.Name)
+	if err != nil {
+		return false, err
+	}
+	for _, obj := range objs {
+		grb, ok := obj.(*v3.GlobalRoleBinding)
+		if !ok {
+			continue
+		}
+		for _, role := range strings.Split(requiredRoles, ",") {
+			if strings.TrimSpace(role) == grb.GlobalRoleName {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func getLocalPrincipalID(user *v3.User) string {
 	// TODO error condition handling: no principal, more than one that would match
 	var principalID string
@@ -378,6 +567,14 @@ func userNameIndexer(obj interface{}) ([]string, error) {
 	return []string{user.Username}, nil
 }
 
+func grbByUserIndexer(obj interface{}) ([]string, error) {
+	grb, ok := obj.(*v3.GlobalRoleBinding)
+	if !ok || grb.UserName == "" {
+		return []string{}, nil
+	}
+	return []string{grb.UserName}, nil
+}
+
 func gmPIdIndexer(obj interface{}) ([]string, error) {
 	gm, ok := obj.(*v3.GroupMember)
 	if !ok {
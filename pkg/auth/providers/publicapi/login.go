@@ -25,6 +25,7 @@
 	"github.com/rancher/rancher/pkg/auth/providers/saml"
 	"github.com/rancher/rancher/pkg/auth/settings"
 	"github.com/rancher/rancher/pkg/auth/tokens"
+	"github.com/rancher/rancher/pkg/auth/totp"
 	"github.com/rancher/rancher/pkg/auth/util"
 	client "github.com/rancher/rancher/pkg/client/generated/management/v3public"
 	"github.com/rancher/rancher/pkg/clustermanager"
@@ -214,7 +215,15 @@ func (h *loginHandler) createLoginToken(request *types.APIContext) (v3.Token, st
 		return v3.Token{}, "", "", httperror.NewAPIError(httperror.PermissionDenied, "Permission Denied")
 	}
 
+	mustEnrollTOTP, err := h.mustEnrollTOTP(providerName, currUser.Name)
+	if err != nil {
+		return v3.Token{}, "", "", err
+	}
+
 	if strings.HasPrefix(responseType, tokens.KubeconfigResponseType) {
+		if mustEnrollTOTP {
+			return v3.Token{}, "", "", httperror.NewAPIError(local.TOTPEnrollmentRequired, "a TOTP second factor must be enrolled first")
+		}
 		token, tokenValue, err := tokens.GetKubeConfigToken(currUser.Name, responseType, h.userMGR, userPrincipal)
 		if err != nil {
 			return v3.Token{}, "", "", err
@@ -229,10 +238,32 @@ func (h *loginHandler) createLoginToken(request *types.APIContext) (v3.Token, st
 
 	userExtraInfo := providers.GetUserExtraAttributes(providerName, userPrincipal)
 
+	if mustEnrollTOTP {
+		rToken, unhashedTokenKey, err := h.tokenMGR.NewScopedLoginToken(currUser.Name, userPrincipal, groupPrincipals, providerToken, ttl, description, userExtraInfo, totp.EnrollmentRules(currUser.Name))
+		return rToken, unhashedTokenKey, responseType, err
+	}
+
 	rToken, unhashedTokenKey, err := h.tokenMGR.NewLoginToken(currUser.Name, userPrincipal, groupPrincipals, providerToken, ttl, description, userExtraInfo)
 	return rToken, unhashedTokenKey, responseType, err
 }
 
+// mustEnrollTOTP returns true if the local user has to enroll a TOTP second factor, their session then only allows
+// enrollment until they log in again.
+func (h *loginHandler) mustEnrollTOTP(providerName, userName string) (bool, error) {
+	if providerName != local.Name {
+		return false, nil
+	}
+	provider, err := providers.GetProvider(local.Name)
+	if err != nil {
+		return false, err
+	}
+	localProvider, ok := provider.(*local.Provider)
+	if !ok {
+		return false, nil
+	}
+	return localProvider.MustEnrollTOTP(userName)
+}
+
 // createClusterAuthTokenIfNeeded checks if local cluster auth endpoint is enabled. If it is, a cluster auth token
 // is created.
 func (h *loginHandler) createClusterAuthTokenIfNeeded(token *v3.Token, tokenValue string) error {
@@ -4,6 +4,7 @@
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/httperror"
@@ -69,6 +70,7 @@ func NewAuthenticator(ctx context.Context, clusterRouter ClusterRouter, mgmtCtx
 		userLister:          mgmtCtx.Management.Users("").Controller().Lister(),
 		clusterRouter:       clusterRouter,
 		userAuthRefresher:   providerrefresh.NewUserAuthRefresher(ctx, mgmtCtx),
+		usageRecorder:       tokens.NewUsageRecorder(mgmtCtx.Management.Tokens("")),
 	}
 }
 
@@ -81,6 +83,7 @@ type tokenAuthenticator struct {
 	userLister          v3.UserLister
 	clusterRouter       ClusterRouter
 	userAuthRefresher   providerrefresh.UserAuthRefresher
+	usageRecorder       *tokens.UsageRecorder
 }
 
 const (
@@ -111,6 +114,13 @@ func (a *tokenAuthenticator) Authenticate(req *http.Request) (*AuthenticatorResp
 	if token.ClusterName != "" && token.ClusterName != a.clusterRouter(req) {
 		return nil, errors.Wrapf(ErrMustAuthenticate, "clusterID does not match")
 	}
+	if err := tokens.CheckScope(token, req, a.clusterRouter(req)); err != nil {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "request is outside of the token scope: %v", err)
+	}
+	now := time.Now()
+	if tokens.IsIdleExpired(*token, tokens.IdleTTL(), now) {
+		return nil, errors.Wrapf(ErrMustAuthenticate, "token was not used for longer than the idle timeout")
+	}
 
 	attribs, err := a.userAttributeLister.Get("", token.UserID)
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -153,6 +163,8 @@ func (a *tokenAuthenticator) Authenticate(req *http.Request) (*AuthenticatorResp
 		go a.userAuthRefresher.TriggerUserRefresh(token.UserID, false)
 	}
 
+	a.usageRecorder.Record(token, tokens.ClientIP(req), now)
+
 	authResp.IsAuthed = true
 	authResp.User = token.UserID
 	authResp.UserPrincipal = token.UserPrincipal.Name
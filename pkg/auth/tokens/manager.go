@@ -25,6 +25,7 @@
 	"github.com/rancher/wrangler/pkg/randomtoken"
 	"github.com/sirupsen/logrus"
 	apicorev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -101,6 +102,16 @@ func (m *Manager) createDerivedToken(jsonInput clientv3.Token, tokenAuthValue st
 		return v3.Token{}, "", 401, err
 	}
 
+	// a scoped token must not be able to create a token with a wider scope
+	if IsScoped(token) {
+		return v3.Token{}, "", http.StatusForbidden, errors.New("scoped tokens can't be used to create tokens")
+	}
+
+	rules, err := scopeRules(jsonInput.Rules)
+	if err != nil {
+		return v3.Token{}, "", http.StatusUnprocessableEntity, err
+	}
+
 	tokenTTL, err := ClampToMaxTTL(time.Duration(int64(jsonInput.TTLMillis)) * time.Millisecond)
 	if err != nil {
 		return v3.Token{}, "", 500, fmt.Errorf("error validating max-ttl %v", err)
@@ -108,14 +119,17 @@ func (m *Manager) createDerivedToken(jsonInput clientv3.Token, tokenAuthValue st
 
 	var unhashedTokenKey string
 	derivedToken := v3.Token{
-		UserPrincipal: token.UserPrincipal,
-		IsDerived:     true,
-		TTLMillis:     tokenTTL.Milliseconds(),
-		UserID:        token.UserID,
-		AuthProvider:  token.AuthProvider,
-		ProviderInfo:  token.ProviderInfo,
-		Description:   jsonInput.Description,
-		ClusterName:   jsonInput.ClusterID,
+		UserPrincipal:   token.UserPrincipal,
+		IsDerived:       true,
+		TTLMillis:       tokenTTL.Milliseconds(),
+		UserID:          token.UserID,
+		AuthProvider:    token.AuthProvider,
+		ProviderInfo:    token.ProviderInfo,
+		Description:     jsonInput.Description,
+		ClusterName:     jsonInput.ClusterID,
+		Rules:           rules,
+		AllowedClusters: jsonInput.AllowedClusters,
+		AllowedProjects: jsonInput.AllowedProjects,
 	}
 	derivedToken, unhashedTokenKey, err = m.createToken(&derivedToken)
 
@@ -329,6 +343,11 @@ func (m *Manager) listTokens(request *types.APIContext) error {
 		return httperror.NewAPIErrorLong(status, util.GetHTTPErrorCode(status), fmt.Sprintf("%v", err))
 	}
 
+	tokens, err = filterByUsage(tokens, r.URL.Query())
+	if err != nil {
+		return httperror.NewAPIError(httperror.InvalidOption, err.Error())
+	}
+
 	currentAuthToken, _, err := m.getToken(tokenAuthValue)
 	if err != nil {
 		return err
@@ -650,6 +669,11 @@ func (m *Manager) UserAttributeChanged(attribs *v32.UserAttribute, provider stri
 var PerUserCacheProviders = []string{"github", "azuread", "googleoauth", "oidc", "keycloakoidc"}
 
 func (m *Manager) NewLoginToken(userID string, userPrincipal v3.Principal, groupPrincipals []v3.Principal, providerToken string, ttl int64, description string, userExtraInfo map[string][]string) (v3.Token, string, error) {
+	return m.NewScopedLoginToken(userID, userPrincipal, groupPrincipals, providerToken, ttl, description, userExtraInfo, nil)
+}
+
+// NewScopedLoginToken creates a session token that is limited to the requests the rules allow.
+func (m *Manager) NewScopedLoginToken(userID string, userPrincipal v3.Principal, groupPrincipals []v3.Principal, providerToken string, ttl int64, description string, userExtraInfo map[string][]string, rules []rbacv1.PolicyRule) (v3.Token, string, error) {
 	provider := userPrincipal.Provider
 	// Providers that use oauth need to create a secret for storing the access token.
 	if utils.Contains(PerUserCacheProviders, provider) && providerToken != "" {
@@ -678,6 +702,7 @@ func (m *Manager) NewLoginToken(userID string, userPrincipal v3.Principal, group
 		UserID:        userID,
 		AuthProvider:  provider,
 		Description:   description,
+		Rules:         rules,
 		ObjectMeta: metav1.ObjectMeta{
 			Labels: map[string]string{
 				TokenKindLabel: "session",
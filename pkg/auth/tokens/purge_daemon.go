@@ -40,8 +40,10 @@ func (p *purger) purge() {
 	}
 
 	var count int
+	idleTTL := IdleTTL()
+	now := time.Now()
 	for _, token := range allTokens {
-		if IsExpired(*token) {
+		if IsExpired(*token) || IsIdleExpired(*token, idleTTL, now) {
 			err = p.tokens.Delete(token.ObjectMeta.Name, &metav1.DeleteOptions{})
 			if err != nil && !clientbase.IsNotFound(err) {
 				logrus.Errorf("Error: while deleting expired token %v: %v", err, token.ObjectMeta.Name)
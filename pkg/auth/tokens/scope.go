@@ -0,0 +1,303 @@
+package tokens
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientv3 "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// The API groups of the Norman schemas served under /v3, /v3/cluster/<id> and /v3/project/<id>.
+const (
+	normanManagementGroup = "management.cattle.io"
+	normanClusterGroup    = "cluster.cattle.io"
+	normanProjectGroup    = "project.cattle.io"
+)
+
+var requestInfoFactory = &request.RequestInfoFactory{
+	APIPrefixes:          sets.NewString("api", "apis"),
+	GrouplessAPIPrefixes: sets.NewString("api"),
+}
+
+// IsScoped returns true if the token is limited by rules, clusters or projects.
+func IsScoped(token *v3.Token) bool {
+	return len(token.Rules) > 0 || len(token.AllowedClusters) > 0 || len(token.AllowedProjects) > 0
+}
+
+// CheckScope returns an error if the request is outside of the rules, clusters or projects the token is limited to.
+// The request is still subject to the permissions of the user, so a scoped token never allows more than the user could do.
+func CheckScope(token *v3.Token, req *http.Request, clusterID string) error {
+	if !IsScoped(token) {
+		return nil
+	}
+
+	attributes := attributesFromRequest(req)
+	if len(token.AllowedClusters) > 0 || len(token.AllowedProjects) > 0 {
+		if !targetAllowed(token, clusterID, attributes.project) {
+			return fmt.Errorf("token is not allowed for cluster %q and project %q", clusterID, attributes.project)
+		}
+	}
+	if len(token.Rules) > 0 && !rulesAllow(token.Rules, attributes) {
+		return fmt.Errorf("token rules don't allow %s on %s", attributes.verb, attributes.String())
+	}
+	return nil
+}
+
+// scopeRules converts the rules requested for a derived token, making sure every rule can allow something.
+func scopeRules(input []clientv3.PolicyRule) ([]rbacv1.PolicyRule, error) {
+	var rules []rbacv1.PolicyRule
+	for i, rule := range input {
+		if len(rule.Verbs) == 0 {
+			return nil, fmt.Errorf("rule %d must specify verbs", i)
+		}
+		if len(rule.Resources) == 0 && len(rule.NonResourceURLs) == 0 {
+			return nil, fmt.Errorf("rule %d must specify resources or nonResourceURLs", i)
+		}
+		rules = append(rules, rbacv1.PolicyRule{
+			Verbs:           rule.Verbs,
+			APIGroups:       rule.APIGroups,
+			Resources:       rule.Resources,
+			ResourceNames:   rule.ResourceNames,
+			NonResourceURLs: rule.NonResourceURLs,
+		})
+	}
+	return rules, nil
+}
+
+func targetAllowed(token *v3.Token, clusterID, projectID string) bool {
+	if projectID != "" {
+		for _, allowed := range token.AllowedProjects {
+			if allowed == projectID {
+				return true
+			}
+		}
+		if clusterID == "" {
+			clusterID, _ = splitProjectID(projectID)
+		}
+	}
+	if clusterID == "" {
+		return false
+	}
+	for _, allowed := range token.AllowedClusters {
+		if allowed == clusterID {
+			return true
+		}
+	}
+	return false
+}
+
+// requestAttributes describes a request in the terms of RBAC policy rules.
+type requestAttributes struct {
+	verb     string
+	apiGroup string
+	// resource is the resource, or for Steve requests the kind, with the subresource appended after a slash.
+	resource string
+	name     string
+	// path is set instead of the resource for requests that don't address a resource.
+	path string
+	// project is the project a Norman project request is for.
+	project string
+	// steve is true for Steve requests, whose types are named after the kind rather than the resource.
+	steve bool
+}
+
+func (a requestAttributes) String() string {
+	if a.path != "" {
+		return a.path
+	}
+	if a.apiGroup == "" {
+		return a.resource
+	}
+	return a.resource + "." + a.apiGroup
+}
+
+// attributesFromRequest maps Norman, Steve and Kubernetes API requests to the verb and resource they act on.
+func attributesFromRequest(req *http.Request) requestAttributes {
+	path := strings.Trim(req.URL.Path, "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) >= 2 && parts[0] == "v3":
+		return normanAttributes(req, parts[1:])
+	case len(parts) >= 2 && parts[0] == "v1":
+		return steveAttributes(req, parts[1:])
+	case len(parts) >= 4 && parts[0] == "k8s" && parts[1] == "clusters":
+		return kubernetesAttributes(req, "/"+strings.Join(parts[3:], "/"))
+	case len(parts) >= 1 && (parts[0] == "api" || parts[0] == "apis"):
+		return kubernetesAttributes(req, req.URL.Path)
+	}
+	return requestAttributes{verb: strings.ToLower(req.Method), path: req.URL.Path}
+}
+
+// normanAttributes handles /v3/<type>/<id>, /v3/cluster/<cluster>/<type>/<id> and /v3/project/<project>/<type>/<id>.
+func normanAttributes(req *http.Request, parts []string) requestAttributes {
+	attributes := requestAttributes{apiGroup: normanManagementGroup}
+	switch {
+	case parts[0] == "cluster" && len(parts) >= 3:
+		attributes.apiGroup = normanClusterGroup
+		parts = parts[2:]
+	case parts[0] == "project" && len(parts) >= 3:
+		attributes.apiGroup = normanProjectGroup
+		attributes.project = parts[1]
+		parts = parts[2:]
+	case parts[0] == "projects" && len(parts) >= 2:
+		attributes.project = parts[1]
+	}
+
+	attributes.resource = strings.ToLower(parts[0])
+	if len(parts) >= 2 {
+		attributes.name = parts[1]
+	}
+	attributes.verb = normanVerb(req, attributes.name != "")
+	return attributes
+}
+
+func normanVerb(req *http.Request, hasName bool) string {
+	// actions change the resource they are invoked on
+	if req.Method == http.MethodPost && req.URL.Query().Get("action") != "" {
+		return "update"
+	}
+	return methodVerb(req.Method, hasName)
+}
+
+func methodVerb(method string, hasName bool) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		if hasName {
+			return "get"
+		}
+		return "list"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	}
+	return strings.ToLower(method)
+}
+
+// steveAttributes handles /v1/<type>, /v1/<type>/<name> and /v1/<type>/<namespace>/<name>, where the type is
+// the lower case kind prefixed with its API group.
+func steveAttributes(req *http.Request, parts []string) requestAttributes {
+	attributes := requestAttributes{steve: true}
+	schemaID := parts[0]
+	if i := strings.LastIndex(schemaID, "."); i >= 0 {
+		attributes.apiGroup = schemaID[:i]
+		attributes.resource = schemaID[i+1:]
+	} else {
+		attributes.resource = schemaID
+	}
+	if len(parts) >= 2 {
+		attributes.name = parts[len(parts)-1]
+	}
+
+	if req.Method == http.MethodGet && attributes.name == "" && req.URL.Query().Get("watch") == "true" {
+		attributes.verb = "watch"
+	} else {
+		attributes.verb = methodVerb(req.Method, attributes.name != "")
+	}
+	return attributes
+}
+
+func kubernetesAttributes(req *http.Request, path string) requestAttributes {
+	kubeReq := req.Clone(req.Context())
+	kubeReq.URL.Path = path
+	info, err := requestInfoFactory.NewRequestInfo(kubeReq)
+	if err != nil || !info.IsResourceRequest {
+		return requestAttributes{verb: strings.ToLower(req.Method), path: path}
+	}
+
+	resource := info.Resource
+	if info.Subresource != "" {
+		resource += "/" + info.Subresource
+	}
+	return requestAttributes{
+		verb:     info.Verb,
+		apiGroup: info.APIGroup,
+		resource: resource,
+		name:     info.Name,
+	}
+}
+
+func rulesAllow(rules []rbacv1.PolicyRule, attributes requestAttributes) bool {
+	for _, rule := range rules {
+		if ruleAllows(rule, attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleAllows(rule rbacv1.PolicyRule, attributes requestAttributes) bool {
+	if !matches(rule.Verbs, attributes.verb) {
+		return false
+	}
+	if attributes.path != "" {
+		return nonResourceURLMatches(rule.NonResourceURLs, attributes.path)
+	}
+	if !matches(rule.APIGroups, attributes.apiGroup) || !resourceMatches(rule.Resources, attributes) {
+		return false
+	}
+	return len(rule.ResourceNames) == 0 || (attributes.name != "" && matches(rule.ResourceNames, attributes.name))
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == rbacv1.VerbAll || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceMatches(resources []string, attributes requestAttributes) bool {
+	resource, subresource, _ := strings.Cut(attributes.resource, "/")
+	for _, r := range resources {
+		if r == rbacv1.ResourceAll || r == attributes.resource {
+			return true
+		}
+		if subresource != "" && r == "*/"+subresource {
+			return true
+		}
+		if attributes.steve && strings.EqualFold(r, plural(resource)) {
+			return true
+		}
+	}
+	return false
+}
+
+func nonResourceURLMatches(urls []string, path string) bool {
+	for _, u := range urls {
+		if u == rbacv1.NonResourceAll || u == path {
+			return true
+		}
+		if strings.HasSuffix(u, "*") && strings.HasPrefix(path, strings.TrimSuffix(u, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// plural returns the resource name of a lower case kind, following the common English rules Kubernetes uses.
+func plural(kind string) string {
+	switch {
+	case strings.HasSuffix(kind, "s"), strings.HasSuffix(kind, "x"), strings.HasSuffix(kind, "ch"), strings.HasSuffix(kind, "sh"):
+		return kind + "es"
+	case strings.HasSuffix(kind, "y") && len(kind) > 1 && !strings.ContainsAny(kind[len(kind)-2:len(kind)-1], "aeiou"):
+		return kind[:len(kind)-1] + "ies"
+	}
+	return kind + "s"
+}
+
+func splitProjectID(projectID string) (string, string) {
+	clusterID, projectName, _ := strings.Cut(projectID, ":")
+	return clusterID, projectName
+}
@@ -0,0 +1,173 @@
+package tokens
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	clientv3 "github.com/rancher/rancher/pkg/client/generated/management/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestCheckScope(t *testing.T) {
+	readOnly := []rbacv1.PolicyRule{{
+		Verbs:     []string{"get", "list", "watch"},
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+	}}
+	deployments := []rbacv1.PolicyRule{{
+		Verbs:     []string{"*"},
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments"},
+	}, {
+		Verbs:           []string{"get"},
+		NonResourceURLs: []string{"/version"},
+	}}
+
+	tests := []struct {
+		name    string
+		token   v3.Token
+		method  string
+		url     string
+		cluster string
+		allowed bool
+	}{
+		{
+			name:    "unscoped token",
+			method:  "DELETE",
+			url:     "/v3/clusters/c-1",
+			allowed: true,
+		},
+		{
+			name:    "read only norman list",
+			token:   v3.Token{Rules: readOnly},
+			method:  "GET",
+			url:     "/v3/clusters",
+			allowed: true,
+		},
+		{
+			name:   "read only norman action",
+			token:  v3.Token{Rules: readOnly},
+			method: "POST",
+			url:    "/v3/users/u-1?action=setpassword",
+		},
+		{
+			name:    "read only kubernetes get",
+			token:   v3.Token{Rules: readOnly},
+			method:  "GET",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/api/v1/namespaces/default/pods/web",
+			allowed: true,
+		},
+		{
+			name:    "read only kubernetes delete",
+			token:   v3.Token{Rules: readOnly},
+			method:  "DELETE",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/api/v1/namespaces/default/pods/web",
+		},
+		{
+			name:    "kubernetes resource in rules",
+			token:   v3.Token{Rules: deployments},
+			method:  "PATCH",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/apis/apps/v1/namespaces/default/deployments/web",
+			allowed: true,
+		},
+		{
+			name:    "kubernetes subresource not in rules",
+			token:   v3.Token{Rules: deployments},
+			method:  "PUT",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/apis/apps/v1/namespaces/default/deployments/web/scale",
+		},
+		{
+			name:    "steve kind matches plural resource",
+			token:   v3.Token{Rules: deployments},
+			method:  "GET",
+			url:     "/v1/apps.deployment/default/web",
+			allowed: true,
+		},
+		{
+			name:    "non resource url",
+			token:   v3.Token{Rules: deployments},
+			method:  "GET",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/version",
+			allowed: true,
+		},
+		{
+			name:    "allowed cluster",
+			token:   v3.Token{AllowedClusters: []string{"c-1"}},
+			method:  "GET",
+			cluster: "c-1",
+			url:     "/k8s/clusters/c-1/api/v1/pods",
+			allowed: true,
+		},
+		{
+			name:    "other cluster",
+			token:   v3.Token{AllowedClusters: []string{"c-1"}},
+			method:  "GET",
+			cluster: "c-2",
+			url:     "/k8s/clusters/c-2/api/v1/pods",
+		},
+		{
+			name:    "project of allowed cluster",
+			token:   v3.Token{AllowedClusters: []string{"c-1"}},
+			method:  "GET",
+			url:     "/v3/project/c-1:p-1/workloads",
+			allowed: true,
+		},
+		{
+			name:    "allowed project",
+			token:   v3.Token{AllowedProjects: []string{"c-1:p-1"}},
+			method:  "GET",
+			url:     "/v3/project/c-1:p-1/apps",
+			allowed: true,
+		},
+		{
+			name:   "other project",
+			token:  v3.Token{AllowedProjects: []string{"c-1:p-1"}},
+			method: "GET",
+			url:    "/v3/project/c-1:p-2/apps",
+		},
+		{
+			name:   "management request with allowed clusters",
+			token:  v3.Token{AllowedClusters: []string{"c-1"}},
+			method: "GET",
+			url:    "/v3/users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.url, nil)
+			err := CheckScope(&tt.token, req, tt.cluster)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScopeRules(t *testing.T) {
+	rules, err := scopeRules([]clientv3.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.PolicyRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}, rules)
+
+	_, err = scopeRules([]clientv3.PolicyRule{{Resources: []string{"pods"}}})
+	assert.Error(t, err)
+
+	_, err = scopeRules([]clientv3.PolicyRule{{Verbs: []string{"get"}}})
+	assert.Error(t, err)
+}
+
+func TestPlural(t *testing.T) {
+	assert.Equal(t, "deployments", plural("deployment"))
+	assert.Equal(t, "ingresses", plural("ingress"))
+	assert.Equal(t, "networkpolicies", plural("networkpolicy"))
+	assert.Equal(t, "gateways", plural("gateway"))
+}
@@ -0,0 +1,228 @@
+package tokens
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// lastUsedGranularity is how often the last use of a token is written at most, so that busy tokens
+// don't cause a write on every request.
+const lastUsedGranularity = time.Minute
+
+// UsageRecorder records when and from where tokens were last used.
+type UsageRecorder struct {
+	tokens v3.TokenInterface
+
+	lock      sync.Mutex
+	recorded  map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewUsageRecorder(tokens v3.TokenInterface) *UsageRecorder {
+	return &UsageRecorder{
+		tokens:   tokens,
+		recorded: map[string]time.Time{},
+	}
+}
+
+// Record updates the last use of token in the background. The token is only written if its last use is older than
+// a minute or came from another IP, and only once a minute per token by this server while the cache catches up.
+func (u *UsageRecorder) Record(token *v3.Token, ip string, now time.Time) {
+	if token.LastUsedAt != nil && now.Sub(token.LastUsedAt.Time) < lastUsedGranularity && token.LastUsedIP == ip {
+		return
+	}
+	if !u.claim(token.Name, now) {
+		return
+	}
+
+	token = token.DeepCopy()
+	token.LastUsedAt = &metav1.Time{Time: now.UTC().Truncate(time.Second)}
+	token.LastUsedIP = ip
+	go func() {
+		if _, err := u.tokens.Update(token); err != nil {
+			// a conflict means the token changed since it was cached, its use is recorded with the next request
+			if !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+				logrus.Warnf("Failed to record last use of token %s: %v", token.Name, err)
+			}
+		}
+	}()
+}
+
+func (u *UsageRecorder) claim(tokenName string, now time.Time) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if now.Sub(u.lastPrune) > lastUsedGranularity {
+		for name, recorded := range u.recorded {
+			if now.Sub(recorded) >= lastUsedGranularity {
+				delete(u.recorded, name)
+			}
+		}
+		u.lastPrune = now
+	}
+
+	if recorded, ok := u.recorded[tokenName]; ok && now.Sub(recorded) < lastUsedGranularity {
+		return false
+	}
+	u.recorded[tokenName] = now
+	return true
+}
+
+// ClientIP returns the address of the client that sent the request. The X-Forwarded-For header is only used when
+// the request comes from one of the proxies in the auth-trusted-proxy-cidrs setting, and then yields the last address
+// that isn't a trusted proxy, since clients can put any address at the start of the header.
+func ClientIP(req *http.Request) string {
+	return clientIP(req, trustedProxies(settings.AuthTrustedProxyCIDRs.Get()))
+}
+
+func clientIP(req *http.Request, trusted []*net.IPNet) string {
+	remote, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		remote = req.RemoteAddr
+	}
+	if !isTrusted(remote, trusted) {
+		return remote
+	}
+
+	var forwarded []string
+	for _, header := range req.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	client := remote
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(address) == nil {
+			break
+		}
+		client = address
+		if !isTrusted(address, trusted) {
+			break
+		}
+	}
+	return client
+}
+
+func trustedProxies(cidrs string) []*net.IPNet {
+	var trusted []*net.IPNet
+	for _, cidr := range strings.Split(cidrs, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			logrus.Warnf("Ignoring invalid CIDR %q in setting %s", cidr, settings.AuthTrustedProxyCIDRs.Name)
+			continue
+		}
+		trusted = append(trusted, ipNet)
+	}
+	return trusted
+}
+
+func isTrusted(address string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// IdleTTL returns the time after which unused tokens expire, or 0 if tokens don't expire from being idle.
+func IdleTTL() time.Duration {
+	return time.Duration(settings.AuthTokenIdleTTLMinutes.GetInt()) * time.Minute
+}
+
+// IsIdleExpired returns true if the token was not used for longer than idleTTL. Tokens that were never used
+// are idle since their creation. Only session tokens and tokens users created themselves expire from being idle,
+// tokens Rancher creates for its own components don't. Neither do tokens of a cluster that are synced to it as a
+// ClusterAuthToken, since their use through the authorized cluster endpoint doesn't reach Rancher.
+func IsIdleExpired(token v3.Token, idleTTL time.Duration, now time.Time) bool {
+	if idleTTL <= 0 {
+		return false
+	}
+	if kind := token.Labels[TokenKindLabel]; kind != "session" && !token.IsDerived {
+		return false
+	}
+	if token.ClusterName != "" && !IsScoped(&token) {
+		return false
+	}
+
+	return now.Sub(lastUsed(token)) >= idleTTL
+}
+
+// Query parameters of the token list filtering and sorting by last use.
+const (
+	lastUsedBeforeParam = "lastUsedBefore"
+	lastUsedAfterParam  = "lastUsedAfter"
+	neverUsedParam      = "neverUsed"
+	sortParam           = "sort"
+	orderParam          = "order"
+)
+
+// filterByUsage filters tokens by the lastUsedBefore, lastUsedAfter and neverUsed query parameters and sorts
+// them by last use if sort=lastUsedAt. Tokens that were never used count as last used when they were created.
+func filterByUsage(tokens []v3.Token, query url.Values) ([]v3.Token, error) {
+	var before, after time.Time
+	for param, t := range map[string]*time.Time{lastUsedBeforeParam: &before, lastUsedAfterParam: &after} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q, must be an RFC 3339 time", param, value)
+		}
+		*t = parsed
+	}
+	neverUsed := query.Get(neverUsedParam)
+
+	filtered := make([]v3.Token, 0, len(tokens))
+	for _, token := range tokens {
+		used := token.LastUsedAt != nil
+		if (neverUsed == "true" && used) || (neverUsed == "false" && !used) {
+			continue
+		}
+		if !before.IsZero() && !lastUsed(token).Before(before) {
+			continue
+		}
+		if !after.IsZero() && !lastUsed(token).After(after) {
+			continue
+		}
+		filtered = append(filtered, token)
+	}
+
+	if query.Get(sortParam) == "lastUsedAt" {
+		desc := query.Get(orderParam) == "desc"
+		sort.SliceStable(filtered, func(i, j int) bool {
+			if desc {
+				i, j = j, i
+			}
+			return lastUsed(filtered[i]).Before(lastUsed(filtered[j]))
+		})
+	}
+	return filtered, nil
+}
+
+func lastUsed(token v3.Token) time.Time {
+	if token.LastUsedAt == nil {
+		return token.CreationTimestamp.Time
+	}
+	return token.LastUsedAt.Time
+}
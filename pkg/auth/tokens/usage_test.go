@@ -0,0 +1,121 @@
+package tokens
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var usageNow = time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func usageToken(name string, created time.Duration, lastUsed *time.Duration) v3.Token {
+	token := v3.Token{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(usageNow.Add(-created)),
+		},
+		IsDerived: true,
+	}
+	if lastUsed != nil {
+		token.LastUsedAt = &metav1.Time{Time: usageNow.Add(-*lastUsed)}
+	}
+	return token
+}
+
+func ago(d time.Duration) *time.Duration {
+	return &d
+}
+
+func tokenNames(tokens []v3.Token) []string {
+	var names []string
+	for _, token := range tokens {
+		names = append(names, token.Name)
+	}
+	return names
+}
+
+func TestFilterByUsage(t *testing.T) {
+	tokens := []v3.Token{
+		usageToken("recent", 48*time.Hour, ago(time.Hour)),
+		usageToken("unused", 72*time.Hour, nil),
+		usageToken("stale", 96*time.Hour, ago(30*time.Hour)),
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{"recent", "unused", "stale"}},
+		{query: "neverUsed=true", want: []string{"unused"}},
+		{query: "neverUsed=false", want: []string{"recent", "stale"}},
+		{query: "lastUsedBefore=2022-05-31T12:00:00Z", want: []string{"unused", "stale"}},
+		{query: "lastUsedAfter=2022-05-31T12:00:00Z", want: []string{"recent"}},
+		{query: "sort=lastUsedAt", want: []string{"unused", "stale", "recent"}},
+		{query: "sort=lastUsedAt&order=desc", want: []string{"recent", "stale", "unused"}},
+	}
+	for _, tt := range tests {
+		query, err := url.ParseQuery(tt.query)
+		require.NoError(t, err)
+		filtered, err := filterByUsage(tokens, query)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, tokenNames(filtered), tt.query)
+	}
+
+	_, err := filterByUsage(tokens, url.Values{lastUsedBeforeParam: []string{"yesterday"}})
+	assert.Error(t, err)
+}
+
+func TestIsIdleExpired(t *testing.T) {
+	assert.False(t, IsIdleExpired(usageToken("unused", 72*time.Hour, nil), 0, usageNow), "idle expiry is disabled")
+	assert.True(t, IsIdleExpired(usageToken("unused", 72*time.Hour, nil), 24*time.Hour, usageNow))
+	assert.False(t, IsIdleExpired(usageToken("recent", 72*time.Hour, ago(time.Hour)), 24*time.Hour, usageNow))
+	assert.True(t, IsIdleExpired(usageToken("stale", 96*time.Hour, ago(30*time.Hour)), 24*time.Hour, usageNow))
+
+	system := usageToken("system", 96*time.Hour, nil)
+	system.IsDerived = false
+	assert.False(t, IsIdleExpired(system, 24*time.Hour, usageNow), "tokens created by Rancher don't expire from being idle")
+	system.Labels = map[string]string{TokenKindLabel: "session"}
+	assert.True(t, IsIdleExpired(system, 24*time.Hour, usageNow))
+
+	kubeconfig := usageToken("kubeconfig", 96*time.Hour, nil)
+	kubeconfig.ClusterName = "c-1"
+	assert.False(t, IsIdleExpired(kubeconfig, 24*time.Hour, usageNow), "tokens used through the authorized cluster endpoint aren't recorded")
+	kubeconfig.AllowedProjects = []string{"c-1:p-1"}
+	assert.True(t, IsIdleExpired(kubeconfig, 24*time.Hour, usageNow), "scoped tokens are only used through Rancher")
+}
+
+func TestUsageRecorderClaim(t *testing.T) {
+	recorder := NewUsageRecorder(nil)
+	assert.True(t, recorder.claim("token-a", usageNow))
+	assert.False(t, recorder.claim("token-a", usageNow.Add(30*time.Second)))
+	assert.True(t, recorder.claim("token-b", usageNow.Add(30*time.Second)))
+	assert.True(t, recorder.claim("token-a", usageNow.Add(time.Minute)))
+	assert.Len(t, recorder.recorded, 2)
+
+	// entries older than the granularity are pruned
+	assert.True(t, recorder.claim("token-c", usageNow.Add(5*time.Minute)))
+	assert.Len(t, recorder.recorded, 1)
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := trustedProxies("10.0.0.0/24, invalid")
+
+	req := httptest.NewRequest("GET", "/v3", nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	assert.Equal(t, "10.0.0.1", clientIP(req, trusted))
+
+	// the last address that isn't a trusted proxy is the client, earlier ones are set by the client
+	req.Header.Set("X-Forwarded-For", "1.2.3.4, 192.168.1.1, 10.0.0.2")
+	assert.Equal(t, "192.168.1.1", clientIP(req, trusted))
+
+	// forwarded headers of untrusted peers are ignored
+	req.RemoteAddr = "172.16.0.1:4321"
+	assert.Equal(t, "172.16.0.1", clientIP(req, trusted))
+	assert.Equal(t, "172.16.0.1", clientIP(req, nil))
+}
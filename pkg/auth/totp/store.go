@@ -0,0 +1,162 @@
+package totp
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rancher/rancher/pkg/auth/providers/common"
+	corev1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"golang.org/x/crypto/bcrypt"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	secretField = "totp"
+
+	// RecoveryCodeCount is the number of recovery codes generated when a user enrolls.
+	RecoveryCodeCount = 10
+	recoveryCodeChars = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeLen   = 10
+)
+
+// Enrollment is the TOTP state of a user, stored in a secret.
+type Enrollment struct {
+	// Secret is the verified secret codes are checked against. Empty until enrollment is verified.
+	Secret string `json:"secret,omitempty"`
+	// PendingSecret is a secret returned to the user that has not yet been confirmed with a valid code.
+	PendingSecret string `json:"pendingSecret,omitempty"`
+	// RecoveryCodes are the bcrypt hashes of the unused recovery codes.
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+	// LastStep is the time step of the last accepted code, codes can't be used twice.
+	LastStep uint64 `json:"lastStep,omitempty"`
+}
+
+// Enrolled returns true if the enrollment has a verified secret.
+func (e *Enrollment) Enrolled() bool {
+	return e != nil && e.Secret != ""
+}
+
+// CheckCode validates code as either a TOTP code or an unused recovery code. A used recovery code
+// is removed from the enrollment, so the caller must save it when the check succeeds.
+func (e *Enrollment) CheckCode(code string, now time.Time) bool {
+	if !e.Enrolled() {
+		return false
+	}
+	if step, ok := Validate(e.Secret, code, now); ok {
+		if step <= e.LastStep {
+			return false
+		}
+		e.LastStep = step
+		return true
+	}
+	for i, hash := range e.RecoveryCodes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(code)) == nil {
+			e.RecoveryCodes = append(e.RecoveryCodes[:i:i], e.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRecoveryCodes replaces the recovery codes of the enrollment and returns them in plain text.
+func (e *Enrollment) GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		code, err := randomString(recoveryCodeLen)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, string(hash))
+	}
+	e.RecoveryCodes = hashes
+	return codes, nil
+}
+
+func randomString(length int) (string, error) {
+	out := make([]byte, length)
+	max := big.NewInt(int64(len(recoveryCodeChars)))
+	for i := range out {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		out[i] = recoveryCodeChars[n.Int64()]
+	}
+	return string(out), nil
+}
+
+// Store keeps the TOTP enrollment of each user in a secret in the global data namespace.
+type Store struct {
+	Secrets corev1.SecretInterface
+}
+
+// Get returns the enrollment of the user, or an empty enrollment if the user never enrolled.
+func (s *Store) Get(userName string) (*Enrollment, error) {
+	secret, err := s.Secrets.GetNamespaced(common.SecretsNamespace, secretName(userName), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return &Enrollment{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get TOTP enrollment of user %s: %w", userName, err)
+	}
+
+	enrollment := &Enrollment{}
+	if err := json.Unmarshal(secret.Data[secretField], enrollment); err != nil {
+		return nil, fmt.Errorf("failed to read TOTP enrollment of user %s: %w", userName, err)
+	}
+	return enrollment, nil
+}
+
+// Save stores the enrollment of the user. The secret is read and written without the cache, so an enrollment is
+// never reported as saved when it wasn't.
+func (s *Store) Save(userName string, enrollment *Enrollment) error {
+	data, err := json.Marshal(enrollment)
+	if err != nil {
+		return err
+	}
+	if err := common.SaveSecretField(s.Secrets, userName, secretField, data); err != nil {
+		return fmt.Errorf("failed to save TOTP enrollment of user %s: %w", userName, err)
+	}
+	return nil
+}
+
+// Delete removes the enrollment of the user.
+func (s *Store) Delete(userName string) error {
+	err := common.DeleteSecret(s.Secrets, userName, secretField)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// EnrollmentRules limit the session of a user that must enroll a TOTP second factor to reading and enrolling
+// their own user.
+func EnrollmentRules(userName string) []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"management.cattle.io"},
+			Resources: []string{"users"},
+			Verbs:     []string{"get", "list"},
+		},
+		{
+			APIGroups:     []string{"management.cattle.io"},
+			Resources:     []string{"users"},
+			ResourceNames: []string{userName},
+			Verbs:         []string{"update"},
+		},
+	}
+}
+
+func secretName(userName string) string {
+	return fmt.Sprintf("%s-%s", userName, secretField)
+}
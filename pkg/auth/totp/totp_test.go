@@ -0,0 +1,133 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/rancher/rancher/pkg/generated/norman/core/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// rfcSecret is the base32 encoding of the RFC 6238 SHA1 test key "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B test vectors, truncated to six digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+	for _, test := range tests {
+		code, err := Code(rfcSecret, Step(time.Unix(test.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, test.want, code, "time %d", test.unix)
+	}
+
+	_, err := Code("not base32!", 1)
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	step, ok := Validate(rfcSecret, "050471", now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// the code of the previous period is accepted to allow for clock drift
+	_, ok = Validate(rfcSecret, "050471", now.Add(Period*time.Second))
+	assert.True(t, ok)
+
+	_, ok = Validate(rfcSecret, "050471", now.Add(2*Period*time.Second))
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "123", now)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	_, err = Code(secret, 1)
+	assert.NoError(t, err)
+}
+
+func TestKeyURI(t *testing.T) {
+	u, err := url.Parse(KeyURI("Rancher", "admin", rfcSecret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Rancher:admin", u.Path)
+	assert.Equal(t, rfcSecret, u.Query().Get("secret"))
+	assert.Equal(t, "Rancher", u.Query().Get("issuer"))
+}
+
+func TestEnrollmentCheckCode(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	assert.False(t, (&Enrollment{PendingSecret: rfcSecret}).CheckCode("050471", now), "a pending secret must not be accepted")
+
+	enrollment := &Enrollment{Secret: rfcSecret}
+	recoveryCodes, err := enrollment.GenerateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, recoveryCodes, RecoveryCodeCount)
+	require.Len(t, enrollment.RecoveryCodes, RecoveryCodeCount)
+
+	assert.True(t, enrollment.CheckCode("050471", now))
+	assert.False(t, enrollment.CheckCode("050471", now), "a code must not be accepted twice")
+	assert.False(t, enrollment.CheckCode("000000", now))
+
+	assert.True(t, enrollment.CheckCode(recoveryCodes[3], now))
+	assert.Len(t, enrollment.RecoveryCodes, RecoveryCodeCount-1)
+	assert.False(t, enrollment.CheckCode(recoveryCodes[3], now), "a recovery code must not be accepted twice")
+	assert.True(t, enrollment.CheckCode(recoveryCodes[4], now))
+}
+
+func TestStoreSave(t *testing.T) {
+	var created, updated []*v1.Secret
+	existing := map[string]*v1.Secret{}
+	store := &Store{
+		Secrets: &fakes.SecretInterfaceMock{
+			GetNamespacedFunc: func(namespace string, name string, opts metav1.GetOptions) (*v1.Secret, error) {
+				if secret, ok := existing[name]; ok {
+					return secret, nil
+				}
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+			CreateFunc: func(in1 *v1.Secret) (*v1.Secret, error) {
+				created = append(created, in1)
+				existing[in1.Name] = in1
+				return in1, nil
+			},
+			UpdateFunc: func(in1 *v1.Secret) (*v1.Secret, error) {
+				updated = append(updated, in1)
+				return nil, apierrors.NewConflict(schema.GroupResource{}, in1.Name, nil)
+			},
+		},
+	}
+
+	require.NoError(t, store.Save("u-1", &Enrollment{PendingSecret: rfcSecret}))
+	require.Len(t, created, 1)
+	assert.Equal(t, "u-1-totp", created[0].Name)
+
+	// failing writes are returned rather than ignored
+	err := store.Save("u-1", &Enrollment{Secret: rfcSecret})
+	assert.True(t, apierrors.IsConflict(err))
+	assert.Len(t, updated, 1)
+}
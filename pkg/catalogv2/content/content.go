@@ -18,6 +18,7 @@
 	"github.com/rancher/rancher/pkg/catalogv2/git"
 	"github.com/rancher/rancher/pkg/catalogv2/helm"
 	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/rancher/pkg/catalogv2/oci"
 	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
 	"github.com/rancher/rancher/pkg/settings"
 	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
@@ -289,6 +290,11 @@ func (c *Manager) Icon(namespace, name, chartName, version string) (io.ReadClose
 		return git.Icon(namespace, name, repo.status.URL, chart)
 	}
 
+	// charts in OCI registries can only link to icons elsewhere
+	if !isHTTP(chart.Icon) && oci.IsOCI(repo.status.URL) {
+		return nil, "", fmt.Errorf("failed to find icon of chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
 	secret, err := catalogv2.GetSecret(c.secrets, repo.spec, repo.metadata.Namespace)
 	if err != nil {
 		return nil, "", err
@@ -327,6 +333,10 @@ func (c *Manager) Chart(namespace, name, chartName, version string, skipFilter b
 		return nil, err
 	}
 
+	if oci.IsOCI(repo.status.URL) {
+		return oci.Chart(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, chart)
+	}
+
 	return helmhttp.Chart(secret, repo.status.URL, repo.spec.CABundle, repo.spec.InsecureSkipTLSverify, repo.spec.DisableSameOriginCheck, chart)
 }
 
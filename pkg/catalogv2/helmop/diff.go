@@ -0,0 +1,308 @@
+package helmop
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	types2 "github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	"github.com/rancher/rancher/pkg/catalogv2/helm"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	wyaml "github.com/rancher/wrangler/pkg/yaml"
+	"helm.sh/helm/v3/pkg/release"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+
+	// maxDryRunOutput limits how much of the log of a dry run operation is read.
+	maxDryRunOutput = int64(16 << 20)
+)
+
+// dryRunOutput makes helm print the release it would create as JSON, which Diff reads back from the log of the operation.
+var dryRunOutput = map[string]interface{}{
+	"output": "json",
+}
+
+// Diff compares the releases rendered by a dry run operation with the deployed revisions of the releases, per
+// resource and per value. The deployed releases are read as the user of the request.
+func (s *Operations) Diff(ctx context.Context, namespace, name string) ([]types2.ChartDiff, error) {
+	op, pod, err := s.operationPod(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !op.Status.DryRun {
+		return nil, validation.NotFound
+	}
+	if !succeeded(pod) {
+		return nil, validation.ErrorCode{
+			Status: http.StatusConflict,
+			Code:   "OperationNotComplete",
+		}
+	}
+
+	client, err := s.cg.AdminK8sInterface()
+	if err != nil {
+		return nil, err
+	}
+	limit := maxDryRunOutput
+	logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
+		Container:  "helm",
+		LimitBytes: &limit,
+	}).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := parseDryRunOutput(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []types2.ChartDiff
+	for _, rel := range rendered {
+		live, err := s.deployedRelease(ctx, rel.Namespace, rel.Name)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := diffReleases(live, rel)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+func succeeded(pod *v1.Pod) bool {
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name == "helm" {
+			return container.State.Terminated != nil && container.State.Terminated.ExitCode == 0
+		}
+	}
+	return false
+}
+
+// deployedRelease returns the deployed revision of a release, or nil if the release isn't installed.
+func (s *Operations) deployedRelease(ctx context.Context, namespace, releaseName string) (*release.Release, error) {
+	secrets, err := s.releaseSecrets(ctx, namespace, releaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployed *release.Release
+	for i := range secrets.Items {
+		rel, err := helm.ToHelm3Release(&secrets.Items[i])
+		if err == helm.ErrNotHelmRelease {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if rel.Info == nil || rel.Info.Status != release.StatusDeployed {
+			continue
+		}
+		if deployed == nil || rel.Version > deployed.Version {
+			deployed = rel
+		}
+	}
+	return deployed, nil
+}
+
+// parseDryRunOutput reads the releases helm printed as JSON from the log of a dry run operation. The log may
+// contain other output, such as warnings, around the JSON.
+func parseDryRunOutput(logs []byte) ([]*release.Release, error) {
+	var (
+		releases []*release.Release
+		rest     = logs
+	)
+	for len(rest) > 0 {
+		start := bytes.IndexByte(rest, '{')
+		if start < 0 {
+			break
+		}
+		rest = rest[start:]
+
+		rel := &release.Release{}
+		decoder := json.NewDecoder(bytes.NewReader(rest))
+		if err := decoder.Decode(rel); err != nil || rel.Name == "" {
+			rest = rest[1:]
+			continue
+		}
+		releases = append(releases, rel)
+		rest = rest[decoder.InputOffset():]
+	}
+
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("failed to find the output of the dry run in the operation log")
+	}
+	return releases, nil
+}
+
+// diffReleases compares the rendered release with the live release, which is nil if the release isn't installed.
+func diffReleases(live, rendered *release.Release) (types2.ChartDiff, error) {
+	result := types2.ChartDiff{
+		ReleaseName: rendered.Name,
+		Namespace:   rendered.Namespace,
+	}
+
+	var (
+		liveManifest string
+		liveValues   map[string]interface{}
+	)
+	if live != nil {
+		liveManifest = live.Manifest
+		liveValues = live.Config
+	}
+
+	liveResources, err := manifestResources(liveManifest)
+	if err != nil {
+		return result, err
+	}
+	renderedResources, err := manifestResources(rendered.Manifest)
+	if err != nil {
+		return result, err
+	}
+
+	resourceKeys := map[string]bool{}
+	for key := range liveResources {
+		resourceKeys[key] = true
+	}
+	for key := range renderedResources {
+		resourceKeys[key] = true
+	}
+	for _, key := range sortedKeys(resourceKeys) {
+		before, inLive := liveResources[key]
+		after, inRendered := renderedResources[key]
+		diff := before.ResourceDiff
+		if !inLive {
+			diff = after.ResourceDiff
+		}
+
+		switch {
+		case !inLive:
+			diff.Change = ChangeAdded
+		case !inRendered:
+			diff.Change = ChangeRemoved
+		case before.yaml != after.yaml:
+			diff.Change = ChangeChanged
+		default:
+			continue
+		}
+
+		diff.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(before.yaml),
+			B:        difflib.SplitLines(after.yaml),
+			FromFile: "live",
+			ToFile:   "dry-run",
+			Context:  3,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Resources = append(result.Resources, diff)
+	}
+
+	liveFlat, renderedFlat := flatten("", liveValues, map[string]interface{}{}), flatten("", rendered.Config, map[string]interface{}{})
+	paths := map[string]bool{}
+	for path := range liveFlat {
+		paths[path] = true
+	}
+	for path := range renderedFlat {
+		paths[path] = true
+	}
+	for _, path := range sortedKeys(paths) {
+		before, inLive := liveFlat[path]
+		after, inRendered := renderedFlat[path]
+		diff := types2.ValueDiff{
+			Path: path,
+			Old:  before,
+			New:  after,
+		}
+		switch {
+		case !inLive:
+			diff.Change = ChangeAdded
+		case !inRendered:
+			diff.Change = ChangeRemoved
+		case !reflect.DeepEqual(before, after):
+			diff.Change = ChangeChanged
+		default:
+			continue
+		}
+		result.Values = append(result.Values, diff)
+	}
+
+	return result, nil
+}
+
+type manifestResource struct {
+	types2.ResourceDiff
+	yaml string
+}
+
+// manifestResources splits a manifest into its resources, keyed by their apiVersion, kind, namespace and name.
+// Resources are marshalled again so that formatting differences don't show up as changes.
+func manifestResources(manifest string) (map[string]manifestResource, error) {
+	objs, err := wyaml.ToObjects(strings.NewReader(manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]manifestResource{}
+	for _, obj := range objs {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		r := manifestResource{
+			ResourceDiff: types2.ResourceDiff{
+				Namespace: m.GetNamespace(),
+				Name:      m.GetName(),
+			},
+			yaml: string(data),
+		}
+		r.APIVersion, r.Kind = obj.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+		result[strings.Join([]string{r.APIVersion, r.Kind, r.Namespace, r.Name}, "/")] = r
+	}
+	return result, nil
+}
+
+// flatten turns nested values into paths like a.b.c, lists are compared as a whole.
+func flatten(prefix string, values map[string]interface{}, result map[string]interface{}) map[string]interface{} {
+	for k, v := range values {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flatten(path, nested, result)
+			continue
+		}
+		result[path] = v
+	}
+	return result
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
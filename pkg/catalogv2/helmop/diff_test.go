@@ -0,0 +1,136 @@
+package helmop
+
+import (
+	"encoding/json"
+	"testing"
+
+	types2 "github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const liveManifest = `---
+# Source: test-chart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: test-ns
+data:
+  replicas: "1"
+---
+# Source: test-chart/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-service
+  namespace: test-ns
+spec:
+  ports:
+  - port: 80
+---
+# Source: test-chart/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+  namespace: test-ns
+`
+
+const renderedManifest = `---
+# Source: test-chart/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  namespace: test-ns
+  name: test-service
+spec:
+  ports:
+  - port: 80
+---
+# Source: test-chart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: test-ns
+data:
+  replicas: "3"
+---
+# Source: test-chart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: test-ns
+`
+
+func Test_parseDryRunOutput(t *testing.T) {
+	rel, err := json.Marshal(&release.Release{Name: "test", Namespace: "test-ns", Manifest: renderedManifest})
+	require.NoError(t, err)
+
+	logs := "helm upgrade --dry-run=true --output=json test /home/shell/helm/test-chart-1.1.0.tgz\n" +
+		"WARNING: {not json}\n" + string(rel) + "\n" + string(rel)
+	releases, err := parseDryRunOutput([]byte(logs))
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, "test", releases[0].Name)
+	assert.Equal(t, renderedManifest, releases[0].Manifest)
+
+	_, err = parseDryRunOutput([]byte("Error: UPGRADE FAILED: {something}\n"))
+	assert.Error(t, err)
+}
+
+func Test_diffReleases(t *testing.T) {
+	live := &release.Release{
+		Name:     "test",
+		Manifest: liveManifest,
+		Config: map[string]interface{}{
+			"replicas": 1,
+			"image":    map[string]interface{}{"tag": "v1", "pullPolicy": "Always"},
+			"debug":    true,
+		},
+	}
+	rendered := &release.Release{
+		Name:      "test",
+		Namespace: "test-ns",
+		Manifest:  renderedManifest,
+		Config: map[string]interface{}{
+			"replicas": 3,
+			"image":    map[string]interface{}{"tag": "v2", "pullPolicy": "Always"},
+			"ingress":  map[string]interface{}{"enabled": true},
+		},
+	}
+
+	diff, err := diffReleases(live, rendered)
+	require.NoError(t, err)
+	assert.Equal(t, "test", diff.ReleaseName)
+	assert.Equal(t, "test-ns", diff.Namespace)
+
+	require.Len(t, diff.Resources, 3, "the service is unchanged")
+	assert.Equal(t, "Deployment", diff.Resources[0].Kind)
+	assert.Equal(t, "apps/v1", diff.Resources[0].APIVersion)
+	assert.Equal(t, ChangeAdded, diff.Resources[0].Change)
+	assert.Equal(t, "test-config", diff.Resources[1].Name)
+	assert.Equal(t, ChangeChanged, diff.Resources[1].Change)
+	assert.Contains(t, diff.Resources[1].Diff, `-  replicas: "1"`)
+	assert.Contains(t, diff.Resources[1].Diff, `+  replicas: "3"`)
+	assert.Equal(t, "test-secret", diff.Resources[2].Name)
+	assert.Equal(t, ChangeRemoved, diff.Resources[2].Change)
+
+	assert.Equal(t, []types2.ValueDiff{
+		{Path: "debug", Change: ChangeRemoved, Old: true},
+		{Path: "image.tag", Change: ChangeChanged, Old: "v1", New: "v2"},
+		{Path: "ingress.enabled", Change: ChangeAdded, New: true},
+		{Path: "replicas", Change: ChangeChanged, Old: 1, New: 3},
+	}, diff.Values)
+
+	diff, err = diffReleases(nil, rendered)
+	require.NoError(t, err)
+	assert.Len(t, diff.Resources, 3, "everything is added to a release that isn't installed")
+	for _, resource := range diff.Resources {
+		assert.Equal(t, ChangeAdded, resource.Change)
+	}
+	assert.Len(t, diff.Values, 4)
+}
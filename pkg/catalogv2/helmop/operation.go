@@ -14,6 +14,7 @@
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -23,6 +24,7 @@
 	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/catalogv2/content"
+	"github.com/rancher/rancher/pkg/catalogv2/helm"
 	catalogcontrollers "github.com/rancher/rancher/pkg/generated/controllers/catalog.cattle.io/v1"
 	namespaces "github.com/rancher/rancher/pkg/namespace"
 	"github.com/rancher/rancher/pkg/settings"
@@ -37,7 +39,9 @@
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/client-go/kubernetes"
@@ -186,6 +190,80 @@ func (s *Operations) Uninstall(ctx context.Context, user user.Info, namespace, n
 	return s.createOperation(ctx, user, status, cmds, imageOverride)
 }
 
+func (s *Operations) Rollback(ctx context.Context, user user.Info, namespace, name string, options io.Reader, imageOverride string) (*catalog.Operation, error) {
+	status, cmds, err := s.getRollbackArgs(namespace, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err = s.getUser(user, namespace, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createOperation(ctx, user, status, cmds, imageOverride)
+}
+
+// History lists the revisions of the release of an app, newest first. The release secrets are read as the user
+// of the request, as they contain the values of each revision.
+func (s *Operations) History(ctx context.Context, namespace, name string) ([]types2.ChartRevision, error) {
+	rel, err := s.apps.Get(namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.releaseSecrets(ctx, rel.Namespace, rel.Spec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []types2.ChartRevision
+	for i := range secrets.Items {
+		release, err := helm.ToRelease(&secrets.Items[i], nil)
+		if err == helm.ErrNotHelmRelease {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, toRevision(release))
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// releaseSecrets lists the secrets helm stores the revisions of a release in, as the user of the request.
+func (s *Operations) releaseSecrets(ctx context.Context, namespace, releaseName string) (*v1.SecretList, error) {
+	client, err := s.cg.K8sInterface(types.GetAPIContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{"owner": "helm", "name": releaseName}.String(),
+	})
+}
+
+func toRevision(release *catalog.ReleaseSpec) types2.ChartRevision {
+	revision := types2.ChartRevision{
+		Revision: release.Version,
+		Values:   release.Values,
+	}
+	if release.Info != nil {
+		revision.Status = string(release.Info.Status)
+		revision.Description = release.Info.Description
+		revision.Updated = release.Info.LastDeployed
+	}
+	if release.Chart != nil && release.Chart.Metadata != nil {
+		revision.ChartName = release.Chart.Metadata.Name
+		revision.ChartVersion = release.Chart.Metadata.Version
+		revision.AppVersion = release.Chart.Metadata.AppVersion
+	}
+	return revision
+}
+
 func (s *Operations) Upgrade(ctx context.Context, user user.Info, namespace, name string, options io.Reader, imageOverride string) (*catalog.Operation, error) {
 	status, cmds, err := s.getUpgradeCommand(namespace, name, options)
 	if err != nil {
@@ -257,30 +335,40 @@ func (s *Operations) proxyLogRequest(rw http.ResponseWriter, req *http.Request,
 }
 
 func (s *Operations) Log(rw http.ResponseWriter, req *http.Request, namespace, name string) error {
-	op, err := s.ops.Get(namespace, name, metav1.GetOptions{})
+	_, pod, err := s.operationPod(namespace, name)
 	if err != nil {
 		return err
 	}
 
-	pod, err := s.pods.Get(op.Status.PodNamespace, op.Status.PodName, metav1.GetOptions{})
+	client, err := s.cg.AdminK8sInterface()
 	if err != nil {
 		return err
 	}
 
-	if len(pod.OwnerReferences) == 0 || len(op.OwnerReferences) == 0 || pod.OwnerReferences[0].UID != op.OwnerReferences[0].UID {
-		return validation.NotFound
-	}
+	return s.proxyLogRequest(rw, req, pod, client)
+}
 
-	if pod.Labels[podimpersonation.TokenLabel] != op.Status.Token {
-		return validation.NotFound
+// operationPod returns the operation and the pod that runs it, making sure the pod belongs to the operation.
+func (s *Operations) operationPod(namespace, name string) (*catalog.Operation, *v1.Pod, error) {
+	op, err := s.ops.Get(namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	client, err := s.cg.AdminK8sInterface()
+	pod, err := s.pods.Get(op.Status.PodNamespace, op.Status.PodName, metav1.GetOptions{})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return s.proxyLogRequest(rw, req, pod, client)
+	if len(pod.OwnerReferences) == 0 || len(op.OwnerReferences) == 0 || pod.OwnerReferences[0].UID != op.OwnerReferences[0].UID {
+		return nil, nil, validation.NotFound
+	}
+
+	if pod.Labels[podimpersonation.TokenLabel] != op.Status.Token {
+		return nil, nil, validation.NotFound
+	}
+
+	return op, pod, nil
 }
 
 func (s *Operations) getSpec(namespace, name string, isApp bool) (*catalog.RepoSpec, error) {
@@ -370,6 +458,49 @@ func (s *Operations) getUninstallArgs(appNamespace, appName string, body io.Read
 	return status, Commands{cmd}, nil
 }
 
+func (s *Operations) getRollbackArgs(appNamespace, appName string, body io.Reader) (catalog.OperationStatus, Commands, error) {
+	rel, err := s.apps.Get(appNamespace, appName, metav1.GetOptions{})
+	if err != nil {
+		return catalog.OperationStatus{}, nil, err
+	}
+
+	rollbackArgs := &types2.ChartRollbackAction{}
+	if err := json.NewDecoder(body).Decode(rollbackArgs); err != nil {
+		return catalog.OperationStatus{}, nil, err
+	}
+
+	if rollbackArgs.Revision < 0 {
+		return catalog.OperationStatus{}, nil, validation.ErrorCode{
+			Status: http.StatusUnprocessableEntity,
+			Code:   "InvalidBodyContent",
+		}
+	}
+	if rollbackArgs.MaxHistory == 0 {
+		rollbackArgs.MaxHistory = 5
+	}
+
+	cmd := Command{
+		Operation: "rollback",
+		ArgObjects: []interface{}{
+			rollbackArgs,
+		},
+		ReleaseName:      rel.Spec.Name,
+		ReleaseNamespace: rel.Namespace,
+	}
+	// without a revision helm rolls back to the previous one
+	if rollbackArgs.Revision > 0 {
+		cmd.Revision = strconv.Itoa(rollbackArgs.Revision)
+	}
+
+	status := catalog.OperationStatus{
+		Action:    cmd.Operation,
+		Release:   rel.Spec.Name,
+		Namespace: appNamespace,
+	}
+
+	return status, Commands{cmd}, nil
+}
+
 func (s *Operations) getUpgradeCommand(repoNamespace, repoName string, body io.Reader) (catalog.OperationStatus, Commands, error) {
 	var (
 		upgradeArgs = &types2.ChartUpgradeAction{}
@@ -388,6 +519,7 @@ func (s *Operations) getUpgradeCommand(repoNamespace, repoName string, body io.R
 	status := catalog.OperationStatus{
 		Action:    "upgrade",
 		Namespace: namespace(upgradeArgs.Namespace),
+		DryRun:    upgradeArgs.DryRun,
 	}
 
 	for _, chartUpgrade := range upgradeArgs.Charts {
@@ -401,6 +533,9 @@ func (s *Operations) getUpgradeCommand(repoNamespace, repoName string, body io.R
 			chartUpgrade,
 			upgradeArgs,
 		}
+		if upgradeArgs.DryRun {
+			cmd.ArgObjects = append(cmd.ArgObjects, dryRunOutput)
+		}
 
 		status.Release = chartUpgrade.ReleaseName
 		commands = append(commands, cmd)
@@ -418,6 +553,7 @@ type Command struct {
 	Chart            []byte
 	ReleaseName      string
 	ReleaseNamespace string
+	Revision         string
 	Kustomize        bool
 }
 
@@ -503,6 +639,7 @@ func (c Command) renderArgs() ([]string, error) {
 	delete(dataMap, "releaseName")
 	delete(dataMap, "chartName")
 	delete(dataMap, "projectId")
+	delete(dataMap, "revision")
 	if v, ok := dataMap["disableOpenAPIValidation"]; ok {
 		delete(dataMap, "disableOpenAPIValidation")
 		dataMap["disableOpenapiValidation"] = v
@@ -540,6 +677,9 @@ func (c Command) renderArgs() ([]string, error) {
 	if c.ReleaseName != "" {
 		args = append(args, c.ReleaseName)
 	}
+	if c.Revision != "" {
+		args = append(args, c.Revision)
+	}
 	if len(c.Chart) > 0 {
 		args = append(args, filepath.Join(runPath, c.ChartFile))
 	}
@@ -689,6 +829,7 @@ func (s *Operations) getInstallCommand(repoNamespace, repoName string, body io.R
 		cmds   []Command
 		status = catalog.OperationStatus{
 			Action: "install",
+			DryRun: installArgs.DryRun,
 		}
 	)
 
@@ -716,6 +857,9 @@ func (s *Operations) getInstallCommand(repoNamespace, repoName string, body io.R
 				"install": "true",
 			})
 		}
+		if installArgs.DryRun {
+			cmd.ArgObjects = append(cmd.ArgObjects, dryRunOutput)
+		}
 
 		status.Release = chartInstall.ReleaseName
 
@@ -736,9 +880,19 @@ func namespace(ns string) string {
 }
 
 func (s *Operations) createOperation(ctx context.Context, user user.Info, status catalog.OperationStatus, cmds Commands, imageOverride string) (*catalog.Operation, error) {
-	if status.Action != "uninstall" {
-		_, err := s.createNamespace(ctx, status.Namespace, status.ProjectID)
-		if err != nil {
+	opNamespace := status.Namespace
+	if status.Action != "uninstall" && status.Action != "rollback" {
+		if status.DryRun {
+			// a dry run does not change the cluster, so the operation is kept next to its pod if the namespace
+			// the chart would be installed in does not exist yet
+			exists, err := s.namespaceExists(ctx, status.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				opNamespace = s.namespace
+			}
+		} else if _, err := s.createNamespace(ctx, status.Namespace, status.ProjectID); err != nil {
 			return nil, err
 		}
 	}
@@ -774,7 +928,7 @@ func (s *Operations) createOperation(ctx context.Context, user user.Info, status
 	op := &catalog.Operation{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            pod.Name,
-			Namespace:       status.Namespace,
+			Namespace:       opNamespace,
 			OwnerReferences: pod.OwnerReferences,
 		},
 	}
@@ -845,6 +999,18 @@ func (s *Operations) createRoleAndRoleBindings(op *catalog.Operation, user strin
 	return nil
 }
 
+func (s *Operations) namespaceExists(ctx context.Context, namespace string) (bool, error) {
+	adminClient, err := s.cg.AdminK8sInterface()
+	if err != nil {
+		return false, err
+	}
+	_, err = adminClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func (s *Operations) createNamespace(ctx context.Context, namespace, projectID string) (*v1.Namespace, error) {
 	apiContext := types.GetAPIContext(ctx)
 	client, err := s.cg.K8sInterface(apiContext)
@@ -5,7 +5,10 @@
 	"strings"
 	"testing"
 
+	types2 "github.com/rancher/rancher/pkg/api/steve/catalog/types"
+	catalog "github.com/rancher/rancher/pkg/apis/catalog.cattle.io/v1"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type testCase struct {
@@ -103,6 +106,27 @@ func Test_Render(t *testing.T) {
 			},
 			failMsg: "uninstall test case failed",
 		},
+		{
+			commands: Commands{
+				Command{
+					Operation: "rollback",
+					ArgObjects: []interface{}{
+						&types2.ChartRollbackAction{
+							Revision:   2,
+							Wait:       true,
+							MaxHistory: 5,
+						},
+					},
+					ReleaseName:      "test6",
+					ReleaseNamespace: "test-ns",
+					Revision:         "2",
+				},
+			},
+			expected: map[string][]byte{
+				"operation000": []byte(strings.Join([]string{"rollback", "--history-max=5", "--namespace=test-ns", "--wait=true", "test6", "2"}, "\x00")),
+			},
+			failMsg: "rollback test case failed",
+		},
 	}
 	for _, testCase := range testCases {
 		actual, err := testCase.commands.Render()
@@ -110,3 +134,36 @@ func Test_Render(t *testing.T) {
 		asserts.Equal(testCase.expected, actual, testCase.failMsg)
 	}
 }
+
+func Test_toRevision(t *testing.T) {
+	deployed := metav1.Now()
+	revision := toRevision(&catalog.ReleaseSpec{
+		Version: 3,
+		Info: &catalog.Info{
+			Status:       catalog.StatusSuperseded,
+			Description:  "Upgrade complete",
+			LastDeployed: &deployed,
+		},
+		Chart: &catalog.Chart{
+			Metadata: &catalog.Metadata{
+				Name:       "test-chart",
+				Version:    "1.1.0",
+				AppVersion: "2.0",
+			},
+		},
+		Values: map[string]interface{}{"a": "a"},
+	})
+
+	assert.Equal(t, types2.ChartRevision{
+		Revision:     3,
+		Status:       "superseded",
+		ChartName:    "test-chart",
+		ChartVersion: "1.1.0",
+		AppVersion:   "2.0",
+		Description:  "Upgrade complete",
+		Updated:      &deployed,
+		Values:       map[string]interface{}{"a": "a"},
+	}, revision)
+
+	assert.Equal(t, types2.ChartRevision{Revision: 1}, toRevision(&catalog.ReleaseSpec{Version: 1}))
+}
@@ -0,0 +1,367 @@
+// Package oci implements the parts of the OCI distribution API needed to serve Helm charts stored in OCI registries.
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	helmhttp "github.com/rancher/rancher/pkg/catalogv2/http"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	Scheme = "oci"
+
+	manifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// maxResponseSize limits the manifests, configs and tag lists read into memory.
+	maxResponseSize = 4 << 20
+)
+
+// IsOCI returns true if the repo URL points to an OCI registry.
+func IsOCI(repoURL string) bool {
+	return strings.HasPrefix(repoURL, Scheme+"://")
+}
+
+// Client talks to the registry of a single OCI repo URL. It authenticates with the username and password of a
+// basic auth or docker config secret, either directly or by exchanging them for a bearer token as the registry asks.
+type Client struct {
+	host     string
+	username string
+	password string
+	client   *http.Client
+
+	lock   sync.Mutex
+	tokens map[string]string
+}
+
+// NewClient returns a client for the registry of repoURL, using the same TLS settings as HTTP repos.
+func NewClient(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool) (*Client, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != Scheme || u.Host == "" {
+		return nil, fmt.Errorf("invalid OCI repo URL %s", repoURL)
+	}
+
+	// credentials are handled here as registries may ask for them on a different host, only client certificates are left to the HTTP client
+	var tlsSecret *corev1.Secret
+	if secret != nil && secret.Type == corev1.SecretTypeTLS {
+		tlsSecret = secret
+	}
+	httpClient, err := helmhttp.HelmClient(tlsSecret, caBundle, insecureSkipTLSVerify, false, "https://"+u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	username, password, err := credentials(secret, u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		host:     u.Host,
+		username: username,
+		password: password,
+		client:   httpClient,
+		tokens:   map[string]string{},
+	}, nil
+}
+
+func credentials(secret *corev1.Secret, host string) (string, string, error) {
+	if secret == nil {
+		return "", "", nil
+	}
+	switch secret.Type {
+	case corev1.SecretTypeBasicAuth:
+		return string(secret.Data[corev1.BasicAuthUsernameKey]), string(secret.Data[corev1.BasicAuthPasswordKey]), nil
+	case corev1.SecretTypeDockerConfigJson:
+		config := struct {
+			Auths map[string]struct {
+				Username string `json:"username"`
+				Password string `json:"password"`
+				Auth     string `json:"auth"`
+			} `json:"auths"`
+		}{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return "", "", fmt.Errorf("failed to parse docker config of secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		for registry, auth := range config.Auths {
+			if strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://") != host {
+				continue
+			}
+			if auth.Auth != "" {
+				decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+				if err != nil {
+					return "", "", fmt.Errorf("failed to decode auth of %s in secret %s/%s: %w", registry, secret.Namespace, secret.Name, err)
+				}
+				username, password, _ := strings.Cut(string(decoded), ":")
+				return username, password, nil
+			}
+			return auth.Username, auth.Password, nil
+		}
+	}
+	return "", "", nil
+}
+
+// Close releases the connections of the client.
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
+// Descriptor describes a blob in a manifest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest.
+type Manifest struct {
+	Config      Descriptor        `json:"config"`
+	Layers      []Descriptor      `json:"layers"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Tags lists all tags of the repository.
+func (c *Client) Tags(repository string) ([]string, error) {
+	var tags []string
+	next := "/v2/" + repository + "/tags/list"
+	for next != "" {
+		resp, err := c.get(next, repository, "")
+		if err != nil {
+			return nil, err
+		}
+		list := struct {
+			Tags []string `json:"tags"`
+		}{}
+		err = decode(resp, &list)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, list.Tags...)
+		next, err = c.nextLink(resp.Header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+// Catalog lists the repositories of the registry under prefix. Registries that don't offer the catalog API return an error.
+func (c *Client) Catalog(prefix string) ([]string, error) {
+	var repositories []string
+	next := "/v2/_catalog"
+	for next != "" {
+		resp, err := c.get(next, "", "")
+		if err != nil {
+			return nil, err
+		}
+		catalog := struct {
+			Repositories []string `json:"repositories"`
+		}{}
+		err = decode(resp, &catalog)
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range catalog.Repositories {
+			if prefix == "" || strings.HasPrefix(repository, prefix+"/") {
+				repositories = append(repositories, repository)
+			}
+		}
+		next, err = c.nextLink(resp.Header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return repositories, nil
+}
+
+// Manifest returns the manifest of reference, a tag or digest, in the repository.
+func (c *Client) Manifest(repository, reference string) (*Manifest, error) {
+	resp, err := c.get("/v2/"+repository+"/manifests/"+reference, repository, manifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	return manifest, decode(resp, manifest)
+}
+
+// Blob returns the content of the blob with digest in the repository. The caller must close it.
+func (c *Client) Blob(repository, digest string) (io.ReadCloser, error) {
+	resp, err := c.get("/v2/"+repository+"/blobs/"+digest, repository, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *Client) get(path, repository, accept string) (*http.Response, error) {
+	scope := "registry:catalog:*"
+	if repository != "" {
+		scope = "repository:" + repository + ":pull"
+	}
+
+	resp, err := c.do(path, scope, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		drain(resp)
+		if err := c.authenticate(challenge, scope); err != nil {
+			return nil, err
+		}
+		resp, err = c.do(path, scope, accept)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		drain(resp)
+		return nil, validation.ErrorCode{
+			Status: resp.StatusCode,
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) do(path, scope, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, "https://"+c.host+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	c.lock.Lock()
+	token, ok := c.tokens[scope]
+	c.lock.Unlock()
+	if ok {
+		req.Header.Set("Authorization", token)
+	}
+	return c.client.Do(req)
+}
+
+// authenticate handles the WWW-Authenticate challenge of a registry, storing the Authorization header to use for scope.
+func (c *Client) authenticate(challenge, scope string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.username == "" && c.password == "" {
+			return validation.Unauthorized
+		}
+		c.setToken(scope, "Basic "+base64.StdEncoding.EncodeToString([]byte(c.username+":"+c.password)))
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported authentication scheme %q of registry %s", scheme, c.host)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme != "https" {
+		return fmt.Errorf("invalid token realm %q of registry %s", params["realm"], c.host)
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if challengeScope := params["scope"]; challengeScope != "" {
+		scope = challengeScope
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		drain(resp)
+		return validation.Unauthorized
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := decode(resp, &token); err != nil {
+		return err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	c.setToken(scope, "Bearer "+token.Token)
+	return nil
+}
+
+func (c *Client) setToken(scope, token string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.tokens[scope] = token
+}
+
+// parseChallenge parses a WWW-Authenticate header such as `Bearer realm="https://auth.example.com/token",service="example.com"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return scheme, params
+}
+
+// nextLink returns the path of the next page from a Link header such as `</v2/_catalog?last=a&n=100>; rel="next"`.
+// Absolute links must point to the registry itself, so that its credentials are never sent to another host.
+func (c *Client) nextLink(link string) (string, error) {
+	if !strings.Contains(link, `rel="next"`) {
+		return "", nil
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start {
+		return "", nil
+	}
+	next, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q of registry %s: %w", link, c.host, err)
+	}
+	if (next.Scheme != "" || next.Host != "") && (next.Scheme != "https" || next.Host != c.host) {
+		return "", fmt.Errorf("next page %s is not on registry %s", next.Redacted(), c.host)
+	}
+	return next.RequestURI(), nil
+}
+
+func decode(resp *http.Response, into interface{}) error {
+	defer drain(resp)
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(into)
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseSize))
+	resp.Body.Close()
+}
@@ -0,0 +1,192 @@
+package oci
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rancher/wrangler/pkg/schemas/validation"
+	"github.com/sirupsen/logrus"
+	helmchart "helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The media types Helm uses to push charts.
+const (
+	ConfigMediaType      = "application/vnd.cncf.helm.config.v1+json"
+	ChartLayerMediaType  = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	legacyChartMediaType = "application/tar+gzip"
+
+	createdAnnotation = "org.opencontainers.image.created"
+	// maxChartSize limits the size of a chart archive read into memory.
+	maxChartSize = 20 << 20
+)
+
+// DownloadIndex builds an index of the charts in the OCI repo. The URL either points to a single chart,
+// like oci://registry.example.com/charts/app, or to a path in a registry that offers the catalog API.
+// Every semver tag of a chart becomes a chart version.
+func DownloadIndex(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool) (*repo.IndexFile, error) {
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	path := repositoryPath(repoURL)
+	logrus.Infof("Building repo index from OCI repo %s", repoURL)
+
+	repositories := []string{path}
+	tags, err := client.Tags(path)
+	if err != nil {
+		// the URL doesn't point to a chart, look for charts below it
+		repositories, err = client.Catalog(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list charts of OCI repo %s: %w", repoURL, err)
+		}
+		tags = nil
+	}
+
+	index := repo.NewIndexFile()
+	for _, repository := range repositories {
+		if tags == nil {
+			tags, err = client.Tags(repository)
+			if err != nil {
+				logrus.Warnf("Failed to list tags of %s in OCI repo %s: %v", repository, repoURL, err)
+				continue
+			}
+		}
+		for _, tag := range tags {
+			version, err := chartVersion(client, repository, tag)
+			if err != nil {
+				logrus.Warnf("Skipping %s:%s in OCI repo %s: %v", repository, tag, repoURL, err)
+				continue
+			}
+			if version != nil {
+				index.Entries[version.Name] = append(index.Entries[version.Name], version)
+			}
+		}
+		tags = nil
+	}
+	return index, nil
+}
+
+// chartVersion reads the chart metadata of a tag. Tags that aren't chart versions return nil.
+func chartVersion(client *Client, repository, tag string) (*repo.ChartVersion, error) {
+	// Helm replaces the + of semver build metadata, which isn't allowed in tags, with _
+	if _, err := semver.StrictNewVersion(strings.ReplaceAll(tag, "_", "+")); err != nil {
+		return nil, nil
+	}
+
+	manifest, err := client.Manifest(repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Config.MediaType != ConfigMediaType {
+		return nil, nil
+	}
+	layer, ok := chartLayer(manifest)
+	if !ok {
+		return nil, fmt.Errorf("manifest has no chart layer")
+	}
+
+	config, err := client.Blob(repository, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer config.Close()
+	metadata := &helmchart.Metadata{}
+	if err := json.NewDecoder(io.LimitReader(config, maxResponseSize)).Decode(metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse chart metadata: %w", err)
+	}
+
+	version := &repo.ChartVersion{
+		Metadata: metadata,
+		URLs:     []string{fmt.Sprintf("%s://%s/%s:%s", Scheme, client.host, repository, tag)},
+		Digest:   strings.TrimPrefix(layer.Digest, "sha256:"),
+	}
+	if created, err := time.Parse(time.RFC3339, manifest.Annotations[createdAnnotation]); err == nil {
+		version.Created = created
+	}
+	return version, nil
+}
+
+func chartLayer(manifest *Manifest) (Descriptor, bool) {
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == ChartLayerMediaType || layer.MediaType == legacyChartMediaType {
+			return layer, true
+		}
+	}
+	return Descriptor{}, false
+}
+
+// Chart pulls the chart archive of a chart version in the index of an OCI repo.
+func Chart(secret *corev1.Secret, repoURL string, caBundle []byte, insecureSkipTLSVerify bool, chart *repo.ChartVersion) (io.ReadCloser, error) {
+	if len(chart.URLs) == 0 {
+		return nil, fmt.Errorf("failed to find chartName %s version %s: %w", chart.Name, chart.Version, validation.NotFound)
+	}
+
+	client, err := NewClient(secret, repoURL, caBundle, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	chartURL, err := url.Parse(chart.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+	// never follow a chart URL to a registry the credentials of the repo weren't meant for
+	if chartURL.Scheme != Scheme || chartURL.Host != client.host {
+		return nil, fmt.Errorf("chart URL %s is not in OCI repo %s", chart.URLs[0], repoURL)
+	}
+	repository, tag, ok := strings.Cut(strings.TrimPrefix(chartURL.Path, "/"), ":")
+	if !ok {
+		return nil, fmt.Errorf("chart URL %s has no tag", chart.URLs[0])
+	}
+
+	manifest, err := client.Manifest(repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	layer, ok := chartLayer(manifest)
+	if !ok {
+		return nil, fmt.Errorf("%s has no chart layer", chart.URLs[0])
+	}
+
+	blob, err := client.Blob(repository, layer.Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(blob, maxChartSize))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if "sha256:"+hex.EncodeToString(sum[:]) != layer.Digest {
+		return nil, fmt.Errorf("digest of %s does not match %s", chart.URLs[0], layer.Digest)
+	}
+	// the tag may have been moved to another chart since the index was built
+	if chart.Digest != "" && hex.EncodeToString(sum[:]) != strings.TrimPrefix(chart.Digest, "sha256:") {
+		return nil, fmt.Errorf("digest of %s does not match the digest %s in the index of repo %s", chart.URLs[0], chart.Digest, repoURL)
+	}
+	return ioutil.NopCloser(bytes.NewBuffer(data)), nil
+}
+
+func repositoryPath(repoURL string) string {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return ""
+	}
+	return strings.Trim(u.Path, "/")
+}
@@ -0,0 +1,238 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/repo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type fakeRegistry struct {
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	tags      map[string][]string
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func (f *fakeRegistry) push(t *testing.T, repository, name, version string) {
+	config, err := json.Marshal(map[string]string{"apiVersion": "v2", "name": name, "version": version})
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	chartYAML := []byte(fmt.Sprintf("apiVersion: v2\nname: %s\nversion: %s\n", name, version))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name + "/Chart.yaml", Mode: 0644, Size: int64(len(chartYAML))}))
+	_, err = tw.Write(chartYAML)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	f.blobs[digest(config)] = config
+	f.blobs[digest(archive.Bytes())] = archive.Bytes()
+	manifest, err := json.Marshal(Manifest{
+		Config: Descriptor{MediaType: ConfigMediaType, Digest: digest(config), Size: int64(len(config))},
+		Layers: []Descriptor{{MediaType: ChartLayerMediaType, Digest: digest(archive.Bytes()), Size: int64(archive.Len())}},
+	})
+	require.NoError(t, err)
+
+	tag := strings.ReplaceAll(version, "+", "_")
+	f.manifests[repository+":"+tag] = manifest
+	f.tags[repository] = append(f.tags[repository], tag)
+}
+
+// ServeHTTP serves the registry and its token endpoint, which hands out a token for the user "user" with password "pass".
+func (f *fakeRegistry) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if user, pass, _ := req.BasicAuth(); user != "user" || pass != "pass" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(rw).Encode(map[string]string{"token": "secret-token"})
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer secret-token" {
+		rw.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="registry"`, req.Host))
+		rw.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	switch {
+	case path == "_catalog":
+		var repositories []string
+		for repository := range f.tags {
+			repositories = append(repositories, repository)
+		}
+		json.NewEncoder(rw).Encode(map[string][]string{"repositories": repositories})
+	case strings.HasSuffix(path, "/tags/list"):
+		tags, ok := f.tags[strings.TrimSuffix(path, "/tags/list")]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(rw).Encode(map[string][]string{"tags": tags})
+	case strings.Contains(path, "/manifests/"):
+		repository, reference, _ := strings.Cut(path, "/manifests/")
+		manifest, ok := f.manifests[repository+":"+reference]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write(manifest)
+	case strings.Contains(path, "/blobs/"):
+		_, d, _ := strings.Cut(path, "/blobs/")
+		blob, ok := f.blobs[d]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write(blob)
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeRegistry(t *testing.T) (*fakeRegistry, string) {
+	registry := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}, tags: map[string][]string{}}
+	registry.push(t, "charts/app", "app", "1.0.0")
+	registry.push(t, "charts/app", "app", "1.1.0+build.1")
+	registry.push(t, "charts/db", "db", "0.1.0")
+	registry.tags["charts/app"] = append(registry.tags["charts/app"], "latest")
+
+	server := httptest.NewTLSServer(registry)
+	t.Cleanup(server.Close)
+	return registry, strings.TrimPrefix(server.URL, "https://")
+}
+
+var basicAuthSecret = &corev1.Secret{
+	Type: corev1.SecretTypeBasicAuth,
+	Data: map[string][]byte{
+		corev1.BasicAuthUsernameKey: []byte("user"),
+		corev1.BasicAuthPasswordKey: []byte("pass"),
+	},
+}
+
+func versions(index *repo.IndexFile, name string) []string {
+	var result []string
+	for _, version := range index.Entries[name] {
+		result = append(result, version.Version)
+	}
+	return result
+}
+
+func TestDownloadIndex(t *testing.T) {
+	_, host := newFakeRegistry(t)
+
+	index, err := DownloadIndex(basicAuthSecret, "oci://"+host+"/charts/app", nil, true)
+	require.NoError(t, err)
+	assert.Len(t, index.Entries, 1)
+	assert.ElementsMatch(t, []string{"1.0.0", "1.1.0+build.1"}, versions(index, "app"))
+
+	index, err = DownloadIndex(basicAuthSecret, "oci://"+host+"/charts", nil, true)
+	require.NoError(t, err)
+	assert.Len(t, index.Entries, 2)
+	assert.Equal(t, []string{"0.1.0"}, versions(index, "db"))
+	assert.Equal(t, "oci://"+host+"/charts/db:0.1.0", index.Entries["db"][0].URLs[0])
+
+	_, err = DownloadIndex(nil, "oci://"+host+"/charts/app", nil, true)
+	assert.Error(t, err, "the registry requires credentials")
+
+	_, err = DownloadIndex(basicAuthSecret, "oci://"+host+"/charts/app", nil, false)
+	assert.Error(t, err, "the certificate of the registry isn't trusted")
+}
+
+func TestChart(t *testing.T) {
+	_, host := newFakeRegistry(t)
+	repoURL := "oci://" + host + "/charts/app"
+
+	index, err := DownloadIndex(basicAuthSecret, repoURL, nil, true)
+	require.NoError(t, err)
+	version, err := index.Get("app", "1.1.0+build.1")
+	require.NoError(t, err)
+
+	chart, err := Chart(basicAuthSecret, repoURL, nil, true, version)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(chart)
+	require.NoError(t, err)
+	assert.Equal(t, version.Digest, strings.TrimPrefix(digest(data), "sha256:"))
+
+	digest := version.Digest
+	version.Digest = strings.Repeat("0", 64)
+	_, err = Chart(basicAuthSecret, repoURL, nil, true, version)
+	assert.Error(t, err, "the chart must match the digest in the index")
+	version.Digest = digest
+
+	version.URLs = []string{"oci://other.example.com/charts/app:1.1.0_build.1"}
+	_, err = Chart(basicAuthSecret, repoURL, nil, true, version)
+	assert.Error(t, err, "charts must be pulled from the registry of the repo")
+}
+
+func TestCredentials(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	secret := &corev1.Secret{
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"other.example.com":{"username":"x","password":"y"},"https://registry.example.com":{"auth":"` + auth + `"}}}`),
+		},
+	}
+	username, password, err := credentials(secret, "registry.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+
+	username, password, err = credentials(secret, "unknown.example.com")
+	require.NoError(t, err)
+	assert.Empty(t, username)
+	assert.Empty(t, password)
+}
+
+func TestNextLink(t *testing.T) {
+	c := &Client{host: "registry.example.com"}
+
+	next, err := c.nextLink(`</v2/_catalog?last=a&n=100>; rel="next"`)
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/_catalog?last=a&n=100", next)
+
+	next, err = c.nextLink(`<https://registry.example.com/v2/charts/app/tags/list?last=b>; rel="next"`)
+	require.NoError(t, err)
+	assert.Equal(t, "/v2/charts/app/tags/list?last=b", next)
+
+	next, err = c.nextLink("")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+
+	_, err = c.nextLink(`<https://evil.example.com/v2/_catalog?last=a>; rel="next"`)
+	assert.Error(t, err, "the credentials of the registry must not be sent to another host")
+	_, err = c.nextLink(`<http://registry.example.com/v2/_catalog?last=a>; rel="next"`)
+	assert.Error(t, err, "the credentials of the registry must not be sent in plain text")
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:charts/app:pull"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:charts/app:pull",
+	}, params)
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	AzureBlobBackupConfigType                     = "azureBlobBackupConfig"
+	AzureBlobBackupConfigFieldAccountName         = "accountName"
+	AzureBlobBackupConfigFieldCloudCredentialName = "cloudCredentialName"
+	AzureBlobBackupConfigFieldContainerName       = "containerName"
+	AzureBlobBackupConfigFieldEndpoint            = "endpoint"
+	AzureBlobBackupConfigFieldFolder              = "folder"
+)
+
+type AzureBlobBackupConfig struct {
+	AccountName         string `json:"accountName,omitempty" yaml:"accountName,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" yaml:"cloudCredentialName,omitempty"`
+	ContainerName       string `json:"containerName,omitempty" yaml:"containerName,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Folder              string `json:"folder,omitempty" yaml:"folder,omitempty"`
+}
@@ -19,6 +19,7 @@ type Client struct {
 	PodSecurityPolicyTemplateProjectBinding PodSecurityPolicyTemplateProjectBindingOperations
 	ClusterRoleTemplateBinding              ClusterRoleTemplateBindingOperations
 	ProjectRoleTemplateBinding              ProjectRoleTemplateBindingOperations
+	ElevationRequest                        ElevationRequestOperations
 	Cluster                                 ClusterOperations
 	ClusterRegistrationToken                ClusterRegistrationTokenOperations
 	Catalog                                 CatalogOperations
@@ -66,6 +67,8 @@ type Client struct {
 	ManagementSecret                        ManagementSecretOperations
 	ClusterTemplate                         ClusterTemplateOperations
 	ClusterTemplateRevision                 ClusterTemplateRevisionOperations
+	ProjectTemplate                         ProjectTemplateOperations
+	ProjectTemplateRevision                 ProjectTemplateRevisionOperations
 	RkeK8sSystemImage                       RkeK8sSystemImageOperations
 	RkeK8sServiceOption                     RkeK8sServiceOptionOperations
 	RkeAddon                                RkeAddonOperations
@@ -97,6 +100,7 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.PodSecurityPolicyTemplateProjectBinding = newPodSecurityPolicyTemplateProjectBindingClient(client)
 	client.ClusterRoleTemplateBinding = newClusterRoleTemplateBindingClient(client)
 	client.ProjectRoleTemplateBinding = newProjectRoleTemplateBindingClient(client)
+	client.ElevationRequest = newElevationRequestClient(client)
 	client.Cluster = newClusterClient(client)
 	client.ClusterRegistrationToken = newClusterRegistrationTokenClient(client)
 	client.Catalog = newCatalogClient(client)
@@ -144,6 +148,8 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.ManagementSecret = newManagementSecretClient(client)
 	client.ClusterTemplate = newClusterTemplateClient(client)
 	client.ClusterTemplateRevision = newClusterTemplateRevisionClient(client)
+	client.ProjectTemplate = newProjectTemplateClient(client)
+	client.ProjectTemplateRevision = newProjectTemplateRevisionClient(client)
 	client.RkeK8sSystemImage = newRkeK8sSystemImageClient(client)
 	client.RkeK8sServiceOption = newRkeK8sServiceOptionClient(client)
 	client.RkeAddon = newRkeAddonClient(client)
@@ -5,41 +5,51 @@
 )
 
 const (
-	ClusterRoleTemplateBindingType                  = "clusterRoleTemplateBinding"
-	ClusterRoleTemplateBindingFieldAnnotations      = "annotations"
-	ClusterRoleTemplateBindingFieldClusterID        = "clusterId"
-	ClusterRoleTemplateBindingFieldCreated          = "created"
-	ClusterRoleTemplateBindingFieldCreatorID        = "creatorId"
-	ClusterRoleTemplateBindingFieldGroupID          = "groupId"
-	ClusterRoleTemplateBindingFieldGroupPrincipalID = "groupPrincipalId"
-	ClusterRoleTemplateBindingFieldLabels           = "labels"
-	ClusterRoleTemplateBindingFieldName             = "name"
-	ClusterRoleTemplateBindingFieldNamespaceId      = "namespaceId"
-	ClusterRoleTemplateBindingFieldOwnerReferences  = "ownerReferences"
-	ClusterRoleTemplateBindingFieldRemoved          = "removed"
-	ClusterRoleTemplateBindingFieldRoleTemplateID   = "roleTemplateId"
-	ClusterRoleTemplateBindingFieldUUID             = "uuid"
-	ClusterRoleTemplateBindingFieldUserID           = "userId"
-	ClusterRoleTemplateBindingFieldUserPrincipalID  = "userPrincipalId"
+	ClusterRoleTemplateBindingType                      = "clusterRoleTemplateBinding"
+	ClusterRoleTemplateBindingFieldAnnotations          = "annotations"
+	ClusterRoleTemplateBindingFieldClusterID            = "clusterId"
+	ClusterRoleTemplateBindingFieldCreated              = "created"
+	ClusterRoleTemplateBindingFieldCreatorID            = "creatorId"
+	ClusterRoleTemplateBindingFieldExpiresAt            = "expiresAt"
+	ClusterRoleTemplateBindingFieldGroupID              = "groupId"
+	ClusterRoleTemplateBindingFieldGroupPrincipalID     = "groupPrincipalId"
+	ClusterRoleTemplateBindingFieldLabels               = "labels"
+	ClusterRoleTemplateBindingFieldName                 = "name"
+	ClusterRoleTemplateBindingFieldNamespaceId          = "namespaceId"
+	ClusterRoleTemplateBindingFieldNotBefore            = "notBefore"
+	ClusterRoleTemplateBindingFieldOwnerReferences      = "ownerReferences"
+	ClusterRoleTemplateBindingFieldRemoved              = "removed"
+	ClusterRoleTemplateBindingFieldRoleTemplateID       = "roleTemplateId"
+	ClusterRoleTemplateBindingFieldState                = "state"
+	ClusterRoleTemplateBindingFieldTransitioning        = "transitioning"
+	ClusterRoleTemplateBindingFieldTransitioningMessage = "transitioningMessage"
+	ClusterRoleTemplateBindingFieldUUID                 = "uuid"
+	ClusterRoleTemplateBindingFieldUserID               = "userId"
+	ClusterRoleTemplateBindingFieldUserPrincipalID      = "userPrincipalId"
 )
 
 type ClusterRoleTemplateBinding struct {
 	types.Resource
-	Annotations      map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterID        string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Created          string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID        string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	GroupID          string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
-	GroupPrincipalID string            `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
-	Labels           map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId      string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences  []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	Removed          string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	RoleTemplateID   string            `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
-	UUID             string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
-	UserID           string            `json:"userId,omitempty" yaml:"userId,omitempty"`
-	UserPrincipalID  string            `json:"userPrincipalId,omitempty" yaml:"userPrincipalId,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	ExpiresAt            string            `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	GroupID              string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	GroupPrincipalID     string            `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	NotBefore            string            `json:"notBefore,omitempty" yaml:"notBefore,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RoleTemplateID       string            `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UserID               string            `json:"userId,omitempty" yaml:"userId,omitempty"`
+	UserPrincipalID      string            `json:"userPrincipalId,omitempty" yaml:"userPrincipalId,omitempty"`
 }
 
 type ClusterRoleTemplateBindingCollection struct {
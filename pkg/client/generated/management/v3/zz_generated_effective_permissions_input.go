@@ -0,0 +1,16 @@
+package client
+
+const (
+	EffectivePermissionsInputType                  = "effectivePermissionsInput"
+	EffectivePermissionsInputFieldClusterID        = "clusterId"
+	EffectivePermissionsInputFieldGroupPrincipalID = "groupPrincipalId"
+	EffectivePermissionsInputFieldProjectID        = "projectId"
+	EffectivePermissionsInputFieldUserID           = "userId"
+)
+
+type EffectivePermissionsInput struct {
+	ClusterID        string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	ProjectID        string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	UserID           string `json:"userId,omitempty" yaml:"userId,omitempty"`
+}
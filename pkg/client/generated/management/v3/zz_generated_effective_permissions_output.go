@@ -0,0 +1,10 @@
+package client
+
+const (
+	EffectivePermissionsOutputType             = "effectivePermissionsOutput"
+	EffectivePermissionsOutputFieldPermissions = "permissions"
+)
+
+type EffectivePermissionsOutput struct {
+	Permissions []GrantedPermission `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
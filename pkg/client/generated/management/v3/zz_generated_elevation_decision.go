@@ -0,0 +1,16 @@
+package client
+
+const (
+	ElevationDecisionType          = "elevationDecision"
+	ElevationDecisionFieldApproved = "approved"
+	ElevationDecisionFieldReason   = "reason"
+	ElevationDecisionFieldTime     = "time"
+	ElevationDecisionFieldUserID   = "userId"
+)
+
+type ElevationDecision struct {
+	Approved bool   `json:"approved,omitempty" yaml:"approved,omitempty"`
+	Reason   string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Time     string `json:"time,omitempty" yaml:"time,omitempty"`
+	UserID   string `json:"userId,omitempty" yaml:"userId,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ElevationDecisionInputType        = "elevationDecisionInput"
+	ElevationDecisionInputFieldReason = "reason"
+)
+
+type ElevationDecisionInput struct {
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
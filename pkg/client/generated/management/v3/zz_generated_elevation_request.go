@@ -0,0 +1,154 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ElevationRequestType                      = "elevationRequest"
+	ElevationRequestFieldAnnotations          = "annotations"
+	ElevationRequestFieldClusterID            = "clusterId"
+	ElevationRequestFieldCreated              = "created"
+	ElevationRequestFieldCreatorID            = "creatorId"
+	ElevationRequestFieldDurationMinutes      = "durationMinutes"
+	ElevationRequestFieldJustification        = "justification"
+	ElevationRequestFieldLabels               = "labels"
+	ElevationRequestFieldName                 = "name"
+	ElevationRequestFieldOwnerReferences      = "ownerReferences"
+	ElevationRequestFieldProjectID            = "projectId"
+	ElevationRequestFieldRemoved              = "removed"
+	ElevationRequestFieldRoleTemplateID       = "roleTemplateId"
+	ElevationRequestFieldState                = "state"
+	ElevationRequestFieldStatus               = "status"
+	ElevationRequestFieldTransitioning        = "transitioning"
+	ElevationRequestFieldTransitioningMessage = "transitioningMessage"
+	ElevationRequestFieldUUID                 = "uuid"
+	ElevationRequestFieldUserID               = "userId"
+)
+
+type ElevationRequest struct {
+	types.Resource
+	Annotations          map[string]string       `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterID            string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Created              string                  `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string                  `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DurationMinutes      int64                   `json:"durationMinutes,omitempty" yaml:"durationMinutes,omitempty"`
+	Justification        string                  `json:"justification,omitempty" yaml:"justification,omitempty"`
+	Labels               map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string                  `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences      []OwnerReference        `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ProjectID            string                  `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Removed              string                  `json:"removed,omitempty" yaml:"removed,omitempty"`
+	RoleTemplateID       string                  `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
+	State                string                  `json:"state,omitempty" yaml:"state,omitempty"`
+	Status               *ElevationRequestStatus `json:"status,omitempty" yaml:"status,omitempty"`
+	Transitioning        string                  `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string                  `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string                  `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UserID               string                  `json:"userId,omitempty" yaml:"userId,omitempty"`
+}
+
+type ElevationRequestCollection struct {
+	types.Collection
+	Data   []ElevationRequest `json:"data,omitempty"`
+	client *ElevationRequestClient
+}
+
+type ElevationRequestClient struct {
+	apiClient *Client
+}
+
+type ElevationRequestOperations interface {
+	List(opts *types.ListOpts) (*ElevationRequestCollection, error)
+	ListAll(opts *types.ListOpts) (*ElevationRequestCollection, error)
+	Create(opts *ElevationRequest) (*ElevationRequest, error)
+	Update(existing *ElevationRequest, updates interface{}) (*ElevationRequest, error)
+	Replace(existing *ElevationRequest) (*ElevationRequest, error)
+	ByID(id string) (*ElevationRequest, error)
+	Delete(container *ElevationRequest) error
+
+	ActionApprove(resource *ElevationRequest, input *ElevationDecisionInput) (*ElevationRequest, error)
+
+	ActionDeny(resource *ElevationRequest, input *ElevationDecisionInput) (*ElevationRequest, error)
+}
+
+func newElevationRequestClient(apiClient *Client) *ElevationRequestClient {
+	return &ElevationRequestClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ElevationRequestClient) Create(container *ElevationRequest) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoCreate(ElevationRequestType, container, resp)
+	return resp, err
+}
+
+func (c *ElevationRequestClient) Update(existing *ElevationRequest, updates interface{}) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoUpdate(ElevationRequestType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ElevationRequestClient) Replace(obj *ElevationRequest) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoReplace(ElevationRequestType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ElevationRequestClient) List(opts *types.ListOpts) (*ElevationRequestCollection, error) {
+	resp := &ElevationRequestCollection{}
+	err := c.apiClient.Ops.DoList(ElevationRequestType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ElevationRequestClient) ListAll(opts *types.ListOpts) (*ElevationRequestCollection, error) {
+	resp := &ElevationRequestCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ElevationRequestCollection) Next() (*ElevationRequestCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ElevationRequestCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ElevationRequestClient) ByID(id string) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoByID(ElevationRequestType, id, resp)
+	return resp, err
+}
+
+func (c *ElevationRequestClient) Delete(container *ElevationRequest) error {
+	return c.apiClient.Ops.DoResourceDelete(ElevationRequestType, &container.Resource)
+}
+
+func (c *ElevationRequestClient) ActionApprove(resource *ElevationRequest, input *ElevationDecisionInput) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoAction(ElevationRequestType, "approve", &resource.Resource, input, resp)
+	return resp, err
+}
+
+func (c *ElevationRequestClient) ActionDeny(resource *ElevationRequest, input *ElevationDecisionInput) (*ElevationRequest, error) {
+	resp := &ElevationRequest{}
+	err := c.apiClient.Ops.DoAction(ElevationRequestType, "deny", &resource.Resource, input, resp)
+	return resp, err
+}
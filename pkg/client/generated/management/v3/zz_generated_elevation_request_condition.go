@@ -0,0 +1,20 @@
+package client
+
+const (
+	ElevationRequestConditionType                    = "elevationRequestCondition"
+	ElevationRequestConditionFieldLastTransitionTime = "lastTransitionTime"
+	ElevationRequestConditionFieldLastUpdateTime     = "lastUpdateTime"
+	ElevationRequestConditionFieldMessage            = "message"
+	ElevationRequestConditionFieldReason             = "reason"
+	ElevationRequestConditionFieldStatus             = "status"
+	ElevationRequestConditionFieldType               = "type"
+)
+
+type ElevationRequestCondition struct {
+	LastTransitionTime string `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	LastUpdateTime     string `json:"lastUpdateTime,omitempty" yaml:"lastUpdateTime,omitempty"`
+	Message            string `json:"message,omitempty" yaml:"message,omitempty"`
+	Reason             string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Status             string `json:"status,omitempty" yaml:"status,omitempty"`
+	Type               string `json:"type,omitempty" yaml:"type,omitempty"`
+}
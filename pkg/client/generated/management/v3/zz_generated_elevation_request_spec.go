@@ -0,0 +1,20 @@
+package client
+
+const (
+	ElevationRequestSpecType                 = "elevationRequestSpec"
+	ElevationRequestSpecFieldClusterID       = "clusterId"
+	ElevationRequestSpecFieldDurationMinutes = "durationMinutes"
+	ElevationRequestSpecFieldJustification   = "justification"
+	ElevationRequestSpecFieldProjectID       = "projectId"
+	ElevationRequestSpecFieldRoleTemplateID  = "roleTemplateId"
+	ElevationRequestSpecFieldUserID          = "userId"
+)
+
+type ElevationRequestSpec struct {
+	ClusterID       string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	DurationMinutes int64  `json:"durationMinutes,omitempty" yaml:"durationMinutes,omitempty"`
+	Justification   string `json:"justification,omitempty" yaml:"justification,omitempty"`
+	ProjectID       string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RoleTemplateID  string `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
+	UserID          string `json:"userId,omitempty" yaml:"userId,omitempty"`
+}
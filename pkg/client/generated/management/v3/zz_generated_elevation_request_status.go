@@ -0,0 +1,18 @@
+package client
+
+const (
+	ElevationRequestStatusType             = "elevationRequestStatus"
+	ElevationRequestStatusFieldBindingName = "bindingName"
+	ElevationRequestStatusFieldConditions  = "conditions"
+	ElevationRequestStatusFieldDecisions   = "decisions"
+	ElevationRequestStatusFieldExpiresAt   = "expiresAt"
+	ElevationRequestStatusFieldGrantedAt   = "grantedAt"
+)
+
+type ElevationRequestStatus struct {
+	BindingName string                      `json:"bindingName,omitempty" yaml:"bindingName,omitempty"`
+	Conditions  []ElevationRequestCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Decisions   []ElevationDecision         `json:"decisions,omitempty" yaml:"decisions,omitempty"`
+	ExpiresAt   string                      `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	GrantedAt   string                      `json:"grantedAt,omitempty" yaml:"grantedAt,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	EtcdBackupEncryptionType               = "etcdBackupEncryption"
+	EtcdBackupEncryptionFieldKeySecretName = "keySecretName"
+)
+
+type EtcdBackupEncryption struct {
+	KeySecretName string `json:"keySecretName,omitempty" yaml:"keySecretName,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	EtcdBackupRetentionPolicyType         = "etcdBackupRetentionPolicy"
+	EtcdBackupRetentionPolicyFieldDaily   = "daily"
+	EtcdBackupRetentionPolicyFieldHourly  = "hourly"
+	EtcdBackupRetentionPolicyFieldMonthly = "monthly"
+	EtcdBackupRetentionPolicyFieldWeekly  = "weekly"
+)
+
+type EtcdBackupRetentionPolicy struct {
+	Daily   int64 `json:"daily,omitempty" yaml:"daily,omitempty"`
+	Hourly  int64 `json:"hourly,omitempty" yaml:"hourly,omitempty"`
+	Monthly int64 `json:"monthly,omitempty" yaml:"monthly,omitempty"`
+	Weekly  int64 `json:"weekly,omitempty" yaml:"weekly,omitempty"`
+}
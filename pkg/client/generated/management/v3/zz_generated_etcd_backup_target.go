@@ -0,0 +1,16 @@
+package client
+
+const (
+	EtcdBackupTargetType            = "etcdBackupTarget"
+	EtcdBackupTargetFieldAzureBlob  = "azureBlob"
+	EtcdBackupTargetFieldEncryption = "encryption"
+	EtcdBackupTargetFieldGCS        = "gcs"
+	EtcdBackupTargetFieldS3         = "s3"
+)
+
+type EtcdBackupTarget struct {
+	AzureBlob  *AzureBlobBackupConfig `json:"azureBlob,omitempty" yaml:"azureBlob,omitempty"`
+	Encryption *EtcdBackupEncryption  `json:"encryption,omitempty" yaml:"encryption,omitempty"`
+	GCS        *GCSBackupConfig       `json:"gcs,omitempty" yaml:"gcs,omitempty"`
+	S3         *S3BackupTargetConfig  `json:"s3,omitempty" yaml:"s3,omitempty"`
+}
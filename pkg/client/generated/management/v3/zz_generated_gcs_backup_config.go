@@ -0,0 +1,16 @@
+package client
+
+const (
+	GCSBackupConfigType                     = "gcsBackupConfig"
+	GCSBackupConfigFieldBucketName          = "bucketName"
+	GCSBackupConfigFieldCloudCredentialName = "cloudCredentialName"
+	GCSBackupConfigFieldEndpoint            = "endpoint"
+	GCSBackupConfigFieldFolder              = "folder"
+)
+
+type GCSBackupConfig struct {
+	BucketName          string `json:"bucketName,omitempty" yaml:"bucketName,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" yaml:"cloudCredentialName,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Folder              string `json:"folder,omitempty" yaml:"folder,omitempty"`
+}
@@ -5,33 +5,43 @@
 )
 
 const (
-	GlobalRoleBindingType                  = "globalRoleBinding"
-	GlobalRoleBindingFieldAnnotations      = "annotations"
-	GlobalRoleBindingFieldCreated          = "created"
-	GlobalRoleBindingFieldCreatorID        = "creatorId"
-	GlobalRoleBindingFieldGlobalRoleID     = "globalRoleId"
-	GlobalRoleBindingFieldGroupPrincipalID = "groupPrincipalId"
-	GlobalRoleBindingFieldLabels           = "labels"
-	GlobalRoleBindingFieldName             = "name"
-	GlobalRoleBindingFieldOwnerReferences  = "ownerReferences"
-	GlobalRoleBindingFieldRemoved          = "removed"
-	GlobalRoleBindingFieldUUID             = "uuid"
-	GlobalRoleBindingFieldUserID           = "userId"
+	GlobalRoleBindingType                      = "globalRoleBinding"
+	GlobalRoleBindingFieldAnnotations          = "annotations"
+	GlobalRoleBindingFieldCreated              = "created"
+	GlobalRoleBindingFieldCreatorID            = "creatorId"
+	GlobalRoleBindingFieldExpiresAt            = "expiresAt"
+	GlobalRoleBindingFieldGlobalRoleID         = "globalRoleId"
+	GlobalRoleBindingFieldGroupPrincipalID     = "groupPrincipalId"
+	GlobalRoleBindingFieldLabels               = "labels"
+	GlobalRoleBindingFieldName                 = "name"
+	GlobalRoleBindingFieldNotBefore            = "notBefore"
+	GlobalRoleBindingFieldOwnerReferences      = "ownerReferences"
+	GlobalRoleBindingFieldRemoved              = "removed"
+	GlobalRoleBindingFieldState                = "state"
+	GlobalRoleBindingFieldTransitioning        = "transitioning"
+	GlobalRoleBindingFieldTransitioningMessage = "transitioningMessage"
+	GlobalRoleBindingFieldUUID                 = "uuid"
+	GlobalRoleBindingFieldUserID               = "userId"
 )
 
 type GlobalRoleBinding struct {
 	types.Resource
-	Annotations      map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	Created          string            `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID        string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	GlobalRoleID     string            `json:"globalRoleId,omitempty" yaml:"globalRoleId,omitempty"`
-	GroupPrincipalID string            `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
-	Labels           map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
-	OwnerReferences  []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	Removed          string            `json:"removed,omitempty" yaml:"removed,omitempty"`
-	UUID             string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
-	UserID           string            `json:"userId,omitempty" yaml:"userId,omitempty"`
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	ExpiresAt            string            `json:"expiresAt,omitempty" yaml:"expiresAt,omitempty"`
+	GlobalRoleID         string            `json:"globalRoleId,omitempty" yaml:"globalRoleId,omitempty"`
+	GroupPrincipalID     string            `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NotBefore            string            `json:"notBefore,omitempty" yaml:"notBefore,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	UserID               string            `json:"userId,omitempty" yaml:"userId,omitempty"`
 }
 
 type GlobalRoleBindingCollection struct {
@@ -0,0 +1,34 @@
+package client
+
+const (
+	GrantedPermissionType                  = "grantedPermission"
+	GrantedPermissionFieldBindingName      = "bindingName"
+	GrantedPermissionFieldBindingType      = "bindingType"
+	GrantedPermissionFieldClusterID        = "clusterId"
+	GrantedPermissionFieldExternal         = "external"
+	GrantedPermissionFieldGroupID          = "groupId"
+	GrantedPermissionFieldGroupPrincipalID = "groupPrincipalId"
+	GrantedPermissionFieldProjectID        = "projectId"
+	GrantedPermissionFieldRoleName         = "roleName"
+	GrantedPermissionFieldRule             = "rule"
+	GrantedPermissionFieldScope            = "scope"
+	GrantedPermissionFieldSource           = "source"
+	GrantedPermissionFieldUserID           = "userId"
+	GrantedPermissionFieldUserPrincipalID  = "userPrincipalId"
+)
+
+type GrantedPermission struct {
+	BindingName      string      `json:"bindingName,omitempty" yaml:"bindingName,omitempty"`
+	BindingType      string      `json:"bindingType,omitempty" yaml:"bindingType,omitempty"`
+	ClusterID        string      `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	External         bool        `json:"external,omitempty" yaml:"external,omitempty"`
+	GroupID          string      `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	GroupPrincipalID string      `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	ProjectID        string      `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RoleName         string      `json:"roleName,omitempty" yaml:"roleName,omitempty"`
+	Rule             *PolicyRule `json:"rule,omitempty" yaml:"rule,omitempty"`
+	Scope            string      `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Source           string      `json:"source,omitempty" yaml:"source,omitempty"`
+	UserID           string      `json:"userId,omitempty" yaml:"userId,omitempty"`
+	UserPrincipalID  string      `json:"userPrincipalId,omitempty" yaml:"userPrincipalId,omitempty"`
+}
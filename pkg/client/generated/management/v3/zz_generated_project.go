@@ -5,53 +5,59 @@
 )
 
 const (
-	ProjectType                               = "project"
-	ProjectFieldAnnotations                   = "annotations"
-	ProjectFieldClusterID                     = "clusterId"
-	ProjectFieldConditions                    = "conditions"
-	ProjectFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit"
-	ProjectFieldCreated                       = "created"
-	ProjectFieldCreatorID                     = "creatorId"
-	ProjectFieldDescription                   = "description"
-	ProjectFieldEnableProjectMonitoring       = "enableProjectMonitoring"
-	ProjectFieldLabels                        = "labels"
-	ProjectFieldMonitoringStatus              = "monitoringStatus"
-	ProjectFieldName                          = "name"
-	ProjectFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
-	ProjectFieldNamespaceId                   = "namespaceId"
-	ProjectFieldOwnerReferences               = "ownerReferences"
-	ProjectFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId"
-	ProjectFieldRemoved                       = "removed"
-	ProjectFieldResourceQuota                 = "resourceQuota"
-	ProjectFieldState                         = "state"
-	ProjectFieldTransitioning                 = "transitioning"
-	ProjectFieldTransitioningMessage          = "transitioningMessage"
-	ProjectFieldUUID                          = "uuid"
+	ProjectType                                    = "project"
+	ProjectFieldAnnotations                        = "annotations"
+	ProjectFieldAppliedProjectTemplateRevisionName = "appliedProjectTemplateRevisionName"
+	ProjectFieldClusterID                          = "clusterId"
+	ProjectFieldConditions                         = "conditions"
+	ProjectFieldContainerDefaultResourceLimit      = "containerDefaultResourceLimit"
+	ProjectFieldCreated                            = "created"
+	ProjectFieldCreatorID                          = "creatorId"
+	ProjectFieldDescription                        = "description"
+	ProjectFieldEnableProjectMonitoring            = "enableProjectMonitoring"
+	ProjectFieldLabels                             = "labels"
+	ProjectFieldMonitoringStatus                   = "monitoringStatus"
+	ProjectFieldName                               = "name"
+	ProjectFieldNamespaceDefaultResourceQuota      = "namespaceDefaultResourceQuota"
+	ProjectFieldNamespaceId                        = "namespaceId"
+	ProjectFieldOwnerReferences                    = "ownerReferences"
+	ProjectFieldPodSecurityPolicyTemplateName      = "podSecurityPolicyTemplateId"
+	ProjectFieldProjectTemplateID                  = "projectTemplateId"
+	ProjectFieldProjectTemplateRevisionID          = "projectTemplateRevisionId"
+	ProjectFieldRemoved                            = "removed"
+	ProjectFieldResourceQuota                      = "resourceQuota"
+	ProjectFieldState                              = "state"
+	ProjectFieldTransitioning                      = "transitioning"
+	ProjectFieldTransitioningMessage               = "transitioningMessage"
+	ProjectFieldUUID                               = "uuid"
 )
 
 type Project struct {
 	types.Resource
-	Annotations                   map[string]string       `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterID                     string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Conditions                    []ProjectCondition      `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty" yaml:"containerDefaultResourceLimit,omitempty"`
-	Created                       string                  `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID                     string                  `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description                   string                  `json:"description,omitempty" yaml:"description,omitempty"`
-	EnableProjectMonitoring       bool                    `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
-	Labels                        map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
-	MonitoringStatus              *MonitoringStatus       `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
-	Name                          string                  `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
-	NamespaceId                   string                  `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences               []OwnerReference        `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	PodSecurityPolicyTemplateName string                  `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
-	Removed                       string                  `json:"removed,omitempty" yaml:"removed,omitempty"`
-	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
-	State                         string                  `json:"state,omitempty" yaml:"state,omitempty"`
-	Transitioning                 string                  `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage          string                  `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	UUID                          string                  `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Annotations                        map[string]string       `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	AppliedProjectTemplateRevisionName string                  `json:"appliedProjectTemplateRevisionName,omitempty" yaml:"appliedProjectTemplateRevisionName,omitempty"`
+	ClusterID                          string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Conditions                         []ProjectCondition      `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	ContainerDefaultResourceLimit      *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty" yaml:"containerDefaultResourceLimit,omitempty"`
+	Created                            string                  `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID                          string                  `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description                        string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	EnableProjectMonitoring            bool                    `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
+	Labels                             map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MonitoringStatus                   *MonitoringStatus       `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
+	Name                               string                  `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceDefaultResourceQuota      *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	NamespaceId                        string                  `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences                    []OwnerReference        `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PodSecurityPolicyTemplateName      string                  `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	ProjectTemplateID                  string                  `json:"projectTemplateId,omitempty" yaml:"projectTemplateId,omitempty"`
+	ProjectTemplateRevisionID          string                  `json:"projectTemplateRevisionId,omitempty" yaml:"projectTemplateRevisionId,omitempty"`
+	Removed                            string                  `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ResourceQuota                      *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
+	State                              string                  `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning                      string                  `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage               string                  `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UUID                               string                  `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 
 type ProjectCollection struct {
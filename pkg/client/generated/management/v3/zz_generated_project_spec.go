@@ -8,6 +8,8 @@
 	ProjectSpecFieldDisplayName                   = "displayName"
 	ProjectSpecFieldEnableProjectMonitoring       = "enableProjectMonitoring"
 	ProjectSpecFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
+	ProjectSpecFieldProjectTemplateID             = "projectTemplateId"
+	ProjectSpecFieldProjectTemplateRevisionID     = "projectTemplateRevisionId"
 	ProjectSpecFieldResourceQuota                 = "resourceQuota"
 )
 
@@ -18,5 +20,7 @@ type ProjectSpec struct {
 	DisplayName                   string                  `json:"displayName,omitempty" yaml:"displayName,omitempty"`
 	EnableProjectMonitoring       bool                    `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
 	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	ProjectTemplateID             string                  `json:"projectTemplateId,omitempty" yaml:"projectTemplateId,omitempty"`
+	ProjectTemplateRevisionID     string                  `json:"projectTemplateRevisionId,omitempty" yaml:"projectTemplateRevisionId,omitempty"`
 	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
 }
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ProjectSpecBaseType                               = "projectSpecBase"
+	ProjectSpecBaseFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit"
+	ProjectSpecBaseFieldEnableProjectMonitoring       = "enableProjectMonitoring"
+	ProjectSpecBaseFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
+	ProjectSpecBaseFieldResourceQuota                 = "resourceQuota"
+)
+
+type ProjectSpecBase struct {
+	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty" yaml:"containerDefaultResourceLimit,omitempty"`
+	EnableProjectMonitoring       bool                    `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
+}
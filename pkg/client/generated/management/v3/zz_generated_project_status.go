@@ -1,14 +1,16 @@
 package client
 
 const (
-	ProjectStatusType                               = "projectStatus"
-	ProjectStatusFieldConditions                    = "conditions"
-	ProjectStatusFieldMonitoringStatus              = "monitoringStatus"
-	ProjectStatusFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId"
+	ProjectStatusType                                    = "projectStatus"
+	ProjectStatusFieldAppliedProjectTemplateRevisionName = "appliedProjectTemplateRevisionName"
+	ProjectStatusFieldConditions                         = "conditions"
+	ProjectStatusFieldMonitoringStatus                   = "monitoringStatus"
+	ProjectStatusFieldPodSecurityPolicyTemplateName      = "podSecurityPolicyTemplateId"
 )
 
 type ProjectStatus struct {
-	Conditions                    []ProjectCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	MonitoringStatus              *MonitoringStatus  `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
-	PodSecurityPolicyTemplateName string             `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	AppliedProjectTemplateRevisionName string             `json:"appliedProjectTemplateRevisionName,omitempty" yaml:"appliedProjectTemplateRevisionName,omitempty"`
+	Conditions                         []ProjectCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	MonitoringStatus                   *MonitoringStatus  `json:"monitoringStatus,omitempty" yaml:"monitoringStatus,omitempty"`
+	PodSecurityPolicyTemplateName      string             `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
 }
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ProjectTemplateType                   = "projectTemplate"
+	ProjectTemplateFieldAnnotations       = "annotations"
+	ProjectTemplateFieldCreated           = "created"
+	ProjectTemplateFieldCreatorID         = "creatorId"
+	ProjectTemplateFieldDefaultRevisionID = "defaultRevisionId"
+	ProjectTemplateFieldDescription       = "description"
+	ProjectTemplateFieldLabels            = "labels"
+	ProjectTemplateFieldName              = "name"
+	ProjectTemplateFieldOwnerReferences   = "ownerReferences"
+	ProjectTemplateFieldReconcileProjects = "reconcileProjects"
+	ProjectTemplateFieldRemoved           = "removed"
+	ProjectTemplateFieldUUID              = "uuid"
+)
+
+type ProjectTemplate struct {
+	types.Resource
+	Annotations       map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created           string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID         string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	DefaultRevisionID string            `json:"defaultRevisionId,omitempty" yaml:"defaultRevisionId,omitempty"`
+	Description       string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name              string            `json:"name,omitempty" yaml:"name,omitempty"`
+	OwnerReferences   []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	ReconcileProjects bool              `json:"reconcileProjects,omitempty" yaml:"reconcileProjects,omitempty"`
+	Removed           string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	UUID              string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ProjectTemplateCollection struct {
+	types.Collection
+	Data   []ProjectTemplate `json:"data,omitempty"`
+	client *ProjectTemplateClient
+}
+
+type ProjectTemplateClient struct {
+	apiClient *Client
+}
+
+type ProjectTemplateOperations interface {
+	List(opts *types.ListOpts) (*ProjectTemplateCollection, error)
+	ListAll(opts *types.ListOpts) (*ProjectTemplateCollection, error)
+	Create(opts *ProjectTemplate) (*ProjectTemplate, error)
+	Update(existing *ProjectTemplate, updates interface{}) (*ProjectTemplate, error)
+	Replace(existing *ProjectTemplate) (*ProjectTemplate, error)
+	ByID(id string) (*ProjectTemplate, error)
+	Delete(container *ProjectTemplate) error
+}
+
+func newProjectTemplateClient(apiClient *Client) *ProjectTemplateClient {
+	return &ProjectTemplateClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ProjectTemplateClient) Create(container *ProjectTemplate) (*ProjectTemplate, error) {
+	resp := &ProjectTemplate{}
+	err := c.apiClient.Ops.DoCreate(ProjectTemplateType, container, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateClient) Update(existing *ProjectTemplate, updates interface{}) (*ProjectTemplate, error) {
+	resp := &ProjectTemplate{}
+	err := c.apiClient.Ops.DoUpdate(ProjectTemplateType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateClient) Replace(obj *ProjectTemplate) (*ProjectTemplate, error) {
+	resp := &ProjectTemplate{}
+	err := c.apiClient.Ops.DoReplace(ProjectTemplateType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateClient) List(opts *types.ListOpts) (*ProjectTemplateCollection, error) {
+	resp := &ProjectTemplateCollection{}
+	err := c.apiClient.Ops.DoList(ProjectTemplateType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ProjectTemplateClient) ListAll(opts *types.ListOpts) (*ProjectTemplateCollection, error) {
+	resp := &ProjectTemplateCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ProjectTemplateCollection) Next() (*ProjectTemplateCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ProjectTemplateCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ProjectTemplateClient) ByID(id string) (*ProjectTemplate, error) {
+	resp := &ProjectTemplate{}
+	err := c.apiClient.Ops.DoByID(ProjectTemplateType, id, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateClient) Delete(container *ProjectTemplate) error {
+	return c.apiClient.Ops.DoResourceDelete(ProjectTemplateType, &container.Resource)
+}
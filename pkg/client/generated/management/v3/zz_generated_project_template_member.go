@@ -0,0 +1,12 @@
+package client
+
+const (
+	ProjectTemplateMemberType                  = "projectTemplateMember"
+	ProjectTemplateMemberFieldGroupPrincipalID = "groupPrincipalId"
+	ProjectTemplateMemberFieldRoleTemplateID   = "roleTemplateId"
+)
+
+type ProjectTemplateMember struct {
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty" yaml:"groupPrincipalId,omitempty"`
+	RoleTemplateID   string `json:"roleTemplateId,omitempty" yaml:"roleTemplateId,omitempty"`
+}
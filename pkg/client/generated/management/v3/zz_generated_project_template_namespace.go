@@ -0,0 +1,14 @@
+package client
+
+const (
+	ProjectTemplateNamespaceType             = "projectTemplateNamespace"
+	ProjectTemplateNamespaceFieldAnnotations = "annotations"
+	ProjectTemplateNamespaceFieldLabels      = "labels"
+	ProjectTemplateNamespaceFieldName        = "name"
+)
+
+type ProjectTemplateNamespace struct {
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Name        string            `json:"name,omitempty" yaml:"name,omitempty"`
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ProjectTemplateRevisionType                             = "projectTemplateRevision"
+	ProjectTemplateRevisionFieldAnnotations                 = "annotations"
+	ProjectTemplateRevisionFieldCreated                     = "created"
+	ProjectTemplateRevisionFieldCreatorID                   = "creatorId"
+	ProjectTemplateRevisionFieldEnabled                     = "enabled"
+	ProjectTemplateRevisionFieldLabels                      = "labels"
+	ProjectTemplateRevisionFieldMembers                     = "members"
+	ProjectTemplateRevisionFieldName                        = "name"
+	ProjectTemplateRevisionFieldNamespaces                  = "namespaces"
+	ProjectTemplateRevisionFieldOwnerReferences             = "ownerReferences"
+	ProjectTemplateRevisionFieldPodSecurityPolicyTemplateID = "podSecurityPolicyTemplateId"
+	ProjectTemplateRevisionFieldProjectConfig               = "projectConfig"
+	ProjectTemplateRevisionFieldProjectTemplateID           = "projectTemplateId"
+	ProjectTemplateRevisionFieldRemoved                     = "removed"
+	ProjectTemplateRevisionFieldUUID                        = "uuid"
+)
+
+type ProjectTemplateRevision struct {
+	types.Resource
+	Annotations                 map[string]string          `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Created                     string                     `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID                   string                     `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Enabled                     *bool                      `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Labels                      map[string]string          `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Members                     []ProjectTemplateMember    `json:"members,omitempty" yaml:"members,omitempty"`
+	Name                        string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	Namespaces                  []ProjectTemplateNamespace `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	OwnerReferences             []OwnerReference           `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PodSecurityPolicyTemplateID string                     `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	ProjectConfig               *ProjectSpecBase           `json:"projectConfig,omitempty" yaml:"projectConfig,omitempty"`
+	ProjectTemplateID           string                     `json:"projectTemplateId,omitempty" yaml:"projectTemplateId,omitempty"`
+	Removed                     string                     `json:"removed,omitempty" yaml:"removed,omitempty"`
+	UUID                        string                     `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+
+type ProjectTemplateRevisionCollection struct {
+	types.Collection
+	Data   []ProjectTemplateRevision `json:"data,omitempty"`
+	client *ProjectTemplateRevisionClient
+}
+
+type ProjectTemplateRevisionClient struct {
+	apiClient *Client
+}
+
+type ProjectTemplateRevisionOperations interface {
+	List(opts *types.ListOpts) (*ProjectTemplateRevisionCollection, error)
+	ListAll(opts *types.ListOpts) (*ProjectTemplateRevisionCollection, error)
+	Create(opts *ProjectTemplateRevision) (*ProjectTemplateRevision, error)
+	Update(existing *ProjectTemplateRevision, updates interface{}) (*ProjectTemplateRevision, error)
+	Replace(existing *ProjectTemplateRevision) (*ProjectTemplateRevision, error)
+	ByID(id string) (*ProjectTemplateRevision, error)
+	Delete(container *ProjectTemplateRevision) error
+}
+
+func newProjectTemplateRevisionClient(apiClient *Client) *ProjectTemplateRevisionClient {
+	return &ProjectTemplateRevisionClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ProjectTemplateRevisionClient) Create(container *ProjectTemplateRevision) (*ProjectTemplateRevision, error) {
+	resp := &ProjectTemplateRevision{}
+	err := c.apiClient.Ops.DoCreate(ProjectTemplateRevisionType, container, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateRevisionClient) Update(existing *ProjectTemplateRevision, updates interface{}) (*ProjectTemplateRevision, error) {
+	resp := &ProjectTemplateRevision{}
+	err := c.apiClient.Ops.DoUpdate(ProjectTemplateRevisionType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateRevisionClient) Replace(obj *ProjectTemplateRevision) (*ProjectTemplateRevision, error) {
+	resp := &ProjectTemplateRevision{}
+	err := c.apiClient.Ops.DoReplace(ProjectTemplateRevisionType, &obj.Resource, obj, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateRevisionClient) List(opts *types.ListOpts) (*ProjectTemplateRevisionCollection, error) {
+	resp := &ProjectTemplateRevisionCollection{}
+	err := c.apiClient.Ops.DoList(ProjectTemplateRevisionType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (c *ProjectTemplateRevisionClient) ListAll(opts *types.ListOpts) (*ProjectTemplateRevisionCollection, error) {
+	resp := &ProjectTemplateRevisionCollection{}
+	resp, err := c.List(opts)
+	if err != nil {
+		return resp, err
+	}
+	data := resp.Data
+	for next, err := resp.Next(); next != nil && err == nil; next, err = next.Next() {
+		data = append(data, next.Data...)
+		resp = next
+		resp.Data = data
+	}
+	if err != nil {
+		return resp, err
+	}
+	return resp, err
+}
+
+func (cc *ProjectTemplateRevisionCollection) Next() (*ProjectTemplateRevisionCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ProjectTemplateRevisionCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ProjectTemplateRevisionClient) ByID(id string) (*ProjectTemplateRevision, error) {
+	resp := &ProjectTemplateRevision{}
+	err := c.apiClient.Ops.DoByID(ProjectTemplateRevisionType, id, resp)
+	return resp, err
+}
+
+func (c *ProjectTemplateRevisionClient) Delete(container *ProjectTemplateRevision) error {
+	return c.apiClient.Ops.DoResourceDelete(ProjectTemplateRevisionType, &container.Resource)
+}
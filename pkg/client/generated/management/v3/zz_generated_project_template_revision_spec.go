@@ -0,0 +1,22 @@
+package client
+
+const (
+	ProjectTemplateRevisionSpecType                             = "projectTemplateRevisionSpec"
+	ProjectTemplateRevisionSpecFieldDisplayName                 = "displayName"
+	ProjectTemplateRevisionSpecFieldEnabled                     = "enabled"
+	ProjectTemplateRevisionSpecFieldMembers                     = "members"
+	ProjectTemplateRevisionSpecFieldNamespaces                  = "namespaces"
+	ProjectTemplateRevisionSpecFieldPodSecurityPolicyTemplateID = "podSecurityPolicyTemplateId"
+	ProjectTemplateRevisionSpecFieldProjectConfig               = "projectConfig"
+	ProjectTemplateRevisionSpecFieldProjectTemplateID           = "projectTemplateId"
+)
+
+type ProjectTemplateRevisionSpec struct {
+	DisplayName                 string                     `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Enabled                     *bool                      `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Members                     []ProjectTemplateMember    `json:"members,omitempty" yaml:"members,omitempty"`
+	Namespaces                  []ProjectTemplateNamespace `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	PodSecurityPolicyTemplateID string                     `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	ProjectConfig               *ProjectSpecBase           `json:"projectConfig,omitempty" yaml:"projectConfig,omitempty"`
+	ProjectTemplateID           string                     `json:"projectTemplateId,omitempty" yaml:"projectTemplateId,omitempty"`
+}
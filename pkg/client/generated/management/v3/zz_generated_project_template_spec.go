@@ -0,0 +1,16 @@
+package client
+
+const (
+	ProjectTemplateSpecType                   = "projectTemplateSpec"
+	ProjectTemplateSpecFieldDefaultRevisionID = "defaultRevisionId"
+	ProjectTemplateSpecFieldDescription       = "description"
+	ProjectTemplateSpecFieldDisplayName       = "displayName"
+	ProjectTemplateSpecFieldReconcileProjects = "reconcileProjects"
+)
+
+type ProjectTemplateSpec struct {
+	DefaultRevisionID string `json:"defaultRevisionId,omitempty" yaml:"defaultRevisionId,omitempty"`
+	Description       string `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName       string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	ReconcileProjects bool   `json:"reconcileProjects,omitempty" yaml:"reconcileProjects,omitempty"`
+}
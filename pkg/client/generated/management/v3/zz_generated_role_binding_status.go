@@ -0,0 +1,10 @@
+package client
+
+const (
+	RoleBindingStatusType            = "roleBindingStatus"
+	RoleBindingStatusFieldConditions = "conditions"
+)
+
+type RoleBindingStatus struct {
+	Conditions []RoleBindingCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	S3BackupTargetConfigType                     = "s3BackupTargetConfig"
+	S3BackupTargetConfigFieldBucketName          = "bucketName"
+	S3BackupTargetConfigFieldCloudCredentialName = "cloudCredentialName"
+	S3BackupTargetConfigFieldCustomCA            = "customCa"
+	S3BackupTargetConfigFieldEndpoint            = "endpoint"
+	S3BackupTargetConfigFieldFolder              = "folder"
+	S3BackupTargetConfigFieldRegion              = "region"
+)
+
+type S3BackupTargetConfig struct {
+	BucketName          string `json:"bucketName,omitempty" yaml:"bucketName,omitempty"`
+	CloudCredentialName string `json:"cloudCredentialName,omitempty" yaml:"cloudCredentialName,omitempty"`
+	CustomCA            string `json:"customCa,omitempty" yaml:"customCa,omitempty"`
+	Endpoint            string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Folder              string `json:"folder,omitempty" yaml:"folder,omitempty"`
+	Region              string `json:"region,omitempty" yaml:"region,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	TOTPDisableInputType      = "totpDisableInput"
+	TOTPDisableInputFieldCode = "code"
+)
+
+type TOTPDisableInput struct {
+	Code string `json:"code,omitempty" yaml:"code,omitempty"`
+}
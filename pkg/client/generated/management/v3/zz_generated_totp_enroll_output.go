@@ -0,0 +1,12 @@
+package client
+
+const (
+	TOTPEnrollOutputType        = "totpEnrollOutput"
+	TOTPEnrollOutputFieldSecret = "secret"
+	TOTPEnrollOutputFieldURL    = "url"
+)
+
+type TOTPEnrollOutput struct {
+	Secret string `json:"secret,omitempty" yaml:"secret,omitempty"`
+	URL    string `json:"url,omitempty" yaml:"url,omitempty"`
+}
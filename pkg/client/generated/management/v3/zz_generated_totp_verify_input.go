@@ -0,0 +1,10 @@
+package client
+
+const (
+	TOTPVerifyInputType      = "totpVerifyInput"
+	TOTPVerifyInputFieldCode = "code"
+)
+
+type TOTPVerifyInput struct {
+	Code string `json:"code,omitempty" yaml:"code,omitempty"`
+}
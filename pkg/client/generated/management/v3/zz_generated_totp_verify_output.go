@@ -0,0 +1,10 @@
+package client
+
+const (
+	TOTPVerifyOutputType               = "totpVerifyOutput"
+	TOTPVerifyOutputFieldRecoveryCodes = "recoveryCodes"
+)
+
+type TOTPVerifyOutput struct {
+	RecoveryCodes []string `json:"recoveryCodes,omitempty" yaml:"recoveryCodes,omitempty"`
+}
@@ -15,12 +15,14 @@
 	UserFieldLabels               = "labels"
 	UserFieldMe                   = "me"
 	UserFieldMustChangePassword   = "mustChangePassword"
+	UserFieldMustEnrollTOTP       = "mustEnrollTotp"
 	UserFieldName                 = "name"
 	UserFieldOwnerReferences      = "ownerReferences"
 	UserFieldPassword             = "password"
 	UserFieldPrincipalIDs         = "principalIds"
 	UserFieldRemoved              = "removed"
 	UserFieldState                = "state"
+	UserFieldTOTPEnabled          = "totpEnabled"
 	UserFieldTransitioning        = "transitioning"
 	UserFieldTransitioningMessage = "transitioningMessage"
 	UserFieldUUID                 = "uuid"
@@ -38,12 +40,14 @@ type User struct {
 	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Me                   bool              `json:"me,omitempty" yaml:"me,omitempty"`
 	MustChangePassword   bool              `json:"mustChangePassword,omitempty" yaml:"mustChangePassword,omitempty"`
+	MustEnrollTOTP       bool              `json:"mustEnrollTotp,omitempty" yaml:"mustEnrollTotp,omitempty"`
 	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
 	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	Password             string            `json:"password,omitempty" yaml:"password,omitempty"`
 	PrincipalIDs         []string          `json:"principalIds,omitempty" yaml:"principalIds,omitempty"`
 	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
 	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	TOTPEnabled          bool              `json:"totpEnabled,omitempty" yaml:"totpEnabled,omitempty"`
 	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
 	UUID                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
@@ -73,9 +77,19 @@ type UserOperations interface {
 
 	ActionSetpassword(resource *User, input *SetPasswordInput) (*User, error)
 
+	ActionTotpdisable(resource *User, input *TOTPDisableInput) error
+
+	ActionTotpenroll(resource *User) (*TOTPEnrollOutput, error)
+
+	ActionTotpverify(resource *User, input *TOTPVerifyInput) (*TOTPVerifyOutput, error)
+
 	CollectionActionChangepassword(resource *UserCollection, input *ChangePasswordInput) error
 
+	CollectionActionEffectivepermissions(resource *UserCollection, input *EffectivePermissionsInput) (*EffectivePermissionsOutput, error)
+
 	CollectionActionRefreshauthprovideraccess(resource *UserCollection) error
+
+	CollectionActionWhocan(resource *UserCollection, input *WhoCanInput) (*WhoCanOutput, error)
 }
 
 func newUserClient(apiClient *Client) *UserClient {
@@ -158,12 +172,41 @@ func (c *UserClient) ActionSetpassword(resource *User, input *SetPasswordInput)
 	return resp, err
 }
 
+func (c *UserClient) ActionTotpdisable(resource *User, input *TOTPDisableInput) error {
+	err := c.apiClient.Ops.DoAction(UserType, "totpdisable", &resource.Resource, input, nil)
+	return err
+}
+
+func (c *UserClient) ActionTotpenroll(resource *User) (*TOTPEnrollOutput, error) {
+	resp := &TOTPEnrollOutput{}
+	err := c.apiClient.Ops.DoAction(UserType, "totpenroll", &resource.Resource, nil, resp)
+	return resp, err
+}
+
+func (c *UserClient) ActionTotpverify(resource *User, input *TOTPVerifyInput) (*TOTPVerifyOutput, error) {
+	resp := &TOTPVerifyOutput{}
+	err := c.apiClient.Ops.DoAction(UserType, "totpverify", &resource.Resource, input, resp)
+	return resp, err
+}
+
 func (c *UserClient) CollectionActionChangepassword(resource *UserCollection, input *ChangePasswordInput) error {
 	err := c.apiClient.Ops.DoCollectionAction(UserType, "changepassword", &resource.Collection, input, nil)
 	return err
 }
 
+func (c *UserClient) CollectionActionEffectivepermissions(resource *UserCollection, input *EffectivePermissionsInput) (*EffectivePermissionsOutput, error) {
+	resp := &EffectivePermissionsOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(UserType, "effectivepermissions", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *UserClient) CollectionActionRefreshauthprovideraccess(resource *UserCollection) error {
 	err := c.apiClient.Ops.DoCollectionAction(UserType, "refreshauthprovideraccess", &resource.Collection, nil, nil)
 	return err
 }
+
+func (c *UserClient) CollectionActionWhocan(resource *UserCollection, input *WhoCanInput) (*WhoCanOutput, error) {
+	resp := &WhoCanOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(UserType, "whocan", &resource.Collection, input, resp)
+	return resp, err
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	WhoCanInputType              = "whoCanInput"
+	WhoCanInputFieldAPIGroup     = "apiGroup"
+	WhoCanInputFieldClusterID    = "clusterId"
+	WhoCanInputFieldProjectID    = "projectId"
+	WhoCanInputFieldResource     = "resource"
+	WhoCanInputFieldResourceName = "resourceName"
+	WhoCanInputFieldVerb         = "verb"
+)
+
+type WhoCanInput struct {
+	APIGroup     string `json:"apiGroup,omitempty" yaml:"apiGroup,omitempty"`
+	ClusterID    string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	ProjectID    string `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	Resource     string `json:"resource,omitempty" yaml:"resource,omitempty"`
+	ResourceName string `json:"resourceName,omitempty" yaml:"resourceName,omitempty"`
+	Verb         string `json:"verb,omitempty" yaml:"verb,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	WhoCanOutputType             = "whoCanOutput"
+	WhoCanOutputFieldPermissions = "permissions"
+)
+
+type WhoCanOutput struct {
+	Permissions []GrantedPermission `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
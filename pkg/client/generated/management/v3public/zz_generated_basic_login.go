@@ -5,6 +5,7 @@
 	BasicLoginFieldDescription  = "description"
 	BasicLoginFieldPassword     = "password"
 	BasicLoginFieldResponseType = "responseType"
+	BasicLoginFieldTOTPCode     = "totpCode"
 	BasicLoginFieldTTLMillis    = "ttl"
 	BasicLoginFieldUsername     = "username"
 )
@@ -13,6 +14,7 @@ type BasicLogin struct {
 	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
 	Password     string `json:"password,omitempty" yaml:"password,omitempty"`
 	ResponseType string `json:"responseType,omitempty" yaml:"responseType,omitempty"`
+	TOTPCode     string `json:"totpCode,omitempty" yaml:"totpCode,omitempty"`
 	TTLMillis    int64  `json:"ttl,omitempty" yaml:"ttl,omitempty"`
 	Username     string `json:"username,omitempty" yaml:"username,omitempty"`
 }
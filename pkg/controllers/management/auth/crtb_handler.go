@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -66,6 +67,9 @@ func (c *crtbLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Obje
 	if err != nil {
 		return nil, err
 	}
+	if !c.active(obj) {
+		return obj, c.revokeBindings(obj)
+	}
 	err = c.reconcileBindings(obj)
 
 	return obj, err
@@ -79,6 +83,9 @@ func (c *crtbLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Obj
 	if err := c.reconcileLabels(obj); err != nil {
 		return nil, err
 	}
+	if !c.active(obj) {
+		return obj, c.revokeBindings(obj)
+	}
 	err = c.reconcileBindings(obj)
 	return obj, err
 }
@@ -95,6 +102,24 @@ func (c *crtbLifecycle) Remove(obj *v3.ClusterRoleTemplateBinding) (runtime.Obje
 	return nil, err
 }
 
+// active returns whether the CRTB grants its role now, and enqueues it for the time that changes.
+func (c *crtbLifecycle) active(binding *v3.ClusterRoleTemplateBinding) bool {
+	return bindingActive(binding, binding.NotBefore, binding.ExpiresAt, func(after time.Duration) {
+		c.mgr.crtbs.Controller().EnqueueAfter(binding.Namespace, binding.Name, after)
+	})
+}
+
+// revokeBindings revokes the RBAC reconcileBindings granted for a CRTB that isn't active, the CRTB itself is kept.
+func (c *crtbLifecycle) revokeBindings(binding *v3.ClusterRoleTemplateBinding) error {
+	if err := c.mgr.reconcileClusterMembershipBindingForDelete("", pkgrbac.GetRTBLabel(binding.ObjectMeta)); err != nil {
+		return err
+	}
+	if err := c.removeMGMTClusterScopedPrivilegesInProjectNamespace(binding); err != nil {
+		return err
+	}
+	return c.mgr.revokeManagementPlanePrivileges(binding)
+}
+
 func (c *crtbLifecycle) reconcileSubject(binding *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
 	if binding.GroupName != "" || binding.GroupPrincipalName != "" || (binding.UserPrincipalName != "" && binding.UserName != "") {
 		return binding, nil
@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -41,6 +42,7 @@
 func newGlobalRoleBindingLifecycle(management *config.ManagementContext, clusterManager *clustermanager.Manager) *globalRoleBindingLifecycle {
 	return &globalRoleBindingLifecycle{
 		clusters:          management.Management.Clusters(""),
+		grbs:              management.Management.GlobalRoleBindings(""),
 		clusterLister:     management.Management.Clusters("").Controller().Lister(),
 		projectLister:     management.Management.Projects("").Controller().Lister(),
 		clusterManager:    clusterManager,
@@ -58,6 +60,7 @@ func newGlobalRoleBindingLifecycle(management *config.ManagementContext, cluster
 
 type globalRoleBindingLifecycle struct {
 	clusters          v3.ClusterInterface
+	grbs              v3.GlobalRoleBindingInterface
 	clusterLister     v3.ClusterLister
 	projectLister     v3.ProjectLister
 	clusterManager    *clustermanager.Manager
@@ -73,11 +76,17 @@ type globalRoleBindingLifecycle struct {
 }
 
 func (grb *globalRoleBindingLifecycle) Create(obj *v3.GlobalRoleBinding) (runtime.Object, error) {
+	if !grb.active(obj) {
+		return obj, grb.revokeGlobalRoleBinding(obj)
+	}
 	err := grb.reconcileGlobalRoleBinding(obj)
 	return obj, err
 }
 
 func (grb *globalRoleBindingLifecycle) Updated(obj *v3.GlobalRoleBinding) (runtime.Object, error) {
+	if !grb.active(obj) {
+		return obj, grb.revokeGlobalRoleBinding(obj)
+	}
 	err := grb.reconcileGlobalRoleBinding(obj)
 	return obj, err
 }
@@ -90,6 +99,102 @@ func (grb *globalRoleBindingLifecycle) Remove(obj *v3.GlobalRoleBinding) (runtim
 	return obj, nil
 }
 
+// active returns whether the GRB grants its role now, and enqueues it for the time that changes.
+func (grb *globalRoleBindingLifecycle) active(obj *v3.GlobalRoleBinding) bool {
+	return bindingActive(obj, obj.NotBefore, obj.ExpiresAt, func(after time.Duration) {
+		grb.grbs.Controller().EnqueueAfter(obj.Namespace, obj.Name, after)
+	})
+}
+
+// revokeGlobalRoleBinding deletes the bindings reconcileGlobalRoleBinding created for a GRB that isn't active, the GRB
+// itself is kept. The bindings of admins in downstream clusters are deleted too.
+func (grb *globalRoleBindingLifecycle) revokeGlobalRoleBinding(obj *v3.GlobalRoleBinding) error {
+	crbName, ok := obj.Annotations[crbNameAnnotation]
+	if !ok {
+		crbName = crbNamePrefix + obj.Name
+	}
+	if err := grb.deleteCRB(crbName); err != nil {
+		return err
+	}
+	if err := grb.deleteRB(namespace.GlobalNamespace, obj.Name+"-"+globalCatalogRoleBinding); err != nil {
+		return err
+	}
+
+	if obj.GlobalRoleName == rbac.GlobalRestrictedAdmin {
+		if err := grb.revokeRestrictedAdminPermissions(obj); err != nil {
+			return err
+		}
+	}
+	if obj.GlobalRoleName == rbac.GlobalAdmin || obj.GlobalRoleName == rbac.GlobalRestrictedAdmin {
+		return grb.deleteAdminBinding(obj)
+	}
+	return nil
+}
+
+func (grb *globalRoleBindingLifecycle) revokeRestrictedAdminPermissions(globalRoleBinding *v3.GlobalRoleBinding) error {
+	r, _ := labels.NewRequirement(rbac.RestrictedAdminCRForClusters, selection.Exists, []string{})
+	crs, err := grb.crLister.List("", labels.NewSelector().Add(*r))
+	if err != nil {
+		return err
+	}
+	var returnErr error
+	for _, cr := range crs {
+		crbName := cr.Labels[rbac.RestrictedAdminCRForClusters] + rbac.RestrictedAdminCRBForClusters + globalRoleBinding.Name
+		if err := grb.deleteCRB(crbName); err != nil {
+			returnErr = multierror.Append(returnErr, err)
+		}
+	}
+
+	clusters, err := grb.clusterLister.List("", labels.NewSelector())
+	if err != nil {
+		return multierror.Append(returnErr, err)
+	}
+	for _, cluster := range clusters {
+		if err := grb.deleteRB(cluster.Name, fmt.Sprintf("%s-%s", globalRoleBinding.Name, rbac.RestrictedAdminClusterRoleBinding)); err != nil {
+			returnErr = multierror.Append(returnErr, err)
+		}
+		projects, err := grb.projectLister.List(cluster.Name, labels.NewSelector())
+		if err != nil {
+			returnErr = multierror.Append(returnErr, err)
+			continue
+		}
+		for _, project := range projects {
+			if err := grb.deleteRB(project.Name, fmt.Sprintf("%s-%s", globalRoleBinding.Name, rbac.RestrictedAdminProjectRoleBinding)); err != nil {
+				returnErr = multierror.Append(returnErr, err)
+			}
+		}
+	}
+	return returnErr
+}
+
+func (grb *globalRoleBindingLifecycle) deleteCRB(name string) error {
+	if _, err := grb.crbLister.Get("", name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	logrus.Infof("[%v] Deleting clusterRoleBinding %v", grbController, name)
+	if err := grb.crbClient.Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (grb *globalRoleBindingLifecycle) deleteRB(ns, name string) error {
+	if _, err := grb.roleBindingLister.Get(ns, name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	logrus.Infof("[%v] Deleting roleBinding %v in namespace %v", grbController, name, ns)
+	if err := grb.roleBindings.DeleteNamespaced(ns, name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (grb *globalRoleBindingLifecycle) deleteAdminBinding(obj *v3.GlobalRoleBinding) error {
 	// Explicit API call to ensure we have the most recent cluster info when deleting admin bindings
 	clusters, err := grb.clusters.List(metav1.ListOptions{})
@@ -3,6 +3,7 @@
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
@@ -60,6 +61,9 @@ func (p *prtbLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Obje
 	if err != nil {
 		return nil, err
 	}
+	if !p.active(obj) {
+		return obj, p.revokeBindings(obj)
+	}
 	err = p.reconcileBindings(obj)
 	return obj, err
 }
@@ -75,32 +79,54 @@ func (p *prtbLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Obj
 	if err := p.reconcileLabels(obj); err != nil {
 		return nil, err
 	}
+	if !p.active(obj) {
+		return obj, p.revokeBindings(obj)
+	}
 	err = p.reconcileBindings(obj)
 	return obj, err
 }
 
 func (p *prtbLifecycle) Remove(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
-	parts := strings.SplitN(obj.ProjectName, ":", 2)
+	if err := p.revokeMembershipBindings(obj); err != nil {
+		return nil, err
+	}
+
+	err := p.mgr.removeAuthV2Permissions(authprovisioningv2.PRTBRoleBindingID, obj)
+
+	return nil, err
+}
+
+// active returns whether the PRTB grants its role now, and enqueues it for the time that changes.
+func (p *prtbLifecycle) active(binding *v3.ProjectRoleTemplateBinding) bool {
+	return bindingActive(binding, binding.NotBefore, binding.ExpiresAt, func(after time.Duration) {
+		p.mgr.prtbs.Controller().EnqueueAfter(binding.Namespace, binding.Name, after)
+	})
+}
+
+// revokeBindings revokes the RBAC reconcileBindings granted for a PRTB that isn't active, the PRTB itself is kept.
+func (p *prtbLifecycle) revokeBindings(binding *v3.ProjectRoleTemplateBinding) error {
+	if err := p.revokeMembershipBindings(binding); err != nil {
+		return err
+	}
+	return p.mgr.revokeManagementPlanePrivileges(binding)
+}
+
+func (p *prtbLifecycle) revokeMembershipBindings(binding *v3.ProjectRoleTemplateBinding) error {
+	parts := strings.SplitN(binding.ProjectName, ":", 2)
 	if len(parts) < 2 {
-		return nil, errors.Errorf("cannot determine project and cluster from %v", obj.ProjectName)
+		return errors.Errorf("cannot determine project and cluster from %v", binding.ProjectName)
 	}
 	clusterName := parts[0]
-	rtbNsAndName := pkgrbac.GetRTBLabel(obj.ObjectMeta)
+	rtbNsAndName := pkgrbac.GetRTBLabel(binding.ObjectMeta)
 	if err := p.mgr.reconcileProjectMembershipBindingForDelete(clusterName, "", rtbNsAndName); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := p.mgr.reconcileClusterMembershipBindingForDelete("", rtbNsAndName); err != nil {
-		return nil, err
-	}
-
-	if err := p.removeMGMTProjectScopedPrivilegesInClusterNamespace(obj, clusterName); err != nil {
-		return nil, err
+		return err
 	}
 
-	err := p.mgr.removeAuthV2Permissions(authprovisioningv2.PRTBRoleBindingID, obj)
-
-	return nil, err
+	return p.removeMGMTProjectScopedPrivilegesInClusterNamespace(binding, clusterName)
 }
 
 func (p *prtbLifecycle) reconcileSubject(binding *v3.ProjectRoleTemplateBinding) (*v3.ProjectRoleTemplateBinding, error) {
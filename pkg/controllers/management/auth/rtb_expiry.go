@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	pkgrbac "github.com/rancher/rancher/pkg/rbac"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// bindingActive returns whether a binding grants its role now. Bindings with a NotBefore or ExpiresAt time get Active
+// and Expired conditions and are enqueued again for the time they are activated or expire, the conditions of other
+// bindings aren't touched.
+func bindingActive(obj runtime.Object, notBefore, expiresAt *metav1.Time, enqueueAfter func(time.Duration)) bool {
+	if notBefore == nil && expiresAt == nil {
+		return true
+	}
+
+	now := time.Now()
+	active, next := pkgrbac.BindingActive(notBefore, expiresAt, now)
+	switch {
+	case active:
+		v32.RoleBindingConditionActive.True(obj)
+		v32.RoleBindingConditionActive.Reason(obj, "")
+		v32.RoleBindingConditionActive.Message(obj, "")
+	case pkgrbac.BindingExpired(expiresAt, now):
+		v32.RoleBindingConditionActive.False(obj)
+		v32.RoleBindingConditionActive.Reason(obj, "Expired")
+		v32.RoleBindingConditionActive.Message(obj, fmt.Sprintf("role was revoked at %s", expiresAt.UTC().Format(time.RFC3339)))
+	default:
+		v32.RoleBindingConditionActive.False(obj)
+		v32.RoleBindingConditionActive.Reason(obj, "NotYetActive")
+		v32.RoleBindingConditionActive.Message(obj, fmt.Sprintf("role is granted at %s", notBefore.UTC().Format(time.RFC3339)))
+	}
+	if pkgrbac.BindingExpired(expiresAt, now) {
+		v32.RoleBindingConditionExpired.True(obj)
+	} else {
+		v32.RoleBindingConditionExpired.False(obj)
+	}
+
+	if next > 0 {
+		enqueueAfter(next)
+	}
+	return active
+}
+
+// revokeManagementPlanePrivileges deletes the rolebindings grantManagementPlanePrivileges created for a binding.
+func (m *manager) revokeManagementPlanePrivileges(binding metav1.Object) error {
+	current, err := m.rbIndexer.ByIndex(rbByOwnerIndex, string(binding.GetUID()))
+	if err != nil {
+		return err
+	}
+	currentRBs := map[string]*v1.RoleBinding{}
+	for _, c := range current {
+		rb := c.(*v1.RoleBinding)
+		currentRBs[rb.Name] = rb
+	}
+	return m.reconcileDesiredMGMTPlaneRoleBindings(currentRBs, map[string]*v1.RoleBinding{}, m.mgmt.RBAC.RoleBindings(binding.GetNamespace()))
+}
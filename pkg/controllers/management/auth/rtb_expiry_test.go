@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBindingActive(t *testing.T) {
+	var enqueued time.Duration
+	enqueueAfter := func(after time.Duration) { enqueued = after }
+
+	// bindings without times don't get conditions
+	prtb := &v32.ProjectRoleTemplateBinding{}
+	assert.True(t, bindingActive(prtb, prtb.NotBefore, prtb.ExpiresAt, enqueueAfter))
+	assert.Empty(t, prtb.Status.Conditions)
+	assert.Zero(t, enqueued)
+
+	// a binding that isn't active yet is enqueued for the time it's activated
+	prtb.NotBefore = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	assert.False(t, bindingActive(prtb, prtb.NotBefore, prtb.ExpiresAt, enqueueAfter))
+	assert.True(t, v32.RoleBindingConditionActive.IsFalse(prtb))
+	assert.Equal(t, "NotYetActive", v32.RoleBindingConditionActive.GetReason(prtb))
+	assert.True(t, v32.RoleBindingConditionExpired.IsFalse(prtb))
+	assert.InDelta(t, time.Hour, enqueued, float64(time.Minute))
+
+	// an active binding is enqueued for the time it expires
+	prtb.NotBefore = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	prtb.ExpiresAt = &metav1.Time{Time: time.Now().Add(2 * time.Hour)}
+	assert.True(t, bindingActive(prtb, prtb.NotBefore, prtb.ExpiresAt, enqueueAfter))
+	assert.True(t, v32.RoleBindingConditionActive.IsTrue(prtb))
+	assert.True(t, v32.RoleBindingConditionExpired.IsFalse(prtb))
+	assert.InDelta(t, 2*time.Hour, enqueued, float64(time.Minute))
+
+	// an expired binding is kept with an expired condition and isn't enqueued again
+	enqueued = 0
+	prtb.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	assert.False(t, bindingActive(prtb, prtb.NotBefore, prtb.ExpiresAt, enqueueAfter))
+	assert.True(t, v32.RoleBindingConditionActive.IsFalse(prtb))
+	assert.Equal(t, "Expired", v32.RoleBindingConditionActive.GetReason(prtb))
+	assert.True(t, v32.RoleBindingConditionExpired.IsTrue(prtb))
+	assert.Zero(t, enqueued)
+}
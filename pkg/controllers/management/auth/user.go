@@ -6,6 +6,8 @@
 
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 
+	"github.com/rancher/rancher/pkg/auth/passwordpolicy"
+	"github.com/rancher/rancher/pkg/auth/totp"
 	"github.com/rancher/rancher/pkg/clustermanager"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
@@ -212,6 +214,11 @@ func (l *userLifecycle) Remove(user *v3.User) (runtime.Object, error) {
 		return nil, err
 	}
 
+	err = l.deleteAuthSecrets(user.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err = l.removeLegacyFinalizers(user)
 	if err != nil {
 		return nil, err
@@ -429,6 +436,17 @@ func (l *userLifecycle) deleteUserSecret(username string) error {
 	return l.secrets.DeleteNamespaced("cattle-system", username+"-secret", &metav1.DeleteOptions{})
 }
 
+// deleteAuthSecrets removes the TOTP enrollment and password history of a local user.
+func (l *userLifecycle) deleteAuthSecrets(username string) error {
+	if err := (&totp.Store{Secrets: l.secrets}).Delete(username); err != nil {
+		return fmt.Errorf("error deleting TOTP enrollment: %v", err)
+	}
+	if err := (&passwordpolicy.HistoryStore{Secrets: l.secrets}).Delete(username); err != nil {
+		return fmt.Errorf("error deleting password history: %v", err)
+	}
+	return nil
+}
+
 func (l *userLifecycle) removeLegacyFinalizers(user *v3.User) (*v3.User, error) {
 	finalizers := user.GetFinalizers()
 	for i, finalizer := range finalizers {
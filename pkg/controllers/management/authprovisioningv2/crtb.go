@@ -39,6 +39,15 @@ func (h *handler) OnCRTB(key string, crtb *v3.ClusterRoleTemplateBinding) (*v3.C
 
 	cluster := clusters[0]
 
+	// A CRTB that isn't active yet or expired grants nothing, the mgmt auth controller enqueues it when that changes.
+	if active, _ := rbac.BindingActive(crtb.NotBefore, crtb.ExpiresAt, time.Now()); !active {
+		return crtb, h.roleBindingApply.
+			WithListerNamespace(cluster.Namespace).
+			WithSetID(CRTBRoleBindingID).
+			WithOwner(crtb).
+			ApplyObjects()
+	}
+
 	rt, err := h.roleTemplatesCache.Get(crtb.RoleTemplateName)
 	if err != nil {
 		return crtb, err
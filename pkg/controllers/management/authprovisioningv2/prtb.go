@@ -47,6 +47,15 @@ func (h *handler) OnPRTB(key string, prtb *v3.ProjectRoleTemplateBinding) (*v3.P
 
 	cluster := clusters[0]
 
+	// A PRTB that isn't active yet or expired grants nothing, the mgmt auth controller enqueues it when that changes.
+	if active, _ := rbac.BindingActive(prtb.NotBefore, prtb.ExpiresAt, time.Now()); !active {
+		return prtb, h.roleBindingApply.
+			WithListerNamespace(cluster.Namespace).
+			WithSetID(PRTBRoleBindingID).
+			WithOwner(prtb).
+			ApplyObjects()
+	}
+
 	err = h.ensureClusterViewBinding(cluster, prtb)
 
 	return prtb, err
@@ -18,6 +18,7 @@
 	"github.com/rancher/norman/types/values"
 	apimgmtv3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	util "github.com/rancher/rancher/pkg/cluster"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup/target"
 	"github.com/rancher/rancher/pkg/controllers/management/imported"
 	kd "github.com/rancher/rancher/pkg/controllers/management/kontainerdrivermetadata"
 	"github.com/rancher/rancher/pkg/controllers/management/secretmigrator"
@@ -64,6 +65,8 @@ type Provisioner struct {
 	RKESystemImagesLister v3.RkeK8sSystemImageLister
 	SecretLister          corev1.SecretLister
 	Secrets               corev1.SecretInterface
+	ctx                   context.Context
+	snapshotNodes         *target.Nodes
 }
 
 func Register(ctx context.Context, management *config.ManagementContext) {
@@ -83,6 +86,8 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		DaemonsetLister:       management.Apps.DaemonSets("").Controller().Lister(),
 		SecretLister:          management.Core.Secrets("").Controller().Lister(),
 		Secrets:               management.Core.Secrets(""),
+		ctx:                   ctx,
+		snapshotNodes:         &target.Nodes{DialerFactory: management.Dialer},
 	}
 	// Add handlers
 	p.Clusters.AddLifecycle(ctx, "cluster-provisioner-controller", p)
@@ -1007,6 +1012,9 @@ func (p *Provisioner) restoreClusterBackup(cluster *apimgmtv3.Cluster, spec apim
 	if backup.Spec.ClusterID != cluster.Name {
 		return "", "", "", fmt.Errorf("snapshot [%s] is not a backup of cluster [%s]", backup.Name, cluster.Name)
 	}
+	if err := p.downloadBackup(cluster, backup); err != nil {
+		return "", "", "", err
+	}
 
 	api, token, cert, err = p.driverRestore(cluster, spec, GetBackupFilename(backup))
 	if err != nil {
@@ -1026,6 +1034,17 @@ func (p *Provisioner) restoreClusterBackup(cluster *apimgmtv3.Cluster, spec apim
 	return api, token, cert, err
 }
 
+// downloadBackup copies the snapshot of a backup from its etcd backup target to the etcd nodes, so that RKE restores it
+// like a local snapshot. Backups without a target are restored by RKE alone.
+func (p *Provisioner) downloadBackup(cluster *apimgmtv3.Cluster, backup *apimgmtv3.EtcdBackup) error {
+	backend, err := target.ForBackup(p.ctx, backup, p.SecretLister)
+	if err != nil || backend == nil {
+		return err
+	}
+	logrus.Infof("Downloading snapshot [%s] of cluster [%s] to its etcd nodes", backup.Name, cluster.Name)
+	return p.snapshotNodes.Download(p.ctx, cluster, backend, path.Base(backup.Spec.Filename))
+}
+
 func GetBackupFilenameFromURL(URL string) (string, error) {
 	if !isValidURL(URL) {
 		return "", fmt.Errorf("URL is not valid: [%s]", URL)
@@ -0,0 +1,112 @@
+package elevationrequest
+
+import (
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/rbac"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Approvers checks that the approver of a request holds the requested role. The controller grants the role as the
+// system user, so an approver could otherwise grant roles they don't hold, like cluster-owner.
+type Approvers struct {
+	CRTBLister         v3.ClusterRoleTemplateBindingLister
+	PRTBLister         v3.ProjectRoleTemplateBindingLister
+	GRBLister          v3.GlobalRoleBindingLister
+	RoleTemplateLister v3.RoleTemplateLister
+}
+
+// HoldsRole returns whether a user may approve a request: admins may approve any request, restricted admins any
+// request outside of the local cluster, and other users only requests for a role template they are bound to in the
+// cluster or project of the request, directly or through the role templates of their bindings. Bindings that are
+// not active yet or expired don't count.
+func (a *Approvers) HoldsRole(request *v32.ElevationRequest, userName string) (bool, error) {
+	clusterName := request.Spec.ObjClusterName()
+	now := time.Now()
+
+	grbs, err := a.GRBLister.List("", labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	for _, grb := range grbs {
+		if grb.UserName != userName {
+			continue
+		}
+		if active, _ := rbac.BindingActive(grb.NotBefore, grb.ExpiresAt, now); !active {
+			continue
+		}
+		if grb.GlobalRoleName == rbac.GlobalAdmin || (grb.GlobalRoleName == rbac.GlobalRestrictedAdmin && clusterName != "local") {
+			return true, nil
+		}
+	}
+
+	var roleTemplates []string
+	if request.Spec.ProjectName != "" {
+		_, projectName := splitBindingName(request.Spec.ProjectName)
+		prtbs, err := a.PRTBLister.List(projectName, labels.Everything())
+		if err != nil {
+			return false, err
+		}
+		for _, prtb := range prtbs {
+			if prtb.UserName != userName || prtb.ProjectName != request.Spec.ProjectName {
+				continue
+			}
+			if active, _ := rbac.BindingActive(prtb.NotBefore, prtb.ExpiresAt, now); active {
+				roleTemplates = append(roleTemplates, prtb.RoleTemplateName)
+			}
+		}
+	} else {
+		crtbs, err := a.CRTBLister.List(clusterName, labels.Everything())
+		if err != nil {
+			return false, err
+		}
+		for _, crtb := range crtbs {
+			if crtb.UserName != userName || crtb.ClusterName != clusterName {
+				continue
+			}
+			if active, _ := rbac.BindingActive(crtb.NotBefore, crtb.ExpiresAt, now); active {
+				roleTemplates = append(roleTemplates, crtb.RoleTemplateName)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	for len(roleTemplates) > 0 {
+		name := roleTemplates[0]
+		roleTemplates = roleTemplates[1:]
+		if name == request.Spec.RoleTemplateName {
+			return true, nil
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		roleTemplate, err := a.RoleTemplateLister.Get("", name)
+		if err != nil {
+			// a binding of a role template that is gone grants nothing
+			continue
+		}
+		roleTemplates = append(roleTemplates, roleTemplate.RoleTemplateNames...)
+	}
+	return false, nil
+}
+
+// approver returns the user whose approval approved a request.
+func approver(request *v32.ElevationRequest) string {
+	for i := len(request.Status.Decisions) - 1; i >= 0; i-- {
+		if request.Status.Decisions[i].Approved {
+			return request.Status.Decisions[i].UserName
+		}
+	}
+	return ""
+}
+
+// scope returns the cluster or project of a request for messages.
+func scope(request *v32.ElevationRequest) string {
+	if request.Spec.ProjectName != "" {
+		return "project " + request.Spec.ProjectName
+	}
+	return "cluster " + request.Spec.ClusterName
+}
@@ -0,0 +1,261 @@
+package elevationrequest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	rbacv1 "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	k8srbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// ElevationRequestLabel is set on the bindings created for approved requests to the name of the request.
+	ElevationRequestLabel = "management.cattle.io/elevation-request"
+	bindingPrefix         = "elevation-"
+	requesterRolePrefix   = "elevationrequest-"
+)
+
+type controller struct {
+	requests           v3.ElevationRequestInterface
+	requestsController v3.ElevationRequestController
+	crtbs              v3.ClusterRoleTemplateBindingInterface
+	prtbs              v3.ProjectRoleTemplateBindingInterface
+	clusterRoles       rbacv1.ClusterRoleInterface
+	crLister           rbacv1.ClusterRoleLister
+	crbs               rbacv1.ClusterRoleBindingInterface
+	crbLister          rbacv1.ClusterRoleBindingLister
+	approvers          *Approvers
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	c := &controller{
+		requests:           management.Management.ElevationRequests(""),
+		requestsController: management.Management.ElevationRequests("").Controller(),
+		crtbs:              management.Management.ClusterRoleTemplateBindings(""),
+		prtbs:              management.Management.ProjectRoleTemplateBindings(""),
+		clusterRoles:       management.RBAC.ClusterRoles(""),
+		crLister:           management.RBAC.ClusterRoles("").Controller().Lister(),
+		crbs:               management.RBAC.ClusterRoleBindings(""),
+		crbLister:          management.RBAC.ClusterRoleBindings("").Controller().Lister(),
+		approvers: &Approvers{
+			CRTBLister:         management.Management.ClusterRoleTemplateBindings("").Controller().Lister(),
+			PRTBLister:         management.Management.ProjectRoleTemplateBindings("").Controller().Lister(),
+			GRBLister:          management.Management.GlobalRoleBindings("").Controller().Lister(),
+			RoleTemplateLister: management.Management.RoleTemplates("").Controller().Lister(),
+		},
+	}
+	c.requestsController.AddHandler(ctx, "elevation-request-controller", c.sync)
+}
+
+func (c *controller) sync(key string, request *v32.ElevationRequest) (runtime.Object, error) {
+	if request == nil || request.DeletionTimestamp != nil {
+		return request, nil
+	}
+
+	if err := c.ensureRequesterAccess(request); err != nil {
+		return request, err
+	}
+
+	// pending and denied requests grant nothing, expired requests were already cleaned up
+	if !v32.ElevationRequestConditionApproved.IsTrue(request) || v32.ElevationRequestConditionExpired.IsTrue(request) {
+		return request, nil
+	}
+
+	updated := request.DeepCopy()
+	if updated.Status.BindingName == "" {
+		approved, err := c.checkApprover(updated)
+		if err != nil {
+			return request, err
+		}
+		if !approved {
+			return c.requests.Update(updated)
+		}
+		if err := c.grant(updated, time.Now()); err != nil {
+			return request, err
+		}
+	}
+
+	if remaining := time.Until(updated.Status.ExpiresAt.Time); remaining > 0 {
+		c.requestsController.EnqueueAfter("", request.Name, remaining)
+	} else if err := c.revoke(updated); err != nil {
+		return request, err
+	}
+
+	if !reflect.DeepEqual(request.Status, updated.Status) {
+		return c.requests.Update(updated)
+	}
+	return request, nil
+}
+
+// checkApprover rejects the approval of a request whose approver doesn't hold the requested role.
+func (c *controller) checkApprover(request *v32.ElevationRequest) (bool, error) {
+	userName := approver(request)
+	if userName != "" {
+		holdsRole, err := c.approvers.HoldsRole(request, userName)
+		if err != nil || holdsRole {
+			return holdsRole, err
+		}
+	}
+
+	logrus.Warnf("[elevation-request] rejected approval of elevation request %s by %q, who does not hold role %s in %s",
+		request.Name, userName, request.Spec.RoleTemplateName, scope(request))
+	v32.ElevationRequestConditionApproved.False(request)
+	v32.ElevationRequestConditionApproved.Reason(request, "Rejected")
+	v32.ElevationRequestConditionApproved.Message(request, fmt.Sprintf("approver %q does not hold role %s in %s",
+		userName, request.Spec.RoleTemplateName, scope(request)))
+	return false, nil
+}
+
+// grant creates the binding of an approved request, its expiry removes the role even if the request controller can't.
+func (c *controller) grant(request *v32.ElevationRequest, now time.Time) error {
+	grantedAt := metav1.NewTime(now)
+	expiresAt := metav1.NewTime(now.Add(time.Duration(request.Spec.DurationMinutes) * time.Minute))
+	objectMeta := metav1.ObjectMeta{
+		Name:            bindingPrefix + request.Name,
+		Labels:          map[string]string{ElevationRequestLabel: request.Name},
+		OwnerReferences: ownerReferences(request),
+	}
+
+	var err error
+	if request.Spec.ProjectName != "" {
+		parts := strings.SplitN(request.Spec.ProjectName, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid project name %s of elevation request %s", request.Spec.ProjectName, request.Name)
+		}
+		objectMeta.Namespace = parts[1]
+		_, err = c.prtbs.Create(&v32.ProjectRoleTemplateBinding{
+			ObjectMeta:       objectMeta,
+			ProjectName:      request.Spec.ProjectName,
+			UserName:         request.Spec.UserName,
+			RoleTemplateName: request.Spec.RoleTemplateName,
+			ExpiresAt:        &expiresAt,
+		})
+	} else {
+		objectMeta.Namespace = request.Spec.ClusterName
+		_, err = c.crtbs.Create(&v32.ClusterRoleTemplateBinding{
+			ObjectMeta:       objectMeta,
+			ClusterName:      request.Spec.ClusterName,
+			UserName:         request.Spec.UserName,
+			RoleTemplateName: request.Spec.RoleTemplateName,
+			ExpiresAt:        &expiresAt,
+		})
+	}
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	logrus.Infof("[elevation-request] granted role %s to user %s for elevation request %s until %s",
+		request.Spec.RoleTemplateName, request.Spec.UserName, request.Name, expiresAt.Format(time.RFC3339))
+	request.Status.BindingName = objectMeta.Namespace + ":" + objectMeta.Name
+	request.Status.GrantedAt = &grantedAt
+	request.Status.ExpiresAt = &expiresAt
+	v32.ElevationRequestConditionGranted.True(request)
+	v32.ElevationRequestConditionExpired.False(request)
+	return nil
+}
+
+// revoke removes the binding of a request at the end of its duration.
+func (c *controller) revoke(request *v32.ElevationRequest) error {
+	ns, name := splitBindingName(request.Status.BindingName)
+	var err error
+	if request.Spec.ProjectName != "" {
+		err = c.prtbs.DeleteNamespaced(ns, name, &metav1.DeleteOptions{})
+	} else {
+		err = c.crtbs.DeleteNamespaced(ns, name, &metav1.DeleteOptions{})
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	logrus.Infof("[elevation-request] revoked role %s from user %s for expired elevation request %s",
+		request.Spec.RoleTemplateName, request.Spec.UserName, request.Name)
+	v32.ElevationRequestConditionGranted.False(request)
+	v32.ElevationRequestConditionGranted.Reason(request, "Expired")
+	v32.ElevationRequestConditionExpired.True(request)
+	return nil
+}
+
+// ensureRequesterAccess lets the requesting user get and withdraw their own request, which users can create but not
+// list in general.
+func (c *controller) ensureRequesterAccess(request *v32.ElevationRequest) error {
+	if request.Spec.UserName == "" {
+		return nil
+	}
+	roleName := requesterRolePrefix + request.Name
+
+	if _, err := c.crLister.Get("", roleName); err == nil {
+		if _, err := c.crbLister.Get("", roleName); err == nil {
+			return nil
+		}
+	}
+
+	_, err := c.clusterRoles.Create(&k8srbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            roleName,
+			OwnerReferences: ownerReferences(request),
+		},
+		Rules: []k8srbacv1.PolicyRule{
+			{
+				APIGroups:     []string{v3.ElevationRequestGroupVersionKind.Group},
+				Resources:     []string{v3.ElevationRequestResource.Name},
+				ResourceNames: []string{request.Name},
+				Verbs:         []string{"get", "delete"},
+			},
+		},
+	})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	_, err = c.crbs.Create(&k8srbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            roleName,
+			OwnerReferences: ownerReferences(request),
+		},
+		RoleRef: k8srbacv1.RoleRef{
+			APIGroup: k8srbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     roleName,
+		},
+		Subjects: []k8srbacv1.Subject{
+			{
+				APIGroup: k8srbacv1.GroupName,
+				Kind:     "User",
+				Name:     request.Spec.UserName,
+			},
+		},
+	})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func ownerReferences(request *v32.ElevationRequest) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			APIVersion: v32.SchemeGroupVersion.String(),
+			Kind:       v3.ElevationRequestGroupVersionKind.Kind,
+			Name:       request.Name,
+			UID:        request.UID,
+		},
+	}
+}
+
+func splitBindingName(bindingName string) (string, string) {
+	parts := strings.SplitN(bindingName, ":", 2)
+	if len(parts) != 2 {
+		return "", bindingName
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,202 @@
+package elevationrequest
+
+import (
+	"testing"
+	"time"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	rbacfakes "github.com/rancher/rancher/pkg/generated/norman/rbac.authorization.k8s.io/v1/fakes"
+	"github.com/stretchr/testify/assert"
+	k8srbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestSync(t *testing.T) {
+	var created []*v32.ClusterRoleTemplateBinding
+	var deleted []string
+	var enqueued time.Duration
+
+	c := &controller{
+		requests: &fakes.ElevationRequestInterfaceMock{
+			UpdateFunc: func(in1 *v32.ElevationRequest) (*v32.ElevationRequest, error) { return in1, nil },
+		},
+		requestsController: &fakes.ElevationRequestControllerMock{
+			EnqueueAfterFunc: func(namespace string, name string, after time.Duration) { enqueued = after },
+		},
+		crtbs: &fakes.ClusterRoleTemplateBindingInterfaceMock{
+			CreateFunc: func(in1 *v32.ClusterRoleTemplateBinding) (*v32.ClusterRoleTemplateBinding, error) {
+				created = append(created, in1)
+				return in1, nil
+			},
+			DeleteNamespacedFunc: func(namespace string, name string, options *metav1.DeleteOptions) error {
+				deleted = append(deleted, namespace+":"+name)
+				return nil
+			},
+		},
+		crLister: &rbacfakes.ClusterRoleListerMock{
+			GetFunc: func(namespace string, name string) (*k8srbacv1.ClusterRole, error) {
+				return &k8srbacv1.ClusterRole{}, nil
+			},
+		},
+		crbLister: &rbacfakes.ClusterRoleBindingListerMock{
+			GetFunc: func(namespace string, name string) (*k8srbacv1.ClusterRoleBinding, error) {
+				return &k8srbacv1.ClusterRoleBinding{}, nil
+			},
+		},
+		approvers: newTestApprovers(),
+	}
+
+	request := &v32.ElevationRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "er-1"},
+		Spec: v32.ElevationRequestSpec{
+			UserName:         "u-1",
+			ClusterName:      "c-1",
+			RoleTemplateName: "cluster-owner",
+			DurationMinutes:  60,
+			Justification:    "incident",
+		},
+	}
+
+	// pending requests grant nothing
+	obj, err := c.sync("er-1", request)
+	assert.NoError(t, err)
+	assert.Empty(t, created)
+
+	// approved requests get a binding that expires with the request
+	v32.ElevationRequestConditionApproved.True(request)
+	request.Status.Decisions = []v32.ElevationDecision{{UserName: "u-owner", Approved: true}}
+	obj, err = c.sync("er-1", request)
+	assert.NoError(t, err)
+	request = obj.(*v32.ElevationRequest)
+	if assert.Len(t, created, 1) {
+		assert.Equal(t, "c-1", created[0].Namespace)
+		assert.Equal(t, "elevation-er-1", created[0].Name)
+		assert.Equal(t, "u-1", created[0].UserName)
+		assert.Equal(t, "cluster-owner", created[0].RoleTemplateName)
+		assert.Equal(t, request.Status.ExpiresAt, created[0].ExpiresAt)
+	}
+	assert.Equal(t, "c-1:elevation-er-1", request.Status.BindingName)
+	assert.True(t, v32.ElevationRequestConditionGranted.IsTrue(request))
+	assert.InDelta(t, time.Hour, enqueued, float64(time.Minute))
+
+	// at the end of the duration the binding is removed
+	request.Status.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Second)}
+	obj, err = c.sync("er-1", request)
+	assert.NoError(t, err)
+	request = obj.(*v32.ElevationRequest)
+	assert.Equal(t, []string{"c-1:elevation-er-1"}, deleted)
+	assert.True(t, v32.ElevationRequestConditionGranted.IsFalse(request))
+	assert.True(t, v32.ElevationRequestConditionExpired.IsTrue(request))
+
+	// expired requests are left alone
+	_, err = c.sync("er-1", request)
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	assert.Len(t, deleted, 1)
+}
+
+func newTestApprovers() *Approvers {
+	expired := &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	return &Approvers{
+		CRTBLister: &fakes.ClusterRoleTemplateBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v32.ClusterRoleTemplateBinding, error) {
+				return []*v32.ClusterRoleTemplateBinding{
+					{ClusterName: "c-1", UserName: "u-owner", RoleTemplateName: "cluster-owner"},
+					{ClusterName: "c-1", UserName: "u-member", RoleTemplateName: "cluster-member"},
+					{ClusterName: "c-1", UserName: "u-expired", RoleTemplateName: "cluster-owner", ExpiresAt: expired},
+				}, nil
+			},
+		},
+		PRTBLister: &fakes.ProjectRoleTemplateBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v32.ProjectRoleTemplateBinding, error) {
+				return []*v32.ProjectRoleTemplateBinding{
+					{ProjectName: "c-1:p-1", UserName: "u-member", RoleTemplateName: "project-owner"},
+					{ProjectName: "c-1:p-1", UserName: "u-expired", RoleTemplateName: "project-owner", ExpiresAt: expired},
+				}, nil
+			},
+		},
+		GRBLister: &fakes.GlobalRoleBindingListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v32.GlobalRoleBinding, error) {
+				return []*v32.GlobalRoleBinding{
+					{UserName: "u-admin", GlobalRoleName: "admin"},
+					{UserName: "u-restricted", GlobalRoleName: "restricted-admin"},
+					{UserName: "u-expired", GlobalRoleName: "admin", ExpiresAt: expired},
+				}, nil
+			},
+		},
+		RoleTemplateLister: &fakes.RoleTemplateListerMock{
+			GetFunc: func(namespace string, name string) (*v32.RoleTemplate, error) {
+				if name == "project-owner" {
+					return &v32.RoleTemplate{RoleTemplateNames: []string{"project-member"}}, nil
+				}
+				return &v32.RoleTemplate{}, nil
+			},
+		},
+	}
+}
+
+func TestSyncRejectsApprover(t *testing.T) {
+	var updated *v32.ElevationRequest
+	c := &controller{
+		requests: &fakes.ElevationRequestInterfaceMock{
+			UpdateFunc: func(in1 *v32.ElevationRequest) (*v32.ElevationRequest, error) {
+				updated = in1
+				return in1, nil
+			},
+		},
+		crtbs: &fakes.ClusterRoleTemplateBindingInterfaceMock{
+			CreateFunc: func(in1 *v32.ClusterRoleTemplateBinding) (*v32.ClusterRoleTemplateBinding, error) {
+				t.Fatal("the role of a rejected approval is granted")
+				return in1, nil
+			},
+		},
+		approvers: newTestApprovers(),
+	}
+
+	// a cluster member can't grant cluster-owner
+	request := &v32.ElevationRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "er-1"},
+		Spec:       v32.ElevationRequestSpec{ClusterName: "c-1", RoleTemplateName: "cluster-owner", DurationMinutes: 60},
+		Status:     v32.ElevationRequestStatus{Decisions: []v32.ElevationDecision{{UserName: "u-member", Approved: true}}},
+	}
+	v32.ElevationRequestConditionApproved.True(request)
+	_, err := c.sync("er-1", request)
+	assert.NoError(t, err)
+	if assert.NotNil(t, updated) {
+		assert.True(t, v32.ElevationRequestConditionApproved.IsFalse(updated))
+		assert.Equal(t, "Rejected", v32.ElevationRequestConditionApproved.GetReason(updated))
+		assert.Equal(t, `approver "u-member" does not hold role cluster-owner in cluster c-1`, v32.ElevationRequestConditionApproved.GetMessage(updated))
+	}
+}
+
+func TestApproversHoldsRole(t *testing.T) {
+	approvers := newTestApprovers()
+	clusterRequest := &v32.ElevationRequest{Spec: v32.ElevationRequestSpec{ClusterName: "c-1", RoleTemplateName: "cluster-owner"}}
+	projectRequest := &v32.ElevationRequest{Spec: v32.ElevationRequestSpec{ProjectName: "c-1:p-1", RoleTemplateName: "project-member"}}
+	localRequest := &v32.ElevationRequest{Spec: v32.ElevationRequestSpec{ClusterName: "local", RoleTemplateName: "cluster-owner"}}
+
+	tests := []struct {
+		request  *v32.ElevationRequest
+		userName string
+		holds    bool
+	}{
+		{clusterRequest, "u-owner", true},
+		{clusterRequest, "u-member", false},
+		{clusterRequest, "u-admin", true},
+		{clusterRequest, "u-restricted", true},
+		{clusterRequest, "u-other", false},
+		{clusterRequest, "u-expired", false},
+		{projectRequest, "u-member", true},
+		{projectRequest, "u-owner", false},
+		{projectRequest, "u-expired", false},
+		{localRequest, "u-admin", true},
+		{localRequest, "u-restricted", false},
+	}
+	for _, test := range tests {
+		holds, err := approvers.HoldsRole(test.request, test.userName)
+		assert.NoError(t, err)
+		assert.Equal(t, test.holds, holds, "%s approving %s", test.userName, test.request.Spec.RoleTemplateName)
+	}
+}
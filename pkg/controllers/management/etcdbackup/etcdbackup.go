@@ -11,6 +11,7 @@
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"sort"
 	"strings"
 	"time"
@@ -19,7 +20,9 @@
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup/target"
 	"github.com/rancher/rancher/pkg/controllers/management/secretmigrator/assemblers"
+	"github.com/rancher/rancher/pkg/etcdretention"
 	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/kontainer-engine/drivers/rke"
@@ -41,6 +44,8 @@
 	clusterBackupCheckInterval = 5 * time.Minute
 	compressedExtension        = "zip"
 	s3Endpoint                 = "s3.amazonaws.com"
+	// PinnedAnnotation set to "true" excludes a backup from rotation, it is kept until it is deleted.
+	PinnedAnnotation = "etcdbackup.cattle.io/pinned"
 )
 
 type Controller struct {
@@ -52,6 +57,7 @@ type Controller struct {
 	backupDriver          *service.EngineService
 	KontainerDriverLister v3.KontainerDriverLister
 	secretLister          v1.SecretLister
+	snapshotNodes         *target.Nodes
 }
 
 func Register(ctx context.Context, management *config.ManagementContext) {
@@ -64,6 +70,7 @@ func Register(ctx context.Context, management *config.ManagementContext) {
 		backupDriver:          service.NewEngineService(clusterprovisioner.NewPersistentStore(management.Core.Namespaces(""), management.Core)),
 		secretLister:          management.Core.Secrets("").Controller().Lister(),
 		KontainerDriverLister: management.Management.KontainerDrivers("").Controller().Lister(),
+		snapshotNodes:         &target.Nodes{DialerFactory: management.Dialer},
 	}
 
 	local := &rkedialerfactory.RKEDialerFactory{
@@ -267,10 +274,18 @@ func IsBackupRecurring(backup *v3.EtcdBackup) bool {
 	return !backup.Spec.Manual
 }
 
+func IsBackupUnpinned(backup *v3.EtcdBackup) bool {
+	return backup.Annotations[PinnedAnnotation] != "true"
+}
+
 func (c *Controller) createBackupForCluster(b *v3.EtcdBackup, cluster *v3.Cluster) (*v3.EtcdBackup, error) {
 	var err error
 	if b.DeletionTimestamp != nil || rketypes.BackupConditionCreated.IsUnknown(b) {
-		b.Spec.Filename = generateBackupFilename(b.Name, cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig)
+		backupTarget, err := target.Get(b)
+		if err != nil {
+			return b, err
+		}
+		b.Spec.Filename = generateBackupFilename(b.Name, cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig, backupTarget)
 		b.Spec.BackupConfig = *cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig
 		rketypes.BackupConditionCreated.True(b)
 		// we set ConditionCompleted to Unknown to avoid incorrect "active" state
@@ -293,7 +308,8 @@ func (c *Controller) createBackupForCluster(b *v3.EtcdBackup, cluster *v3.Cluste
 		return b, saveErr
 	}
 
-	if !b.Spec.Manual {
+	// a retention policy rotates manual backups too
+	if !b.Spec.Manual || retentionPolicy(cluster).Enabled() {
 		_ = c.rotateSuccessfulBackups(cluster)
 	}
 	return b, nil
@@ -378,8 +394,19 @@ func (c *Controller) etcdSave(b *v3.EtcdBackup) (*v3.EtcdBackup, error) {
 		// no need to retry, RKE will retry for us
 		if err = c.backupDriver.ETCDSave(c.ctx, cluster.Name, kontainerDriver, spec, snapshotName); err != nil {
 			log.Warnf("%v", err)
+			return b, err
 		}
-		return b, err
+		// RKE took the snapshot locally, upload it to the target of the backup
+		backend, err := c.backend(b)
+		if err != nil || backend == nil {
+			return b, err
+		}
+		if err = c.snapshotNodes.Upload(c.ctx, cluster, backend, path.Base(b.Spec.Filename)); err != nil {
+			log.Warnf("[etcd-backup] %v", err)
+			return b, err
+		}
+		target.SetDigest(b, backend.SHA256)
+		return b, nil
 	})
 	b = bObj.(*v3.EtcdBackup)
 	if err != nil {
@@ -406,21 +433,71 @@ func (c *Controller) etcdRemoveSnapshotWithBackoff(b *v3.EtcdBackup) error {
 	if err != nil {
 		return err
 	}
+	backend, err := c.backend(b)
+	if err != nil {
+		return err
+	}
 	snapshotName := clusterprovisioner.GetBackupFilename(b)
 	return wait.ExponentialBackoff(backoff, func() (bool, error) {
 		if inErr := c.backupDriver.ETCDRemoveSnapshot(c.ctx, cluster.Name, kontainerDriver, spec, snapshotName); inErr != nil {
 			log.Warnf("%v", inErr)
 			return false, nil
 		}
+		// RKE removed the local snapshots, the copy in the target of the backup is left
+		if backend != nil {
+			if inErr := backend.Delete(c.ctx, path.Base(b.Spec.Filename)); inErr != nil {
+				log.Warnf("[etcd-backup] failed to delete snapshot of backup [%s] from its target: %v", b.Name, inErr)
+				return false, nil
+			}
+		}
 		return true, nil
 	})
 }
 
+// backend returns the backend of the target of a backup, or nil if RKE stores the snapshot of the backup by itself.
+func (c *Controller) backend(b *v3.EtcdBackup) (*target.Checksum, error) {
+	return target.ForBackup(c.ctx, b, c.secretLister)
+}
+
 func (c *Controller) rotateSuccessfulBackups(cluster *v3.Cluster) error {
+	if policy := retentionPolicy(cluster); policy.Enabled() {
+		log.Infof("[etcd-backup] Rotating successful backups with retention policy %+v", policy)
+		return c.rotateBackupsWithPolicy(cluster, policy)
+	}
 	log.Infof("[etcd-backup] Rotating successful recurring backups")
 	return c.rotateBackups(cluster, IsBackupCompleted)
 }
 
+// retentionPolicy returns the retention policy of a cluster, which isn't enabled if the cluster rotates its backups by
+// retention count.
+func retentionPolicy(cluster *v3.Cluster) etcdretention.Policy {
+	if cluster.Spec.EtcdBackupRetentionPolicy == nil {
+		return etcdretention.Policy{}
+	}
+	return etcdretention.Policy(*cluster.Spec.EtcdBackupRetentionPolicy)
+}
+
+// rotateBackupsWithPolicy removes the successful backups that the retention policy doesn't keep, manual or recurring.
+// Pinned backups are kept and don't take the place of other backups in the policy.
+func (c *Controller) rotateBackupsWithPolicy(cluster *v3.Cluster, policy etcdretention.Policy) error {
+	backups, err := c.getBackupsList(cluster)
+	if err != nil {
+		return err
+	}
+	backups = filterBackups(backups, IsBackupCompleted, IsBackupUnpinned)
+	created := make([]time.Time, len(backups))
+	for i, backup := range backups {
+		created[i] = getBackupCreatedTime(backup)
+	}
+	var remove []*v3.EtcdBackup
+	for i, keep := range etcdretention.Keep(policy, created) {
+		if !keep {
+			remove = append(remove, backups[i])
+		}
+	}
+	return c.removeBackups(remove)
+}
+
 func IsBackupCompleted(backup *v3.EtcdBackup) bool {
 	return rketypes.BackupConditionCompleted.IsTrue(backup)
 }
@@ -440,7 +517,7 @@ func (c *Controller) rotateBackups(cluster *v3.Cluster, filter FilterFunc) error
 	if err != nil {
 		return err
 	}
-	backups = filterBackups(backups, IsBackupRecurring, filter)
+	backups = filterBackups(backups, IsBackupRecurring, IsBackupUnpinned, filter)
 	if len(backups) <= retention {
 		return nil
 	}
@@ -469,8 +546,15 @@ func NewBackupObject(cluster *v3.Cluster, manual bool) (*v3.EtcdBackup, error) {
 	if manual {
 		typeFlag = "m" // manual backup
 	}
+	backupTarget := cluster.Spec.EtcdBackupTarget
 	if cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.S3BackupConfig != nil {
 		providerFlag = "s" // s3 backup
+		backupTarget = nil
+	} else if backupTarget != nil {
+		if err := target.Validate(backupTarget); err != nil {
+			return nil, err
+		}
+		providerFlag = target.ProviderFlag(backupTarget) // azure blob or gcs backup
 	}
 	prefix := fmt.Sprintf("%s-%s%s-", cluster.Name, typeFlag, providerFlag)
 
@@ -479,7 +563,7 @@ func NewBackupObject(cluster *v3.Cluster, manual bool) (*v3.EtcdBackup, error) {
 		return nil, err
 	}
 
-	return &v3.EtcdBackup{
+	backup := &v3.EtcdBackup{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    cluster.Name,
 			GenerateName: prefix,
@@ -501,7 +585,13 @@ func NewBackupObject(cluster *v3.Cluster, manual bool) (*v3.EtcdBackup, error) {
 			KubernetesVersion: cluster.Spec.RancherKubernetesEngineConfig.Version,
 			ClusterObject:     compressedCluster,
 		},
-	}, nil
+	}
+	if backupTarget != nil {
+		if err := target.Set(backup, backupTarget); err != nil {
+			return nil, err
+		}
+	}
+	return backup, nil
 }
 
 func CompressCluster(cluster *v3.Cluster) (string, error) {
@@ -555,7 +645,7 @@ func DecompressCluster(cluster string) (*v3.Cluster, error) {
 	return &c, nil
 }
 
-func generateBackupFilename(snapshotName string, backupConfig *rketypes.BackupConfig) string {
+func generateBackupFilename(snapshotName string, backupConfig *rketypes.BackupConfig, backupTarget *v32.EtcdBackupTarget) string {
 	// no backup config
 	if backupConfig == nil {
 		return ""
@@ -572,6 +662,10 @@ func generateBackupFilename(snapshotName string, backupConfig *rketypes.BackupCo
 		}
 		return fmt.Sprintf("https://%s/%s/%s", backupConfig.S3BackupConfig.Endpoint, backupConfig.S3BackupConfig.BucketName, filename)
 	}
+	// azure blob or gcs backup
+	if backupTarget != nil {
+		return target.URL(backupTarget, filename)
+	}
 	// local backup
 	return filename
 
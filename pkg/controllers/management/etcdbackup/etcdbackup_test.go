@@ -1,11 +1,19 @@
 package etcdbackup
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/controllers/management/clusterprovisioner"
+	"github.com/rancher/rancher/pkg/controllers/management/etcdbackup/target"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
 	rketypes "github.com/rancher/rke/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func Test_filterBackups(t *testing.T) {
@@ -78,3 +86,130 @@ func Test_filterBackups(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBackupObjectWithTarget(t *testing.T) {
+	cluster := &v3.Cluster{}
+	cluster.Name = "c-abcde"
+	cluster.Spec.RancherKubernetesEngineConfig = &rketypes.RancherKubernetesEngineConfig{
+		Services: rketypes.RKEConfigServices{
+			Etcd: rketypes.ETCDService{
+				BackupConfig: &rketypes.BackupConfig{SafeTimestamp: true},
+			},
+		},
+	}
+	cluster.Spec.EtcdBackupTarget = &v3.EtcdBackupTarget{
+		AzureBlob: &v3.AzureBlobBackupConfig{
+			AccountName:         "account",
+			ContainerName:       "snapshots",
+			Folder:              "rke",
+			CloudCredentialName: "cattle-global-data:cc-abcde",
+		},
+	}
+
+	backup, err := NewBackupObject(cluster, true)
+	require.NoError(t, err)
+	assert.Equal(t, "c-abcde-ma-", backup.GenerateName)
+	backupTarget, err := target.Get(backup)
+	require.NoError(t, err)
+	assert.Equal(t, cluster.Spec.EtcdBackupTarget, backupTarget)
+
+	backup.Name = "c-abcde-ma-xyz12"
+	backup.Spec.Filename = generateBackupFilename(backup.Name, cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig, backupTarget)
+	assert.True(t, strings.HasPrefix(backup.Spec.Filename, "https://account.blob.core.windows.net/snapshots/rke/c-abcde-ma-xyz12_"), backup.Spec.Filename)
+	assert.True(t, strings.HasPrefix(clusterprovisioner.GetBackupFilename(backup), "c-abcde-ma-xyz12_"))
+	assert.False(t, strings.HasSuffix(clusterprovisioner.GetBackupFilename(backup), ".zip"))
+
+	// snapshots in an encrypted S3 target are uploaded by Rancher
+	cluster.Spec.EtcdBackupTarget = &v3.EtcdBackupTarget{
+		S3: &v3.S3BackupTargetConfig{
+			BucketName:          "bucket",
+			Endpoint:            "minio.example.com:9000",
+			CloudCredentialName: "cc-abcde",
+		},
+		Encryption: &v3.EtcdBackupEncryption{KeySecretName: "etcd-backup-key"},
+	}
+	backup, err = NewBackupObject(cluster, false)
+	require.NoError(t, err)
+	assert.Equal(t, "c-abcde-rs-", backup.GenerateName)
+	backupTarget, err = target.Get(backup)
+	require.NoError(t, err)
+	assert.Equal(t, cluster.Spec.EtcdBackupTarget, backupTarget)
+	backup.Spec.Filename = generateBackupFilename("c-abcde-rs-xyz12", cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig, backupTarget)
+	assert.True(t, strings.HasPrefix(backup.Spec.Filename, "https://minio.example.com:9000/bucket/c-abcde-rs-xyz12_"), backup.Spec.Filename)
+
+	cluster.Spec.EtcdBackupTarget.Encryption.KeySecretName = ""
+	_, err = NewBackupObject(cluster, false)
+	assert.Error(t, err, "encryption requires a key secret")
+
+	// the S3 backup config takes precedence, RKE uploads those snapshots itself
+	cluster.Spec.RancherKubernetesEngineConfig.Services.Etcd.BackupConfig.S3BackupConfig = &rketypes.S3BackupConfig{BucketName: "bucket"}
+	backup, err = NewBackupObject(cluster, false)
+	require.NoError(t, err)
+	assert.Equal(t, "c-abcde-rs-", backup.GenerateName)
+	backupTarget, err = target.Get(backup)
+	require.NoError(t, err)
+	assert.Nil(t, backupTarget)
+}
+
+func TestRotateSuccessfulBackupsWithPolicy(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 30, 0, 0, time.UTC)
+	newBackup := func(name string, created time.Time, manual bool) *v3.EtcdBackup {
+		backup := &v3.EtcdBackup{}
+		backup.Namespace = "c-abcde"
+		backup.Name = name
+		backup.Spec.Manual = manual
+		rketypes.BackupConditionCreated.True(backup)
+		rketypes.BackupConditionCreated.LastUpdated(backup, created.Format(time.RFC3339))
+		rketypes.BackupConditionCompleted.True(backup)
+		return backup
+	}
+	pinned := newBackup("pinned", now.AddDate(0, 0, -30), true)
+	pinned.Annotations = map[string]string{PinnedAnnotation: "true"}
+	failed := newBackup("failed", now.AddDate(0, 0, -20), false)
+	rketypes.BackupConditionCompleted.False(failed)
+	backups := []*v3.EtcdBackup{
+		newBackup("today-1", now, false),
+		newBackup("today-2", now.Add(-time.Hour), true),
+		newBackup("today-3", now.Add(-2*time.Hour), false),
+		newBackup("yesterday-1", now.AddDate(0, 0, -1), false),
+		newBackup("yesterday-2", now.AddDate(0, 0, -1).Add(-time.Hour), false),
+		newBackup("last-week", now.AddDate(0, 0, -7), false),
+		pinned,
+		failed,
+	}
+
+	var deleted []string
+	c := &Controller{
+		backupLister: &fakes.EtcdBackupListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v3.EtcdBackup, error) {
+				return backups, nil
+			},
+		},
+		backupClient: &fakes.EtcdBackupInterfaceMock{
+			DeleteNamespacedFunc: func(namespace string, name string, options *metav1.DeleteOptions) error {
+				deleted = append(deleted, name)
+				return nil
+			},
+		},
+	}
+	cluster := &v3.Cluster{}
+	cluster.Name = "c-abcde"
+	cluster.Spec.RancherKubernetesEngineConfig = &rketypes.RancherKubernetesEngineConfig{
+		Services: rketypes.RKEConfigServices{
+			Etcd: rketypes.ETCDService{
+				BackupConfig: &rketypes.BackupConfig{Retention: 1},
+			},
+		},
+	}
+	cluster.Spec.EtcdBackupRetentionPolicy = &v3.EtcdBackupRetentionPolicy{Hourly: 2, Daily: 2}
+
+	require.NoError(t, c.rotateSuccessfulBackups(cluster))
+	assert.ElementsMatch(t, []string{"today-3", "yesterday-2", "last-week"}, deleted,
+		"manual backups are rotated too, pinned and failed backups aren't")
+
+	// without a policy only recurring backups are rotated by count
+	deleted = nil
+	cluster.Spec.EtcdBackupRetentionPolicy = &v3.EtcdBackupRetentionPolicy{}
+	require.NoError(t, c.rotateSuccessfulBackups(cluster))
+	assert.ElementsMatch(t, []string{"today-3", "yesterday-1", "yesterday-2", "last-week"}, deleted)
+}
@@ -0,0 +1,202 @@
+package target
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+const (
+	azureAPIVersion = "2020-10-02"
+	// azureStorageResource is the resource tokens for Azure Storage are issued for, in every cloud.
+	azureStorageResource = "https://storage.azure.com/"
+)
+
+// azureBlob stores snapshots as block blobs through the REST API of Azure Blob Storage.
+type azureBlob struct {
+	containerURL string
+	folder       string
+	client       *http.Client
+	token        func(ctx context.Context) (string, error)
+}
+
+// azureContainerURL is the URL of the container of a config. The endpoint of a config is the URL of the blob service
+// of the storage account, such as http://127.0.0.1:10000/devstoreaccount1 for Azurite.
+func azureContainerURL(config *v3.AzureBlobBackupConfig) string {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.%s", config.AccountName, azure.PublicCloud.StorageEndpointSuffix)
+	} else if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/" + config.ContainerName
+}
+
+func newAzureBlob(config *v3.AzureBlobBackupConfig, credential map[string]string) (*azureBlob, error) {
+	env := azure.PublicCloud
+	if credential["environment"] != "" {
+		var err error
+		if env, err = azure.EnvironmentFromName(credential["environment"]); err != nil {
+			return nil, err
+		}
+	}
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, credential["tenantId"])
+	if err != nil {
+		return nil, err
+	}
+	spt, err := adal.NewServicePrincipalToken(*oauthConfig, credential["clientId"], credential["clientSecret"], azureStorageResource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureBlob{
+		containerURL: azureContainerURL(config),
+		folder:       config.Folder,
+		client:       http.DefaultClient,
+		token: func(ctx context.Context) (string, error) {
+			if err := spt.EnsureFreshWithContext(ctx); err != nil {
+				return "", err
+			}
+			return spt.OAuthToken(), nil
+		},
+	}, nil
+}
+
+func (a *azureBlob) blobURL(filename string) string {
+	return a.containerURL + "/" + (&url.URL{Path: objectName(a.folder, filename)}).EscapedPath()
+}
+
+func (a *azureBlob) do(ctx context.Context, method, u string, body io.Reader, size int64, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	token, err := a.token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		return resp, &azureError{
+			StatusCode: resp.StatusCode,
+			Code:       resp.Header.Get("x-ms-error-code"),
+			Message:    strings.TrimSpace(string(message)),
+		}
+	}
+	return resp, nil
+}
+
+type azureError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *azureError) Error() string {
+	return fmt.Sprintf("Azure Blob Storage request failed with status %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+func (a *azureBlob) Upload(ctx context.Context, filename string, r io.Reader, size int64) error {
+	resp, err := a.do(ctx, http.MethodPut, a.blobURL(filename), r, size, http.Header{
+		"X-Ms-Blob-Type": []string{"BlockBlob"},
+		"Content-Type":   []string{"application/zip"},
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (a *azureBlob) Download(ctx context.Context, filename string, w io.Writer) error {
+	resp, err := a.do(ctx, http.MethodGet, a.blobURL(filename), nil, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+type azureBlobList struct {
+	Blobs      []string `xml:"Blobs>Blob>Name"`
+	NextMarker string   `xml:"NextMarker"`
+}
+
+func (a *azureBlob) List(ctx context.Context) ([]string, error) {
+	prefix := objectName(a.folder, "")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var (
+		result []string
+		marker string
+	)
+	for {
+		query := url.Values{
+			"restype": []string{"container"},
+			"comp":    []string{"list"},
+		}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := a.do(ctx, http.MethodGet, a.containerURL+"?"+query.Encode(), nil, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		list := azureBlobList{}
+		err = xml.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range list.Blobs {
+			name = strings.TrimPrefix(name, prefix)
+			if !strings.Contains(name, "/") {
+				result = append(result, name)
+			}
+		}
+		if list.NextMarker == "" {
+			return result, nil
+		}
+		marker = list.NextMarker
+	}
+}
+
+func (a *azureBlob) Delete(ctx context.Context, filename string) error {
+	resp, err := a.do(ctx, http.MethodDelete, a.blobURL(filename), nil, 0, nil)
+	if e, ok := err.(*azureError); ok && e.StatusCode == http.StatusNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,159 @@
+package target
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBlobService serves the container "snapshots" of the account "devstoreaccount1" with path style URLs, like
+// Azurite does. It pages blob lists by two blobs.
+type fakeBlobService struct {
+	blobs map[string][]byte
+}
+
+func (f *fakeBlobService) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Bearer secret-token" || req.Header.Get("x-ms-version") == "" {
+		rw.Header().Set("x-ms-error-code", "AuthenticationFailed")
+		rw.WriteHeader(http.StatusForbidden)
+		return
+	}
+	name := strings.TrimPrefix(req.URL.Path, "/devstoreaccount1/snapshots")
+	if name == req.URL.Path {
+		rw.Header().Set("x-ms-error-code", "ContainerNotFound")
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name = strings.TrimPrefix(name, "/")
+
+	switch {
+	case req.Method == http.MethodGet && name == "" && req.URL.Query().Get("comp") == "list":
+		var names []string
+		for blob := range f.blobs {
+			if strings.HasPrefix(blob, req.URL.Query().Get("prefix")) && blob > req.URL.Query().Get("marker") {
+				names = append(names, blob)
+			}
+		}
+		sort.Strings(names)
+		fmt.Fprint(rw, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		for i, blob := range names {
+			if i == 2 {
+				fmt.Fprintf(rw, `</Blobs><NextMarker>%s</NextMarker></EnumerationResults>`, names[1])
+				return
+			}
+			fmt.Fprint(rw, `<Blob><Name>`)
+			xml.EscapeText(rw, []byte(blob))
+			fmt.Fprint(rw, `</Name><Properties/></Blob>`)
+		}
+		fmt.Fprint(rw, `</Blobs><NextMarker/></EnumerationResults>`)
+	case req.Method == http.MethodPut:
+		if req.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := ioutil.ReadAll(req.Body)
+		f.blobs[name] = data
+		rw.WriteHeader(http.StatusCreated)
+	case req.Method == http.MethodGet:
+		data, ok := f.blobs[name]
+		if !ok {
+			rw.Header().Set("x-ms-error-code", "BlobNotFound")
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write(data)
+	case req.Method == http.MethodDelete:
+		if _, ok := f.blobs[name]; !ok {
+			rw.Header().Set("x-ms-error-code", "BlobNotFound")
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.blobs, name)
+		rw.WriteHeader(http.StatusAccepted)
+	default:
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newFakeAzureBlob(t *testing.T, folder string) (*fakeBlobService, *azureBlob) {
+	service := &fakeBlobService{blobs: map[string][]byte{
+		"other/c-abcde-rl-old_2022-10-01T00:00:00Z.zip": []byte("other"),
+	}}
+	server := httptest.NewServer(service)
+	t.Cleanup(server.Close)
+
+	return service, &azureBlob{
+		containerURL: azureContainerURL(&v3.AzureBlobBackupConfig{
+			AccountName:   "devstoreaccount1",
+			ContainerName: "snapshots",
+			Endpoint:      server.URL + "/devstoreaccount1",
+		}),
+		folder: folder,
+		client: server.Client(),
+		token: func(ctx context.Context) (string, error) {
+			return "secret-token", nil
+		},
+	}
+}
+
+func TestAzureBlob(t *testing.T) {
+	ctx := context.Background()
+	service, backend := newFakeAzureBlob(t, "rke/c-abcde")
+
+	filenames := []string{
+		"c-abcde-rl-aaaaa_2022-10-01T00:00:00Z.zip",
+		"c-abcde-rl-bbbbb_2022-10-01T12:00:00Z.zip",
+		"c-abcde-ma-ccccc_2022-10-02T00:00:00Z.zip",
+	}
+	for _, filename := range filenames {
+		require.NoError(t, backend.Upload(ctx, filename, strings.NewReader(filename), int64(len(filename))))
+	}
+	assert.Equal(t, []byte(filenames[0]), service.blobs["rke/c-abcde/"+filenames[0]])
+
+	list, err := backend.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, filenames, list, "every page is listed and blobs outside of the folder are not")
+
+	var downloaded strings.Builder
+	require.NoError(t, backend.Download(ctx, filenames[1], &downloaded))
+	assert.Equal(t, filenames[1], downloaded.String())
+
+	require.NoError(t, backend.Delete(ctx, filenames[1]))
+	require.NoError(t, backend.Delete(ctx, filenames[1]), "deleting a blob that doesn't exist succeeds")
+	list, err = backend.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{filenames[0], filenames[2]}, list)
+
+	err = backend.Download(ctx, filenames[1], &downloaded)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "BlobNotFound")
+
+	backend.token = func(ctx context.Context) (string, error) {
+		return "wrong-token", nil
+	}
+	_, err = backend.List(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AuthenticationFailed")
+}
+
+func TestAzureContainerURL(t *testing.T) {
+	assert.Equal(t, "https://account.blob.core.windows.net/snapshots", azureContainerURL(&v3.AzureBlobBackupConfig{
+		AccountName:   "account",
+		ContainerName: "snapshots",
+	}))
+	assert.Equal(t, "https://account.blob.core.usgovcloudapi.net/snapshots", azureContainerURL(&v3.AzureBlobBackupConfig{
+		AccountName:   "account",
+		ContainerName: "snapshots",
+		Endpoint:      "account.blob.core.usgovcloudapi.net/",
+	}))
+}
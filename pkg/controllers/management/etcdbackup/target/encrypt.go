@@ -0,0 +1,294 @@
+package target
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// currentKey is the field of the key secret with the key new snapshots are encrypted with.
+	currentKey = "key"
+	keySize    = 32
+	chunkSize  = 64 * 1024
+)
+
+// magic starts every encrypted snapshot, the version of the format is part of it.
+var magic = []byte("RKESNAP1")
+
+// Keys wraps the data keys snapshots are encrypted with. It's implemented by the key secret of a target, and can be
+// implemented by a KMS.
+type Keys interface {
+	// Wrap encrypts a data key with the current key and returns the ID of that key.
+	Wrap(dataKey []byte) (keyID string, wrapped []byte, err error)
+	// Unwrap decrypts a data key with the key of the ID.
+	Unwrap(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// secretKeys are the AES-256 keys of a key secret, by key ID.
+type secretKeys struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// SecretKeys returns the keys of a key secret. Keys are either 32 raw bytes or 32 base64 encoded bytes, like the
+// output of "openssl rand -base64 32". The secret must have a current key, fields that aren't keys are ignored.
+func SecretKeys(secret *corev1.Secret) (Keys, error) {
+	result := &secretKeys{keys: map[string]cipher.AEAD{}}
+	for field, value := range secret.Data {
+		key := parseKey(value)
+		if key == nil {
+			continue
+		}
+		id := keyID(key)
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		result.keys[id] = aead
+		if field == currentKey {
+			result.current = id
+		}
+	}
+	if result.current == "" {
+		return nil, fmt.Errorf("secret [%s/%s] has no %d byte encryption key in field [%s]", secret.Namespace, secret.Name, keySize, currentKey)
+	}
+	return result, nil
+}
+
+func (s *secretKeys) Wrap(dataKey []byte) (string, []byte, error) {
+	aead := s.keys[s.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return s.current, aead.Seal(nonce, nonce, dataKey, []byte(s.current)), nil
+}
+
+func (s *secretKeys) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("snapshot was encrypted with key [%s], which isn't in the key secret", keyID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("invalid data key of snapshot")
+	}
+	dataKey, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key of snapshot with key [%s]: %w", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func parseKey(value []byte) []byte {
+	if len(value) == keySize {
+		return value
+	}
+	if key, err := base64.StdEncoding.DecodeString(string(value)); err == nil && len(key) == keySize {
+		return key
+	}
+	return nil
+}
+
+// keyID identifies a key without revealing it, so that snapshots record which key their data key is wrapped with.
+func keyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// envelope is the header of an encrypted snapshot.
+type envelope struct {
+	KeyID      string `json:"keyId"`
+	WrappedKey []byte `json:"wrappedKey"`
+	// NoncePrefix starts the nonce of every chunk, the nonce ends with the index of the chunk and whether it's the
+	// last one, so that chunks can't be reordered or dropped.
+	NoncePrefix []byte `json:"noncePrefix"`
+	ChunkSize   int    `json:"chunkSize"`
+}
+
+// encrypted encrypts the snapshots uploaded to a backend with a data key of their own. The snapshot is encrypted in
+// chunks with AES-256-GCM, so that it's streamed and any change to it fails the download.
+type encrypted struct {
+	Backend
+	keys Keys
+}
+
+// Encrypted returns a backend that encrypts the snapshots uploaded to another backend and decrypts them on download.
+func Encrypted(backend Backend, keys Keys) Backend {
+	return &encrypted{Backend: backend, keys: keys}
+}
+
+func (e *encrypted) Upload(ctx context.Context, filename string, r io.Reader, size int64) error {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return err
+	}
+	header := envelope{
+		NoncePrefix: make([]byte, aead.NonceSize()-5),
+		ChunkSize:   chunkSize,
+	}
+	if _, err := rand.Read(header.NoncePrefix); err != nil {
+		return err
+	}
+	if header.KeyID, header.WrappedKey, err = e.keys.Wrap(dataKey); err != nil {
+		return err
+	}
+	prefix, err := envelopePrefix(&header)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(seal(pw, r, aead, &header, prefix))
+	}()
+	defer pr.Close()
+	// every chunk grows by the GCM tag, the last chunk is shorter than the others and may be empty
+	chunks := size/chunkSize + 1
+	return e.Backend.Upload(ctx, filename, pr, int64(len(prefix))+size+chunks*int64(aead.Overhead()))
+}
+
+func (e *encrypted) Download(ctx context.Context, filename string, w io.Writer) error {
+	pr, pw := io.Pipe()
+	result := make(chan error, 1)
+	go func() {
+		err := e.Backend.Download(ctx, filename, pw)
+		pw.CloseWithError(err)
+		result <- err
+	}()
+	openErr := e.open(w, pr)
+	pr.CloseWithError(openErr)
+	if err := <-result; err != nil && !errors.Is(err, openErr) {
+		return err
+	}
+	return openErr
+}
+
+func (e *encrypted) open(w io.Writer, r io.Reader) error {
+	header, prefix, err := readEnvelope(r)
+	if err != nil {
+		return err
+	}
+	dataKey, err := e.keys.Unwrap(header.KeyID, header.WrappedKey)
+	if err != nil {
+		return err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return err
+	}
+	if header.ChunkSize <= 0 || len(header.NoncePrefix) != aead.NonceSize()-5 {
+		return fmt.Errorf("invalid header of encrypted snapshot")
+	}
+
+	buf := make([]byte, header.ChunkSize+aead.Overhead())
+	for i := uint32(0); ; i++ {
+		n, err := io.ReadFull(r, buf)
+		last := err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			if err == io.EOF {
+				return fmt.Errorf("encrypted snapshot is truncated")
+			}
+			return err
+		}
+		plain, err := aead.Open(buf[:0], nonce(header, i, last), buf[:n], prefix)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt snapshot, it was changed or is corrupt")
+		}
+		if _, err := w.Write(plain); err != nil {
+			return err
+		}
+		if last {
+			if n, _ := r.Read(buf[:1]); n > 0 {
+				return fmt.Errorf("encrypted snapshot has data after its last chunk")
+			}
+			return nil
+		}
+	}
+}
+
+// seal writes the header and the encrypted chunks of a snapshot. Every chunk is full but the last one.
+func seal(w io.Writer, r io.Reader, aead cipher.AEAD, header *envelope, prefix []byte) error {
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+	buf := make([]byte, header.ChunkSize, header.ChunkSize+aead.Overhead())
+	for i := uint32(0); ; i++ {
+		n, err := io.ReadFull(r, buf[:header.ChunkSize])
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+		if _, err := w.Write(aead.Seal(buf[:0], nonce(header, i, last), buf[:n], prefix)); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+func nonce(header *envelope, chunk uint32, last bool) []byte {
+	result := make([]byte, len(header.NoncePrefix)+5)
+	copy(result, header.NoncePrefix)
+	binary.BigEndian.PutUint32(result[len(header.NoncePrefix):], chunk)
+	if last {
+		result[len(result)-1] = 1
+	}
+	return result
+}
+
+// envelopePrefix is the magic, length and JSON of a header. Every chunk is authenticated with it.
+func envelopePrefix(header *envelope) ([]byte, error) {
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, len(magic)+4, len(magic)+4+len(data))
+	copy(prefix, magic)
+	binary.BigEndian.PutUint32(prefix[len(magic):], uint32(len(data)))
+	return append(prefix, data...), nil
+}
+
+func readEnvelope(r io.Reader) (*envelope, []byte, error) {
+	prefix := make([]byte, len(magic)+4)
+	if _, err := io.ReadFull(r, prefix); err != nil || string(prefix[:len(magic)]) != string(magic) {
+		return nil, nil, fmt.Errorf("snapshot isn't encrypted")
+	}
+	length := binary.BigEndian.Uint32(prefix[len(magic):])
+	if length > 64*1024 {
+		return nil, nil, fmt.Errorf("invalid header of encrypted snapshot")
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, nil, fmt.Errorf("encrypted snapshot is truncated")
+	}
+	header := &envelope{}
+	if err := json.Unmarshal(data, header); err != nil {
+		return nil, nil, fmt.Errorf("invalid header of encrypted snapshot: %w", err)
+	}
+	return header, append(prefix, data...), nil
+}
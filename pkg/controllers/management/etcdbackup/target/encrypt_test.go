@@ -0,0 +1,134 @@
+package target
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newKeySecret(t *testing.T, data map[string][]byte) *corev1.Secret {
+	t.Helper()
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cattle-global-data", Name: "etcd-backup-key"},
+		Data:       data,
+	}
+}
+
+func randomKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestEncrypted(t *testing.T) {
+	ctx := context.Background()
+	keys, err := SecretKeys(newKeySecret(t, map[string][]byte{currentKey: randomKey(t)}))
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 42} {
+		stored := memoryBackend{}
+		backend := Encrypted(stored, keys)
+		snapshot := make([]byte, size)
+		_, err := rand.Read(snapshot)
+		require.NoError(t, err)
+
+		// memoryBackend fails uploads that don't match their size
+		require.NoError(t, backend.Upload(ctx, "snapshot.zip", bytes.NewReader(snapshot), int64(size)), "size %d", size)
+		// short snapshots can be found in any random data
+		if size >= 16 {
+			assert.False(t, bytes.Contains(stored["snapshot.zip"], snapshot), "size %d is stored in plain text", size)
+		}
+
+		var downloaded bytes.Buffer
+		require.NoError(t, backend.Download(ctx, "snapshot.zip", &downloaded), "size %d", size)
+		assert.True(t, bytes.Equal(snapshot, downloaded.Bytes()), "size %d", size)
+	}
+}
+
+func TestEncryptedTampering(t *testing.T) {
+	ctx := context.Background()
+	keys, err := SecretKeys(newKeySecret(t, map[string][]byte{currentKey: randomKey(t)}))
+	require.NoError(t, err)
+	stored := memoryBackend{}
+	backend := Encrypted(stored, keys)
+	snapshot := bytes.Repeat([]byte("etcd"), chunkSize)
+	require.NoError(t, backend.Upload(ctx, "snapshot.zip", bytes.NewReader(snapshot), int64(len(snapshot))))
+	encrypted := stored["snapshot.zip"]
+
+	flipped := append([]byte{}, encrypted...)
+	flipped[len(flipped)-100] ^= 1
+	stored["snapshot.zip"] = flipped
+	assert.Error(t, backend.Download(ctx, "snapshot.zip", &bytes.Buffer{}), "changed snapshots fail to decrypt")
+
+	// the snapshot is four full chunks and an empty last chunk, which is only the 16 bytes of its tag. Drop it.
+	stored["snapshot.zip"] = encrypted[:len(encrypted)-16]
+	assert.Error(t, backend.Download(ctx, "snapshot.zip", &bytes.Buffer{}), "truncated snapshots fail to decrypt")
+
+	stored["snapshot.zip"] = append(append([]byte{}, encrypted...), 0)
+	assert.Error(t, backend.Download(ctx, "snapshot.zip", &bytes.Buffer{}), "snapshots with trailing data fail to decrypt")
+
+	stored["snapshot.zip"] = snapshot
+	assert.EqualError(t, backend.Download(ctx, "snapshot.zip", &bytes.Buffer{}), "snapshot isn't encrypted")
+
+	assert.Error(t, backend.Download(ctx, "missing.zip", &bytes.Buffer{}))
+}
+
+func TestSecretKeysRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey, newKey := randomKey(t), randomKey(t)
+	oldKeys, err := SecretKeys(newKeySecret(t, map[string][]byte{currentKey: oldKey}))
+	require.NoError(t, err)
+	stored := memoryBackend{}
+	require.NoError(t, Encrypted(stored, oldKeys).Upload(ctx, "snapshot.zip", bytes.NewReader([]byte("snapshot")), 8))
+
+	rotated, err := SecretKeys(newKeySecret(t, map[string][]byte{
+		currentKey: []byte(base64.StdEncoding.EncodeToString(newKey)),
+		"previous": oldKey,
+		"note":     []byte("rotated on 2022-10-01"),
+	}))
+	require.NoError(t, err)
+	var downloaded bytes.Buffer
+	require.NoError(t, Encrypted(stored, rotated).Download(ctx, "snapshot.zip", &downloaded), "snapshots are decrypted with previous keys")
+	assert.Equal(t, "snapshot", downloaded.String())
+
+	id, _, err := rotated.Wrap(randomKey(t))
+	require.NoError(t, err)
+	assert.Equal(t, keyID(newKey), id, "data keys are wrapped with the current key")
+
+	withoutOldKey, err := SecretKeys(newKeySecret(t, map[string][]byte{currentKey: newKey}))
+	require.NoError(t, err)
+	err = Encrypted(stored, withoutOldKey).Download(ctx, "snapshot.zip", &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), keyID(oldKey))
+
+	_, err = SecretKeys(newKeySecret(t, map[string][]byte{"previous": oldKey, currentKey: []byte("too short")}))
+	assert.Error(t, err, "secrets need a current key")
+}
+
+func TestChecksum(t *testing.T) {
+	ctx := context.Background()
+	stored := memoryBackend{}
+	checksum := &Checksum{Backend: stored}
+	require.NoError(t, checksum.Upload(ctx, "snapshot.zip", bytes.NewReader([]byte("snapshot")), 8))
+	assert.Equal(t, "16a0eeb0791b6c92451fd284dd9f599e0a7dbe7f6ebea6e2d2d06c7f74aec112", checksum.SHA256)
+
+	var downloaded bytes.Buffer
+	require.NoError(t, checksum.Download(ctx, "snapshot.zip", &downloaded))
+	assert.Equal(t, "snapshot", downloaded.String())
+
+	stored["snapshot.zip"] = []byte("snapsh0t")
+	err := checksum.Download(ctx, "snapshot.zip", &bytes.Buffer{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't match its SHA-256 digest")
+
+	assert.NoError(t, (&Checksum{Backend: stored}).Download(ctx, "snapshot.zip", &bytes.Buffer{}), "snapshots without a digest aren't checked")
+}
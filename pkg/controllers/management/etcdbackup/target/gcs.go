@@ -0,0 +1,87 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	storage "google.golang.org/api/storage/v1"
+)
+
+// gcs stores snapshots as objects through the JSON API of Google Cloud Storage.
+type gcs struct {
+	bucket  string
+	folder  string
+	service *storage.Service
+}
+
+// newGCS authenticates with the service account key of a Google cloud credential. The endpoint of a config replaces
+// the base URL of the JSON API, such as http://127.0.0.1:4443/storage/v1/ for fake-gcs-server.
+func newGCS(ctx context.Context, config *v3.GCSBackupConfig, credential map[string]string, opts ...option.ClientOption) (*gcs, error) {
+	if credential != nil {
+		opts = append(opts, option.WithCredentialsJSON([]byte(credential["authEncodedJson"])))
+	}
+	if config.Endpoint != "" {
+		endpoint := config.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		opts = append(opts, option.WithEndpoint(strings.TrimSuffix(endpoint, "/")+"/"))
+	}
+	service, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	return &gcs{
+		bucket:  config.BucketName,
+		folder:  config.Folder,
+		service: service,
+	}, nil
+}
+
+func (g *gcs) Upload(ctx context.Context, filename string, r io.Reader, _ int64) error {
+	_, err := g.service.Objects.Insert(g.bucket, &storage.Object{
+		Name:        objectName(g.folder, filename),
+		ContentType: "application/zip",
+	}).Media(r).Context(ctx).Do()
+	return err
+}
+
+func (g *gcs) Download(ctx context.Context, filename string, w io.Writer) error {
+	resp, err := g.service.Objects.Get(g.bucket, objectName(g.folder, filename)).Context(ctx).Download()
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (g *gcs) List(ctx context.Context) ([]string, error) {
+	prefix := objectName(g.folder, "")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var result []string
+	err := g.service.Objects.List(g.bucket).Prefix(prefix).Delimiter("/").Pages(ctx, func(objects *storage.Objects) error {
+		for _, object := range objects.Items {
+			result = append(result, strings.TrimPrefix(object.Name, prefix))
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (g *gcs) Delete(ctx context.Context, filename string) error {
+	err := g.service.Objects.Delete(g.bucket, objectName(g.folder, filename)).Context(ctx).Do()
+	if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+// fakeGCS serves the JSON API of the bucket "snapshots", like fake-gcs-server does. It pages object lists by two
+// objects.
+type fakeGCS struct {
+	objects map[string][]byte
+}
+
+func (f *fakeGCS) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	path := req.URL.EscapedPath()
+	switch {
+	case req.Method == http.MethodPost && path == "/upload/storage/v1/b/snapshots/o":
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		parts := multipart.NewReader(req.Body, params["boundary"])
+		metadata, err := parts.NextPart()
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		object := map[string]interface{}{}
+		if err := json.NewDecoder(metadata).Decode(&object); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		media, err := parts.NextPart()
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := ioutil.ReadAll(media)
+		f.objects[object["name"].(string)] = data
+		json.NewEncoder(rw).Encode(object)
+	case req.Method == http.MethodGet && path == "/storage/v1/b/snapshots/o":
+		query := req.URL.Query()
+		var names []string
+		for name := range f.objects {
+			rest := strings.TrimPrefix(name, query.Get("prefix"))
+			if rest != name && !strings.Contains(rest, query.Get("delimiter")) && name > query.Get("pageToken") {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		result := map[string]interface{}{}
+		var items []map[string]string
+		for i, name := range names {
+			if i == 2 {
+				result["nextPageToken"] = names[1]
+				break
+			}
+			items = append(items, map[string]string{"name": name})
+		}
+		result["items"] = items
+		json.NewEncoder(rw).Encode(result)
+	case strings.HasPrefix(path, "/storage/v1/b/snapshots/o/"):
+		name, _ := url.PathUnescape(strings.TrimPrefix(path, "/storage/v1/b/snapshots/o/"))
+		data, ok := f.objects[name]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(rw).Encode(map[string]interface{}{"error": map[string]interface{}{"code": 404, "message": "Not Found"}})
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			rw.Write(data)
+		case http.MethodDelete:
+			delete(f.objects, name)
+			rw.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestGCS(t *testing.T) {
+	ctx := context.Background()
+	storage := &fakeGCS{objects: map[string][]byte{
+		"rke/c-abcde-rl-old_2022-10-01T00:00:00Z.zip": []byte("outside of the folder"),
+	}}
+	server := httptest.NewServer(storage)
+	defer server.Close()
+
+	backend, err := newGCS(ctx, &v3.GCSBackupConfig{
+		BucketName: "snapshots",
+		Folder:     "rke/c-abcde",
+		Endpoint:   server.URL + "/storage/v1",
+	}, nil, option.WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+
+	filenames := []string{
+		"c-abcde-rg-aaaaa_2022-10-01T00:00:00Z.zip",
+		"c-abcde-rg-bbbbb_2022-10-01T12:00:00Z.zip",
+		"c-abcde-mg-ccccc_2022-10-02T00:00:00Z.zip",
+	}
+	for _, filename := range filenames {
+		require.NoError(t, backend.Upload(ctx, filename, strings.NewReader(filename), int64(len(filename))))
+	}
+	assert.Equal(t, []byte(filenames[0]), storage.objects["rke/c-abcde/"+filenames[0]])
+
+	list, err := backend.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, filenames, list, "every page is listed and objects outside of the folder are not")
+
+	var downloaded strings.Builder
+	require.NoError(t, backend.Download(ctx, filenames[1], &downloaded))
+	assert.Equal(t, filenames[1], downloaded.String())
+
+	require.NoError(t, backend.Delete(ctx, filenames[1]))
+	require.NoError(t, backend.Delete(ctx, filenames[1]), "deleting an object that doesn't exist succeeds")
+	list, err = backend.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{filenames[0], filenames[2]}, list)
+
+	assert.Error(t, backend.Download(ctx, filenames[1], &downloaded))
+}
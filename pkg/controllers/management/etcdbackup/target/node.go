@@ -0,0 +1,178 @@
+package target
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rancher/norman/types/slice"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	"github.com/rancher/rke/services"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/errors"
+)
+
+// snapshotMount is where the snapshot directory of RKE is mounted in the containers snapshots are copied with, the
+// same path RKE mounts it at.
+const snapshotMount = "/backup"
+
+// Nodes copies snapshot files between the etcd nodes of an RKE cluster and a backend. It talks to the docker daemons
+// of the nodes through the agent tunnels, like RKE does, and copies files through containers that mount the snapshot
+// directory of RKE. The containers are never started, so that this works even if the cluster is down.
+type Nodes struct {
+	DialerFactory dialer.Factory
+}
+
+// Upload uploads a snapshot file from the first etcd node that has it, every node takes the same snapshot.
+func (n *Nodes) Upload(ctx context.Context, cluster *v3.Cluster, backend Backend, filename string) error {
+	var errs []error
+	for _, node := range etcdNodes(cluster) {
+		err := n.withContainer(ctx, cluster, node, func(cli *client.Client, id string) error {
+			archive, _, err := cli.CopyFromContainer(ctx, id, path.Join(snapshotMount, filename))
+			if err != nil {
+				return err
+			}
+			defer archive.Close()
+
+			tr := tar.NewReader(archive)
+			header, err := tr.Next()
+			if err != nil {
+				return err
+			}
+			return backend.Upload(ctx, filename, tr, header.Size)
+		})
+		if err == nil {
+			logrus.Infof("[etcd-backup] uploaded snapshot [%s] of cluster [%s] from node [%s]", filename, cluster.Name, node.NodeName)
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("node [%s]: %w", node.NodeName, err))
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("cluster [%s] has no etcd nodes to upload snapshot [%s] from", cluster.Name, filename)
+	}
+	return fmt.Errorf("failed to upload snapshot [%s]: %w", filename, errors.NewAggregate(errs))
+}
+
+// Download downloads a snapshot file to every etcd node, which is where RKE restores local snapshots from.
+func (n *Nodes) Download(ctx context.Context, cluster *v3.Cluster, backend Backend, filename string) error {
+	nodes := etcdNodes(cluster)
+	if len(nodes) == 0 {
+		return fmt.Errorf("cluster [%s] has no etcd nodes to download snapshot [%s] to", cluster.Name, filename)
+	}
+
+	file, err := ioutil.TempFile("", "etcd-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if err := backend.Download(ctx, filename, file); err != nil {
+		return fmt.Errorf("failed to download snapshot [%s]: %w", filename, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		err := n.withContainer(ctx, cluster, node, func(cli *client.Client, id string) error {
+			if _, err := file.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			content := tarFile(filename, info.Size(), file)
+			defer content.Close()
+			return cli.CopyToContainer(ctx, id, snapshotMount, content, types.CopyToContainerOptions{})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to copy snapshot [%s] to node [%s]: %w", filename, node.NodeName, err)
+		}
+	}
+	return nil
+}
+
+func (n *Nodes) withContainer(ctx context.Context, cluster *v3.Cluster, node rketypes.RKEConfigNode, f func(cli *client.Client, id string) error) error {
+	clusterName, machineName := ref.Parse(node.NodeName)
+	d, err := n.DialerFactory.DockerDialer(clusterName, machineName)
+	if err != nil {
+		return err
+	}
+	cli, err := client.NewClientWithOpts(
+		client.WithAPIVersionNegotiation(),
+		client.WithHTTPClient(&http.Client{Transport: &http.Transport{DialContext: d}}))
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	created, err := cli.ContainerCreate(ctx, &container.Config{
+		Image: rkeConfig(cluster).SystemImages.Alpine,
+		Cmd:   []string{"true"},
+	}, &container.HostConfig{
+		Binds: []string{strings.TrimSuffix(services.EtcdSnapshotPath, "/") + ":" + snapshotMount},
+	}, nil, nil, "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cli.ContainerRemove(context.Background(), created.ID, types.ContainerRemoveOptions{Force: true}); err != nil {
+			logrus.Warnf("[etcd-backup] failed to remove container [%s] from node [%s]: %v", created.ID, node.NodeName, err)
+		}
+	}()
+	return f(cli, created.ID)
+}
+
+// tarFile streams a file as a tar archive, which is what docker copies into containers.
+func tarFile(name string, size int64, r io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		tw := tar.NewWriter(pw)
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     size,
+			Typeflag: tar.TypeReg,
+		})
+		if err == nil {
+			_, err = io.CopyN(tw, r, size)
+		}
+		if err == nil {
+			err = tw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// rkeConfig returns the RKE config the cluster was last provisioned with.
+func rkeConfig(cluster *v3.Cluster) *rketypes.RancherKubernetesEngineConfig {
+	if cluster.Status.AppliedSpec.RancherKubernetesEngineConfig != nil {
+		return cluster.Status.AppliedSpec.RancherKubernetesEngineConfig
+	}
+	if cluster.Spec.RancherKubernetesEngineConfig != nil {
+		return cluster.Spec.RancherKubernetesEngineConfig
+	}
+	return &rketypes.RancherKubernetesEngineConfig{}
+}
+
+// etcdNodes returns the etcd nodes Rancher provisioned, which are the ones with an agent tunnel.
+func etcdNodes(cluster *v3.Cluster) []rketypes.RKEConfigNode {
+	var result []rketypes.RKEConfigNode
+	for _, node := range rkeConfig(cluster).Nodes {
+		if slice.ContainsString(node.Role, services.ETCDRole) && strings.Contains(node.NodeName, ":") {
+			result = append(result, node)
+		}
+	}
+	return result
+}
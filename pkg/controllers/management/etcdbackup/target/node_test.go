@@ -0,0 +1,210 @@
+package target
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/types/config/dialer"
+	rketypes "github.com/rancher/rke/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDocker serves the parts of the docker API used to copy files in and out of containers. Every container mounts
+// the same snapshot directory.
+type fakeDocker struct {
+	snapshots  map[string][]byte
+	containers map[string]bool
+	binds      []string
+}
+
+func (f *fakeDocker) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	switch p := strings.TrimPrefix(req.URL.Path, "/v1.41"); {
+	case p == "/_ping":
+		rw.Header().Set("API-Version", "1.41")
+	case p == "/containers/create" && req.Method == http.MethodPost:
+		var config struct {
+			Image      string
+			HostConfig struct {
+				Binds []string
+			}
+		}
+		json.NewDecoder(req.Body).Decode(&config)
+		if config.Image != "rancher/rke-tools:v0.1.87" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.binds = config.HostConfig.Binds
+		id := fmt.Sprintf("container%d", len(f.containers))
+		f.containers[id] = true
+		rw.WriteHeader(http.StatusCreated)
+		json.NewEncoder(rw).Encode(map[string]string{"Id": id})
+	case strings.HasSuffix(p, "/archive"):
+		if !f.containers[strings.TrimSuffix(strings.TrimPrefix(p, "/containers/"), "/archive")] {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.archive(rw, req)
+	case strings.HasPrefix(p, "/containers/") && req.Method == http.MethodDelete:
+		delete(f.containers, strings.TrimPrefix(p, "/containers/"))
+		rw.WriteHeader(http.StatusNoContent)
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeDocker) archive(rw http.ResponseWriter, req *http.Request) {
+	dir, name := path.Split(req.URL.Query().Get("path"))
+	if req.Method == http.MethodPut {
+		tr := tar.NewReader(req.Body)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.snapshots[header.Name], _ = ioutil.ReadAll(tr)
+		}
+	}
+
+	data, ok := f.snapshots[name]
+	if dir != "/backup/" || !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+	stat, _ := json.Marshal(map[string]interface{}{"name": name, "size": len(data)})
+	rw.Header().Set("X-Docker-Container-Path-Stat", base64.StdEncoding.EncodeToString(stat))
+	tw := tar.NewWriter(rw)
+	tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))})
+	tw.Write(data)
+	tw.Close()
+}
+
+// fakeDockerDialers dials the fake docker daemons of machines, like the agent tunnels do.
+type fakeDockerDialers map[string]*httptest.Server
+
+func (f fakeDockerDialers) ClusterDialer(clusterName string) (dialer.Dialer, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f fakeDockerDialers) NodeDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f fakeDockerDialers) DockerDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	server, ok := f[clusterName+":"+machineName]
+	if !ok {
+		return nil, fmt.Errorf("no tunnel to machine [%s]", machineName)
+	}
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "tcp", server.Listener.Addr().String())
+	}, nil
+}
+
+// memoryBackend keeps snapshot files in memory.
+type memoryBackend map[string][]byte
+
+func (m memoryBackend) Upload(_ context.Context, filename string, r io.Reader, size int64) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return fmt.Errorf("expected %d bytes, got %d", size, len(data))
+	}
+	m[filename] = data
+	return nil
+}
+
+func (m memoryBackend) Download(_ context.Context, filename string, w io.Writer) error {
+	data, ok := m[filename]
+	if !ok {
+		return fmt.Errorf("snapshot [%s] not found", filename)
+	}
+	_, err := io.Copy(w, bytes.NewReader(data))
+	return err
+}
+
+func (m memoryBackend) List(context.Context) ([]string, error) {
+	var result []string
+	for filename := range m {
+		result = append(result, filename)
+	}
+	return result, nil
+}
+
+func (m memoryBackend) Delete(_ context.Context, filename string) error {
+	delete(m, filename)
+	return nil
+}
+
+func newFakeNodes(t *testing.T) (*v3.Cluster, map[string]*fakeDocker, *Nodes) {
+	cluster := &v3.Cluster{}
+	cluster.Name = "c-abcde"
+	cluster.Status.AppliedSpec.RancherKubernetesEngineConfig = &rketypes.RancherKubernetesEngineConfig{
+		SystemImages: rketypes.RKESystemImages{Alpine: "rancher/rke-tools:v0.1.87"},
+		Nodes: []rketypes.RKEConfigNode{
+			{NodeName: "c-abcde:m-etcd1", Role: []string{"etcd", "controlplane"}},
+			{NodeName: "c-abcde:m-worker", Role: []string{"worker"}},
+			{NodeName: "c-abcde:m-etcd2", Role: []string{"etcd"}},
+			{Address: "10.0.0.4", Role: []string{"etcd"}},
+		},
+	}
+
+	daemons := map[string]*fakeDocker{}
+	dialers := fakeDockerDialers{}
+	for _, node := range []string{"c-abcde:m-etcd1", "c-abcde:m-worker", "c-abcde:m-etcd2"} {
+		daemons[node] = &fakeDocker{snapshots: map[string][]byte{}, containers: map[string]bool{}}
+		dialers[node] = httptest.NewServer(daemons[node])
+		t.Cleanup(dialers[node].Close)
+	}
+	return cluster, daemons, &Nodes{DialerFactory: dialers}
+}
+
+func TestNodesUpload(t *testing.T) {
+	ctx := context.Background()
+	cluster, daemons, nodes := newFakeNodes(t)
+	backend := memoryBackend{}
+	filename := "c-abcde-ra-aaaaa_2022-10-01T00:00:00Z.zip"
+
+	err := nodes.Upload(ctx, cluster, backend, filename)
+	require.Error(t, err, "no node has the snapshot")
+
+	daemons["c-abcde:m-etcd2"].snapshots[filename] = []byte("snapshot")
+	require.NoError(t, nodes.Upload(ctx, cluster, backend, filename), "the node without the snapshot is skipped")
+	assert.Equal(t, []byte("snapshot"), backend[filename])
+
+	for node, daemon := range daemons {
+		assert.Empty(t, daemon.containers, "containers are removed from %s", node)
+	}
+	assert.Equal(t, []string{"/opt/rke/etcd-snapshots:/backup"}, daemons["c-abcde:m-etcd2"].binds)
+}
+
+func TestNodesDownload(t *testing.T) {
+	ctx := context.Background()
+	cluster, daemons, nodes := newFakeNodes(t)
+	filename := "c-abcde-ma-aaaaa_2022-10-01T00:00:00Z.zip"
+	backend := memoryBackend{filename: []byte("snapshot")}
+
+	require.NoError(t, nodes.Download(ctx, cluster, backend, filename))
+	assert.Equal(t, []byte("snapshot"), daemons["c-abcde:m-etcd1"].snapshots[filename])
+	assert.Equal(t, []byte("snapshot"), daemons["c-abcde:m-etcd2"].snapshots[filename])
+	assert.Empty(t, daemons["c-abcde:m-worker"].snapshots, "the snapshot is only copied to etcd nodes")
+
+	assert.Error(t, nodes.Download(ctx, cluster, backend, "missing.zip"))
+}
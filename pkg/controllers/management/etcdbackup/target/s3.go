@@ -0,0 +1,112 @@
+package target
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+)
+
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+// s3 stores snapshots as objects in an S3 bucket.
+type s3 struct {
+	bucket string
+	folder string
+	client *minio.Client
+}
+
+// newS3 authenticates with the access key of an S3 cloud credential. The default region of the cloud credential is
+// used if the config leaves it out.
+func newS3(config *v3.S3BackupTargetConfig, credential map[string]string) (*s3, error) {
+	secure := true
+	endpoint := s3Endpoint(config)
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		secure = u.Scheme != "http"
+		endpoint = u.Host
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if ca := config.CustomCA; ca != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, fmt.Errorf("invalid custom CA of S3 etcd backup target")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	client, err := minio.New(strings.TrimSuffix(endpoint, "/"), &minio.Options{
+		Creds:     credentials.NewStaticV4(credential["accessKey"], credential["secretKey"], ""),
+		Region:    first(config.Region, credential["defaultRegion"]),
+		Secure:    secure,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	return &s3{
+		bucket: config.BucketName,
+		folder: config.Folder,
+		client: client,
+	}, nil
+}
+
+func (s *s3) Upload(ctx context.Context, filename string, r io.Reader, size int64) error {
+	_, err := s.client.PutObject(ctx, s.bucket, objectName(s.folder, filename), r, size, minio.PutObjectOptions{
+		ContentType: "application/zip",
+	})
+	return err
+}
+
+func (s *s3) Download(ctx context.Context, filename string, w io.Writer) error {
+	object, err := s.client.GetObject(ctx, s.bucket, objectName(s.folder, filename), minio.GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+	_, err = io.Copy(w, object)
+	return err
+}
+
+func (s *s3) List(ctx context.Context) ([]string, error) {
+	prefix := objectName(s.folder, "")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var result []string
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		if name := strings.TrimPrefix(object.Key, prefix); !strings.HasSuffix(name, "/") {
+			result = append(result, name)
+		}
+	}
+	return result, nil
+}
+
+func (s *s3) Delete(ctx context.Context, filename string) error {
+	// S3 doesn't fail to remove objects that don't exist
+	return s.client.RemoveObject(ctx, s.bucket, objectName(s.folder, filename), minio.RemoveObjectOptions{})
+}
+
+func s3Endpoint(config *v3.S3BackupTargetConfig) string {
+	return first(config.Endpoint, defaultS3Endpoint)
+}
+
+// first returns the first non-blank string.
+func first(one, two string) string {
+	if one == "" {
+		return two
+	}
+	return one
+}
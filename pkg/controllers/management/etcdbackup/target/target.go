@@ -0,0 +1,252 @@
+// Package target stores RKE etcd snapshots in object stores that RKE can't upload to from the etcd nodes itself, or
+// that the snapshots have to be encrypted for. RKE takes the snapshots locally and Rancher copies them between the
+// etcd nodes and the backend of the target.
+package target
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	"github.com/rancher/rancher/pkg/namespace"
+	"github.com/rancher/wrangler/pkg/kv"
+)
+
+// Annotation records the target of an EtcdBackup, so that the snapshot is removed from and restored out of the
+// target it was uploaded to even if the target of the cluster changes later on.
+const Annotation = "etcdbackup.cattle.io/target"
+
+// DigestAnnotation records the SHA-256 digest of the snapshot file of an EtcdBackup, which is checked before the
+// snapshot is restored.
+const DigestAnnotation = "etcdbackup.cattle.io/sha256"
+
+// Backend stores snapshot files in an object store, under the folder of the target.
+type Backend interface {
+	Upload(ctx context.Context, filename string, r io.Reader, size int64) error
+	Download(ctx context.Context, filename string, w io.Writer) error
+	// List returns the names of the snapshot files in the folder of the target.
+	List(ctx context.Context) ([]string, error)
+	// Delete removes a snapshot file, it doesn't fail if the file doesn't exist.
+	Delete(ctx context.Context, filename string) error
+}
+
+// New returns the backend of a target, authenticated with the cloud credential the target refers to.
+func New(ctx context.Context, target *v3.EtcdBackupTarget, secretLister v1.SecretLister) (Backend, error) {
+	if err := Validate(target); err != nil {
+		return nil, err
+	}
+	if target.AzureBlob != nil {
+		credential, err := cloudCredential(secretLister, target.AzureBlob.CloudCredentialName)
+		if err != nil {
+			return nil, err
+		}
+		return newAzureBlob(target.AzureBlob, credential)
+	}
+	if target.S3 != nil {
+		credential, err := cloudCredential(secretLister, target.S3.CloudCredentialName)
+		if err != nil {
+			return nil, err
+		}
+		return newS3(target.S3, credential)
+	}
+	credential, err := cloudCredential(secretLister, target.GCS.CloudCredentialName)
+	if err != nil {
+		return nil, err
+	}
+	return newGCS(ctx, target.GCS, credential)
+}
+
+// ForBackup returns the backend of the target of a backup, or nil if RKE stores the snapshot of the backup by itself.
+// Snapshots are encrypted if the target has encryption enabled, and checked against the digest recorded on the
+// backup when they are downloaded.
+func ForBackup(ctx context.Context, backup *v3.EtcdBackup, secretLister v1.SecretLister) (*Checksum, error) {
+	target, err := Get(backup)
+	if err != nil || target == nil {
+		return nil, err
+	}
+	backend, err := New(ctx, target, secretLister)
+	if err != nil {
+		return nil, err
+	}
+	if target.Encryption != nil {
+		keys, err := EncryptionKeys(secretLister, target.Encryption.KeySecretName)
+		if err != nil {
+			return nil, err
+		}
+		backend = Encrypted(backend, keys)
+	}
+	return &Checksum{Backend: backend, SHA256: backup.Annotations[DigestAnnotation]}, nil
+}
+
+// EncryptionKeys returns the keys of a key secret. Like cloud credentials, key secrets are in the global data
+// namespace.
+func EncryptionKeys(secretLister v1.SecretLister, name string) (Keys, error) {
+	ns, secretName := globalName(name)
+	if ns != namespace.GlobalNamespace {
+		return nil, fmt.Errorf("invalid encryption key secret [%s]", name)
+	}
+	secret, err := secretLister.Get(ns, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get encryption key secret [%s]: %w", name, err)
+	}
+	return SecretKeys(secret)
+}
+
+// Validate checks that exactly one backend of a target is configured, along with its required fields.
+func Validate(target *v3.EtcdBackupTarget) error {
+	backends := 0
+	if target != nil {
+		for _, configured := range []bool{target.AzureBlob != nil, target.GCS != nil, target.S3 != nil} {
+			if configured {
+				backends++
+			}
+		}
+	}
+	switch {
+	case backends == 0:
+		return fmt.Errorf("etcd backup target has no backend")
+	case backends > 1:
+		return fmt.Errorf("etcd backup target can only have one of an Azure Blob, GCS and S3 backend")
+	case target.Encryption != nil && target.Encryption.KeySecretName == "":
+		return fmt.Errorf("etcd backup encryption requires a key secret")
+	case target.AzureBlob != nil:
+		if target.AzureBlob.AccountName == "" || target.AzureBlob.ContainerName == "" || target.AzureBlob.CloudCredentialName == "" {
+			return fmt.Errorf("Azure Blob etcd backup target requires an account name, container name and cloud credential")
+		}
+	case target.S3 != nil:
+		if target.S3.BucketName == "" || target.S3.CloudCredentialName == "" {
+			return fmt.Errorf("S3 etcd backup target requires a bucket name and cloud credential")
+		}
+	case target.GCS.BucketName == "" || target.GCS.CloudCredentialName == "":
+		return fmt.Errorf("GCS etcd backup target requires a bucket name and cloud credential")
+	}
+	return nil
+}
+
+// ProviderFlag is the letter of the target in the names of backups, like "s" for S3.
+func ProviderFlag(target *v3.EtcdBackupTarget) string {
+	switch {
+	case target.AzureBlob != nil:
+		return "a"
+	case target.S3 != nil:
+		return "s"
+	}
+	return "g"
+}
+
+// URL is the URL of the object a snapshot file is stored as, which is recorded as the filename of the backup.
+func URL(target *v3.EtcdBackupTarget, filename string) string {
+	if target.AzureBlob != nil {
+		return azureContainerURL(target.AzureBlob) + "/" + objectName(target.AzureBlob.Folder, filename)
+	}
+	if target.S3 != nil {
+		endpoint := s3Endpoint(target.S3)
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(endpoint, "/"), target.S3.BucketName, objectName(target.S3.Folder, filename))
+	}
+	return fmt.Sprintf("gs://%s/%s", target.GCS.BucketName, objectName(target.GCS.Folder, filename))
+}
+
+// Get returns the target recorded on a backup, or nil if RKE stores the snapshot of the backup by itself.
+func Get(backup *v3.EtcdBackup) (*v3.EtcdBackupTarget, error) {
+	data := backup.Annotations[Annotation]
+	if data == "" {
+		return nil, nil
+	}
+	target := &v3.EtcdBackupTarget{}
+	if err := json.Unmarshal([]byte(data), target); err != nil {
+		return nil, fmt.Errorf("invalid etcd backup target of backup [%s]: %w", backup.Name, err)
+	}
+	return target, nil
+}
+
+// Set records the target on a backup.
+func Set(backup *v3.EtcdBackup, target *v3.EtcdBackupTarget) error {
+	data, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	if backup.Annotations == nil {
+		backup.Annotations = map[string]string{}
+	}
+	backup.Annotations[Annotation] = string(data)
+	return nil
+}
+
+// Checksum hashes the snapshot files uploaded through a backend, and checks the snapshot files downloaded through it
+// against SHA256 unless it's empty, which is the case for snapshots uploaded before digests were recorded.
+type Checksum struct {
+	Backend
+	SHA256 string
+}
+
+func (c *Checksum) Upload(ctx context.Context, filename string, r io.Reader, size int64) error {
+	hash := sha256.New()
+	if err := c.Backend.Upload(ctx, filename, io.TeeReader(r, hash), size); err != nil {
+		return err
+	}
+	c.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return nil
+}
+
+func (c *Checksum) Download(ctx context.Context, filename string, w io.Writer) error {
+	hash := sha256.New()
+	if err := c.Backend.Download(ctx, filename, io.MultiWriter(w, hash)); err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); c.SHA256 != "" && sum != c.SHA256 {
+		return fmt.Errorf("snapshot [%s] doesn't match its SHA-256 digest, expected [%s] but got [%s]", filename, c.SHA256, sum)
+	}
+	return nil
+}
+
+// SetDigest records the digest of the snapshot file of a backup.
+func SetDigest(backup *v3.EtcdBackup, digest string) {
+	if backup.Annotations == nil {
+		backup.Annotations = map[string]string{}
+	}
+	backup.Annotations[DigestAnnotation] = digest
+}
+
+func objectName(folder, filename string) string {
+	return strings.TrimPrefix(path.Join(folder, filename), "/")
+}
+
+// cloudCredential reads the fields of a cloud credential without their driver prefix, so that
+// "azurecredentialConfig-clientId" is returned as "clientId". Only cloud credentials in the global data namespace can
+// be used, which is where the cloud credentials of users are stored.
+func cloudCredential(secretLister v1.SecretLister, name string) (map[string]string, error) {
+	ns, secretName := globalName(name)
+	if ns != namespace.GlobalNamespace {
+		return nil, fmt.Errorf("invalid cloud credential [%s]", name)
+	}
+	secret, err := secretLister.Get(ns, secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud credential [%s]: %w", name, err)
+	}
+
+	data := map[string]string{}
+	for k, v := range secret.Data {
+		_, k = kv.RSplit(k, "-")
+		data[k] = string(v)
+	}
+	return data, nil
+}
+
+// globalName splits the namespace off the name of a secret, names without one are in the global data namespace.
+func globalName(name string) (string, string) {
+	ns, secretName := kv.Split(name, ":")
+	if secretName == "" {
+		return namespace.GlobalNamespace, ns
+	}
+	return ns, secretName
+}
@@ -0,0 +1,307 @@
+package projecttemplate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/resourcequota"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/rancher/wrangler/pkg/name"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/kubelet/util/format"
+)
+
+const (
+	memberBindingPrefix = "pt-"
+	pspBindingSuffix    = "project-template"
+)
+
+type controller struct {
+	projects       v3.ProjectInterface
+	projectLister  v3.ProjectLister
+	revisionLister v3.ProjectTemplateRevisionLister
+	prtbs          v3.ProjectRoleTemplateBindingInterface
+	prtbLister     v3.ProjectRoleTemplateBindingLister
+	psptpbs        v3.PodSecurityPolicyTemplateProjectBindingInterface
+	psptpbLister   v3.PodSecurityPolicyTemplateProjectBindingLister
+}
+
+func Register(ctx context.Context, management *config.ManagementContext) {
+	c := &controller{
+		projects:       management.Management.Projects(""),
+		projectLister:  management.Management.Projects("").Controller().Lister(),
+		revisionLister: management.Management.ProjectTemplateRevisions("").Controller().Lister(),
+		prtbs:          management.Management.ProjectRoleTemplateBindings(""),
+		prtbLister:     management.Management.ProjectRoleTemplateBindings("").Controller().Lister(),
+		psptpbs:        management.Management.PodSecurityPolicyTemplateProjectBindings(""),
+		psptpbLister:   management.Management.PodSecurityPolicyTemplateProjectBindings("").Controller().Lister(),
+	}
+	management.Management.Projects("").AddHandler(ctx, "project-template-controller", c.sync)
+	management.Management.ProjectTemplates("").AddHandler(ctx, "project-template-reconcile-controller", c.syncTemplate)
+}
+
+// sync applies the revision set on a project once, later changes to the project are kept until the revision changes.
+func (c *controller) sync(key string, project *v32.Project) (runtime.Object, error) {
+	if project == nil || project.DeletionTimestamp != nil || project.Spec.ProjectTemplateRevisionName == "" {
+		return project, nil
+	}
+	if project.Spec.ProjectTemplateRevisionName == project.Status.AppliedProjectTemplateRevisionName {
+		return project, nil
+	}
+
+	revisionNamespace, revisionName := ref.Parse(project.Spec.ProjectTemplateRevisionName)
+	revision, err := c.revisionLister.Get(revisionNamespace, revisionName)
+	if err != nil {
+		return project, err
+	}
+
+	if err := quotaFits(project, revision); err != nil {
+		return c.notApplied(project, project.Spec.ProjectTemplateRevisionName, err)
+	}
+
+	updated := project.DeepCopy()
+	applyProjectConfig(updated, revision)
+
+	if err := c.ensureMembers(updated, revision); err != nil {
+		return project, err
+	}
+	if err := c.ensurePodSecurityPolicyTemplate(updated, revision); err != nil {
+		return project, err
+	}
+
+	logrus.Infof("[project-template] applied revision %s to project %s:%s", project.Spec.ProjectTemplateRevisionName,
+		project.Namespace, project.Name)
+	updated.Status.AppliedProjectTemplateRevisionName = project.Spec.ProjectTemplateRevisionName
+	v32.ProjectConditionTemplateApplied.True(updated)
+
+	if !reflect.DeepEqual(project, updated) {
+		return c.projects.Update(updated)
+	}
+	return project, nil
+}
+
+// syncTemplate moves the projects of a reconciled template to its default revision, projects whose namespaces use more
+// than the quota of the revision stay on their revision. The revision binds members and pod security policies as the
+// system user, so only projects whose owners opted in are moved, the template managers may not be allowed to.
+func (c *controller) syncTemplate(key string, template *v32.ProjectTemplate) (runtime.Object, error) {
+	if template == nil || template.DeletionTimestamp != nil {
+		return template, nil
+	}
+	if !template.Spec.ReconcileProjects || template.Spec.DefaultRevisionName == "" {
+		return template, nil
+	}
+
+	revisionNamespace, revisionName := ref.Parse(template.Spec.DefaultRevisionName)
+	revision, err := c.revisionLister.Get(revisionNamespace, revisionName)
+	if err != nil {
+		return template, err
+	}
+	if revision.Spec.Enabled != nil && !*revision.Spec.Enabled {
+		logrus.Warnf("[project-template] not reconciling projects of template %s, default revision %s is disabled",
+			template.Name, template.Spec.DefaultRevisionName)
+		return template, nil
+	}
+
+	projects, err := c.projectLister.List("", labels.SelectorFromSet(labels.Set{v32.ProjectTemplateLabel: template.Name}))
+	if err != nil {
+		return template, err
+	}
+	for _, project := range projects {
+		if project.Spec.ProjectTemplateRevisionName == template.Spec.DefaultRevisionName ||
+			project.Annotations[v32.ProjectTemplateReconcileAnnotation] != "true" {
+			continue
+		}
+		if err := quotaFits(project, revision); err != nil {
+			logrus.Warnf("[project-template] not moving project %s:%s to revision %s: %v", project.Namespace, project.Name,
+				template.Spec.DefaultRevisionName, err)
+			if _, err := c.notApplied(project, template.Spec.DefaultRevisionName, err); err != nil {
+				return template, err
+			}
+			continue
+		}
+		project = project.DeepCopy()
+		project.Spec.ProjectTemplateRevisionName = template.Spec.DefaultRevisionName
+		if _, err := c.projects.Update(project); err != nil {
+			return template, err
+		}
+	}
+	return template, nil
+}
+
+// notApplied records on a project why a revision can't be applied to it, the project keeps its config.
+func (c *controller) notApplied(project *v32.Project, revisionName string, err error) (runtime.Object, error) {
+	message := fmt.Sprintf("revision %s can not be applied: %v", revisionName, err)
+	if v32.ProjectConditionTemplateApplied.IsFalse(project) && v32.ProjectConditionTemplateApplied.GetMessage(project) == message {
+		return project, nil
+	}
+	project = project.DeepCopy()
+	v32.ProjectConditionTemplateApplied.False(project)
+	v32.ProjectConditionTemplateApplied.Message(project, message)
+	return c.projects.Update(project)
+}
+
+// quotaFits checks the quotas of a revision against a project like the project API does when the quotas of a project
+// change: the namespace default quota has to fit in the project quota, and so does the quota the namespaces of the
+// project already use, apart from the resources the namespace default quota no longer limits.
+func quotaFits(project *v32.Project, revision *v32.ProjectTemplateRevision) error {
+	config := revision.Spec.ProjectConfig
+	if config == nil || config.ResourceQuota == nil {
+		return nil
+	}
+	projectLimit := &config.ResourceQuota.Limit
+
+	var nsLimitMap map[string]string
+	if config.NamespaceDefaultResourceQuota != nil {
+		nsLimit := &config.NamespaceDefaultResourceQuota.Limit
+		fits, exceeded, err := resourcequota.IsQuotaFit(nsLimit, nil, projectLimit)
+		if err != nil {
+			return err
+		}
+		if !fits {
+			return fmt.Errorf("namespace default resource quota exceeds the resource quota on fields: %s", format.ResourceList(exceeded))
+		}
+		if nsLimitMap, err = resourcequota.LimitToMap(nsLimit); err != nil {
+			return err
+		}
+	}
+
+	if project.Spec.ResourceQuota == nil {
+		return nil
+	}
+	usedLimitMap, err := resourcequota.LimitToMap(&project.Spec.ResourceQuota.UsedLimit)
+	if err != nil {
+		return err
+	}
+	if nsLimitMap != nil {
+		for key := range usedLimitMap {
+			if _, ok := nsLimitMap[key]; !ok {
+				delete(usedLimitMap, key)
+			}
+		}
+	}
+	usedLimit, err := resourcequota.MapToLimit(usedLimitMap)
+	if err != nil {
+		return err
+	}
+	fits, exceeded, err := resourcequota.IsQuotaFit(usedLimit, nil, projectLimit)
+	if err != nil {
+		return err
+	}
+	if !fits {
+		return fmt.Errorf("resource quota is below the used limit on fields: %s", format.ResourceList(exceeded))
+	}
+	return nil
+}
+
+// applyProjectConfig overwrites the quotas, limits and monitoring of a project with the ones of the revision, the
+// quota already used by the namespaces of the project is kept.
+func applyProjectConfig(project *v32.Project, revision *v32.ProjectTemplateRevision) {
+	var usedLimit v32.ResourceQuotaLimit
+	if project.Spec.ResourceQuota != nil {
+		usedLimit = project.Spec.ResourceQuota.UsedLimit
+	}
+
+	if revision.Spec.ProjectConfig != nil {
+		project.Spec.ProjectSpecBase = *revision.Spec.ProjectConfig.DeepCopy()
+	} else {
+		project.Spec.ProjectSpecBase = v32.ProjectSpecBase{}
+	}
+	if project.Spec.ResourceQuota != nil {
+		project.Spec.ResourceQuota.UsedLimit = usedLimit
+	}
+
+	_, templateName := ref.Parse(revision.Spec.ProjectTemplateName)
+	project.Spec.ProjectTemplateName = revision.Spec.ProjectTemplateName
+	if project.Labels == nil {
+		project.Labels = map[string]string{}
+	}
+	project.Labels[v32.ProjectTemplateLabel] = templateName
+	project.Labels[v32.ProjectTemplateRevisionLabel] = revision.Name
+}
+
+// ensureMembers binds the groups of the revision in the project and removes the bindings of earlier revisions.
+func (c *controller) ensureMembers(project *v32.Project, revision *v32.ProjectTemplateRevision) error {
+	projectName := project.Namespace + ":" + project.Name
+	desired := map[string]bool{}
+	for _, member := range revision.Spec.Members {
+		bindingName := memberBindingName(member)
+		desired[bindingName] = true
+		if _, err := c.prtbLister.Get(project.Name, bindingName); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		_, err := c.prtbs.Create(&v32.ProjectRoleTemplateBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName,
+				Namespace: project.Name,
+				Labels:    map[string]string{v32.ProjectTemplateLabel: project.Labels[v32.ProjectTemplateLabel]},
+			},
+			ProjectName:        projectName,
+			GroupPrincipalName: member.GroupPrincipalName,
+			RoleTemplateName:   member.RoleTemplateName,
+		})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	bindings, err := c.prtbLister.List(project.Name, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings {
+		if _, ok := binding.Labels[v32.ProjectTemplateLabel]; !ok || desired[binding.Name] {
+			continue
+		}
+		if err := c.prtbs.DeleteNamespaced(binding.Namespace, binding.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensurePodSecurityPolicyTemplate binds the pod security policy template of the revision to the project, projects
+// keep their template if the revision has none.
+func (c *controller) ensurePodSecurityPolicyTemplate(project *v32.Project, revision *v32.ProjectTemplateRevision) error {
+	pspt := revision.Spec.PodSecurityPolicyTemplateName
+	if pspt == "" {
+		return nil
+	}
+
+	bindingName := name.SafeConcatName(project.Name, pspBindingSuffix)
+	binding, err := c.psptpbLister.Get(project.Namespace, bindingName)
+	if apierrors.IsNotFound(err) {
+		_, err = c.psptpbs.Create(&v32.PodSecurityPolicyTemplateProjectBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName,
+				Namespace: project.Namespace,
+			},
+			PodSecurityPolicyTemplateName: pspt,
+			TargetProjectName:             project.Namespace + ":" + project.Name,
+		})
+	} else if err == nil && binding.PodSecurityPolicyTemplateName != pspt {
+		binding = binding.DeepCopy()
+		binding.PodSecurityPolicyTemplateName = pspt
+		_, err = c.psptpbs.Update(binding)
+	}
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error binding podSecurityPolicyTemplate %s to project %s: %w", pspt, project.Name, err)
+	}
+
+	project.Status.PodSecurityPolicyTemplateName = pspt
+	return nil
+}
+
+func memberBindingName(member v32.ProjectTemplateMember) string {
+	return memberBindingPrefix + name.Hex(member.GroupPrincipalName+"/"+member.RoleTemplateName, 10)
+}
@@ -0,0 +1,286 @@
+package projecttemplate
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3/fakes"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSync(t *testing.T) {
+	revision := &v32.ProjectTemplateRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "ptr-1", Namespace: "cattle-global-data"},
+		Spec: v32.ProjectTemplateRevisionSpec{
+			ProjectTemplateName: "cattle-global-data:pt-1",
+			ProjectConfig: &v32.ProjectSpecBase{
+				ResourceQuota: &v32.ProjectResourceQuota{
+					Limit: v32.ResourceQuotaLimit{LimitsCPU: "2000m"},
+				},
+				EnableProjectMonitoring: true,
+			},
+			PodSecurityPolicyTemplateName: "restricted",
+			Members: []v32.ProjectTemplateMember{
+				{GroupPrincipalName: "github_team://1", RoleTemplateName: "project-member"},
+			},
+		},
+	}
+	stale := &v32.ProjectRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pt-stale",
+			Namespace: "p-1",
+			Labels:    map[string]string{v32.ProjectTemplateLabel: "pt-1"},
+		},
+	}
+	manual := &v32.ProjectRoleTemplateBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-1"},
+	}
+
+	var createdPRTBs []*v32.ProjectRoleTemplateBinding
+	var createdPSPTPBs []*v32.PodSecurityPolicyTemplateProjectBinding
+	var deleted []string
+	notFound := apierrors.NewNotFound(schema.GroupResource{}, "")
+
+	c := &controller{
+		projects: &fakes.ProjectInterfaceMock{
+			UpdateFunc: func(in1 *v32.Project) (*v32.Project, error) { return in1, nil },
+		},
+		revisionLister: &fakes.ProjectTemplateRevisionListerMock{
+			GetFunc: func(namespace string, name string) (*v32.ProjectTemplateRevision, error) {
+				assert.Equal(t, "cattle-global-data", namespace)
+				assert.Equal(t, "ptr-1", name)
+				return revision, nil
+			},
+		},
+		prtbs: &fakes.ProjectRoleTemplateBindingInterfaceMock{
+			CreateFunc: func(in1 *v32.ProjectRoleTemplateBinding) (*v32.ProjectRoleTemplateBinding, error) {
+				createdPRTBs = append(createdPRTBs, in1)
+				return in1, nil
+			},
+			DeleteNamespacedFunc: func(namespace string, name string, options *metav1.DeleteOptions) error {
+				deleted = append(deleted, namespace+":"+name)
+				return nil
+			},
+		},
+		prtbLister: &fakes.ProjectRoleTemplateBindingListerMock{
+			GetFunc: func(namespace string, name string) (*v32.ProjectRoleTemplateBinding, error) {
+				return nil, notFound
+			},
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v32.ProjectRoleTemplateBinding, error) {
+				return []*v32.ProjectRoleTemplateBinding{stale, manual}, nil
+			},
+		},
+		psptpbs: &fakes.PodSecurityPolicyTemplateProjectBindingInterfaceMock{
+			CreateFunc: func(in1 *v32.PodSecurityPolicyTemplateProjectBinding) (*v32.PodSecurityPolicyTemplateProjectBinding, error) {
+				createdPSPTPBs = append(createdPSPTPBs, in1)
+				return in1, nil
+			},
+		},
+		psptpbLister: &fakes.PodSecurityPolicyTemplateProjectBindingListerMock{
+			GetFunc: func(namespace string, name string) (*v32.PodSecurityPolicyTemplateProjectBinding, error) {
+				return nil, notFound
+			},
+		},
+	}
+
+	project := &v32.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "p-1", Namespace: "c-1"},
+		Spec: v32.ProjectSpec{
+			ProjectSpecBase: v32.ProjectSpecBase{
+				ResourceQuota: &v32.ProjectResourceQuota{
+					UsedLimit: v32.ResourceQuotaLimit{LimitsCPU: "500m"},
+				},
+			},
+			ClusterName:                 "c-1",
+			ProjectTemplateRevisionName: "cattle-global-data:ptr-1",
+		},
+	}
+
+	obj, err := c.sync("c-1/p-1", project)
+	assert.NoError(t, err)
+	project = obj.(*v32.Project)
+
+	// the project config of the revision replaces the one of the project, the used quota is kept
+	assert.Equal(t, "2000m", project.Spec.ResourceQuota.Limit.LimitsCPU)
+	assert.Equal(t, "500m", project.Spec.ResourceQuota.UsedLimit.LimitsCPU)
+	assert.True(t, project.Spec.EnableProjectMonitoring)
+	assert.Equal(t, "cattle-global-data:pt-1", project.Spec.ProjectTemplateName)
+	assert.Equal(t, "pt-1", project.Labels[v32.ProjectTemplateLabel])
+	assert.Equal(t, "ptr-1", project.Labels[v32.ProjectTemplateRevisionLabel])
+	assert.Equal(t, "cattle-global-data:ptr-1", project.Status.AppliedProjectTemplateRevisionName)
+	assert.True(t, v32.ProjectConditionTemplateApplied.IsTrue(project))
+
+	// members are bound by group, bindings of earlier revisions are removed and others are left alone
+	if assert.Len(t, createdPRTBs, 1) {
+		assert.Equal(t, "p-1", createdPRTBs[0].Namespace)
+		assert.Equal(t, "c-1:p-1", createdPRTBs[0].ProjectName)
+		assert.Equal(t, "github_team://1", createdPRTBs[0].GroupPrincipalName)
+		assert.Equal(t, "project-member", createdPRTBs[0].RoleTemplateName)
+		assert.Equal(t, "pt-1", createdPRTBs[0].Labels[v32.ProjectTemplateLabel])
+	}
+	assert.Equal(t, []string{"p-1:pt-stale"}, deleted)
+
+	if assert.Len(t, createdPSPTPBs, 1) {
+		assert.Equal(t, "c-1", createdPSPTPBs[0].Namespace)
+		assert.Equal(t, "c-1:p-1", createdPSPTPBs[0].TargetProjectName)
+		assert.Equal(t, "restricted", createdPSPTPBs[0].PodSecurityPolicyTemplateName)
+	}
+	assert.Equal(t, "restricted", project.Status.PodSecurityPolicyTemplateName)
+
+	// an applied revision is not applied again, so later changes to the project are kept
+	project.Spec.ResourceQuota.Limit.LimitsCPU = "4000m"
+	obj, err = c.sync("c-1/p-1", project)
+	assert.NoError(t, err)
+	assert.Equal(t, "4000m", obj.(*v32.Project).Spec.ResourceQuota.Limit.LimitsCPU)
+	assert.Len(t, createdPRTBs, 1)
+
+	// a revision whose quota is below the quota the namespaces use isn't applied
+	project = obj.(*v32.Project)
+	project.Spec.ProjectTemplateRevisionName = "cattle-global-data:ptr-1"
+	project.Status.AppliedProjectTemplateRevisionName = ""
+	project.Spec.ResourceQuota.UsedLimit.LimitsCPU = "3000m"
+	obj, err = c.sync("c-1/p-1", project)
+	assert.NoError(t, err)
+	project = obj.(*v32.Project)
+	assert.Equal(t, "4000m", project.Spec.ResourceQuota.Limit.LimitsCPU)
+	assert.Empty(t, project.Status.AppliedProjectTemplateRevisionName)
+	assert.True(t, v32.ProjectConditionTemplateApplied.IsFalse(project))
+	assert.Equal(t, "revision cattle-global-data:ptr-1 can not be applied: resource quota is below the used limit on fields: limitsCpu=3",
+		v32.ProjectConditionTemplateApplied.GetMessage(project))
+	assert.Len(t, createdPRTBs, 1)
+}
+
+func TestQuotaFits(t *testing.T) {
+	revision := &v32.ProjectTemplateRevision{
+		Spec: v32.ProjectTemplateRevisionSpec{
+			ProjectConfig: &v32.ProjectSpecBase{
+				ResourceQuota: &v32.ProjectResourceQuota{
+					Limit: v32.ResourceQuotaLimit{LimitsCPU: "2000m", Pods: "20"},
+				},
+				NamespaceDefaultResourceQuota: &v32.NamespaceResourceQuota{
+					Limit: v32.ResourceQuotaLimit{LimitsCPU: "500m"},
+				},
+			},
+		},
+	}
+	project := &v32.Project{
+		Spec: v32.ProjectSpec{
+			ProjectSpecBase: v32.ProjectSpecBase{
+				ResourceQuota: &v32.ProjectResourceQuota{
+					UsedLimit: v32.ResourceQuotaLimit{LimitsCPU: "1500m", Pods: "30"},
+				},
+			},
+		},
+	}
+
+	// the pods the namespace default quota no longer limits are not counted
+	assert.NoError(t, quotaFits(project, revision))
+
+	project.Spec.ResourceQuota.UsedLimit.LimitsCPU = "2500m"
+	assert.EqualError(t, quotaFits(project, revision), "resource quota is below the used limit on fields: limitsCpu=2500m")
+
+	revision.Spec.ProjectConfig.NamespaceDefaultResourceQuota.Limit.LimitsCPU = "3000m"
+	assert.EqualError(t, quotaFits(project, revision), "namespace default resource quota exceeds the resource quota on fields: limitsCpu=3")
+
+	// a revision without quotas removes them
+	revision.Spec.ProjectConfig.ResourceQuota = nil
+	assert.NoError(t, quotaFits(project, revision))
+}
+
+func TestSyncTemplate(t *testing.T) {
+	var updated []*v32.Project
+	optedIn := map[string]string{v32.ProjectTemplateReconcileAnnotation: "true"}
+	projects := []*v32.Project{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "p-1", Namespace: "c-1", Annotations: optedIn},
+			Spec:       v32.ProjectSpec{ProjectTemplateRevisionName: "cattle-global-data:ptr-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "p-2", Namespace: "c-1", Annotations: optedIn},
+			Spec:       v32.ProjectSpec{ProjectTemplateRevisionName: "cattle-global-data:ptr-2"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "p-3", Namespace: "c-1", Annotations: optedIn},
+			Spec: v32.ProjectSpec{
+				ProjectSpecBase: v32.ProjectSpecBase{
+					ResourceQuota: &v32.ProjectResourceQuota{
+						UsedLimit: v32.ResourceQuotaLimit{LimitsCPU: "4000m"},
+					},
+				},
+				ProjectTemplateRevisionName: "cattle-global-data:ptr-1",
+			},
+		},
+		{
+			// the owners of the project didn't opt in to be moved
+			ObjectMeta: metav1.ObjectMeta{Name: "p-4", Namespace: "c-1"},
+			Spec:       v32.ProjectSpec{ProjectTemplateRevisionName: "cattle-global-data:ptr-1"},
+		},
+	}
+
+	c := &controller{
+		projects: &fakes.ProjectInterfaceMock{
+			UpdateFunc: func(in1 *v32.Project) (*v32.Project, error) {
+				updated = append(updated, in1)
+				return in1, nil
+			},
+		},
+		projectLister: &fakes.ProjectListerMock{
+			ListFunc: func(namespace string, selector labels.Selector) ([]*v32.Project, error) {
+				assert.True(t, selector.Matches(labels.Set{v32.ProjectTemplateLabel: "pt-1"}))
+				return projects, nil
+			},
+		},
+		revisionLister: &fakes.ProjectTemplateRevisionListerMock{
+			GetFunc: func(namespace string, name string) (*v32.ProjectTemplateRevision, error) {
+				return &v32.ProjectTemplateRevision{
+					Spec: v32.ProjectTemplateRevisionSpec{
+						ProjectConfig: &v32.ProjectSpecBase{
+							ResourceQuota: &v32.ProjectResourceQuota{
+								Limit: v32.ResourceQuotaLimit{LimitsCPU: "2000m"},
+							},
+						},
+					},
+				}, nil
+			},
+		},
+	}
+
+	template := &v32.ProjectTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "pt-1", Namespace: "cattle-global-data"},
+		Spec: v32.ProjectTemplateSpec{
+			DefaultRevisionName: "cattle-global-data:ptr-2",
+		},
+	}
+
+	// projects are only moved to the default revision of reconciled templates
+	_, err := c.syncTemplate("cattle-global-data/pt-1", template)
+	assert.NoError(t, err)
+	assert.Empty(t, updated)
+
+	template.Spec.ReconcileProjects = true
+	_, err = c.syncTemplate("cattle-global-data/pt-1", template)
+	assert.NoError(t, err)
+	if assert.Len(t, updated, 2) {
+		assert.Equal(t, "p-1", updated[0].Name)
+		assert.Equal(t, "cattle-global-data:ptr-2", updated[0].Spec.ProjectTemplateRevisionName)
+
+		// a project whose namespaces use more than the quota of the revision stays on its revision
+		assert.Equal(t, "p-3", updated[1].Name)
+		assert.Equal(t, "cattle-global-data:ptr-1", updated[1].Spec.ProjectTemplateRevisionName)
+		assert.True(t, v32.ProjectConditionTemplateApplied.IsFalse(updated[1]))
+		assert.Contains(t, v32.ProjectConditionTemplateApplied.GetMessage(updated[1]), "resource quota is below the used limit")
+	}
+	assert.Equal(t, "cattle-global-data:ptr-1", projects[0].Spec.ProjectTemplateRevisionName)
+
+	// the project isn't updated again while the condition is unchanged
+	projects[2] = updated[1]
+	updated = nil
+	projects[0].Spec.ProjectTemplateRevisionName = "cattle-global-data:ptr-2"
+	_, err = c.syncTemplate("cattle-global-data/pt-1", template)
+	assert.NoError(t, err)
+	assert.Empty(t, updated)
+}
@@ -30,15 +30,21 @@ func (r *rbaccontroller) clusterOwnerSync(_ string, grb *v3.GlobalRoleBinding) (
 			continue
 		}
 		crtbName := name.SafeConcatName(rbac.GetGRBTargetKey(grb), "restricted-admin", "cluster-owner")
-		_, err := r.crtbCache.Get(cluster.Name, crtbName)
+		existing, err := r.crtbCache.Get(cluster.Name, crtbName)
 		if err != nil && !apierrors.IsNotFound(err) {
 			retError = multierror.Append(retError, fmt.Errorf("failed to get CRTB '%s' from cache: %w", crtbName, err))
 			continue
 		}
 		if err == nil {
 			// CRTB was already created.
-			// we do not need to check for equivalence between the current CRTB and the desired CRTB
-			// this is because the fields we care about can not be modified
+			// the subject and role can not be modified, only the times the GRB grants its role are kept in sync
+			if existing != nil && (!existing.NotBefore.Equal(grb.NotBefore) || !existing.ExpiresAt.Equal(grb.ExpiresAt)) {
+				existing = existing.DeepCopy()
+				existing.NotBefore, existing.ExpiresAt = grb.NotBefore, grb.ExpiresAt
+				if _, err := r.crtbCtrl.Update(existing); err != nil {
+					retError = multierror.Append(retError, fmt.Errorf("failed to update CRTB '%s': %w", crtbName, err))
+				}
+			}
 			continue
 		}
 
@@ -60,6 +66,8 @@ func (r *rbaccontroller) clusterOwnerSync(_ string, grb *v3.GlobalRoleBinding) (
 			},
 			ClusterName:      cluster.Name,
 			RoleTemplateName: "cluster-owner",
+			NotBefore:        grb.NotBefore,
+			ExpiresAt:        grb.ExpiresAt,
 		}
 
 		// CRTBs must contain either user or group information but not both.
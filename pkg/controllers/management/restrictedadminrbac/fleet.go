@@ -2,6 +2,7 @@
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	v3 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
@@ -42,6 +43,12 @@ func (r *rbaccontroller) ensureRestricedAdminForFleet(key string, obj *v3.Global
 		if fw.Name == fleetconst.ClustersLocalNamespace {
 			continue
 		}
+		if active, _ := rbac.BindingActive(obj.NotBefore, obj.ExpiresAt, time.Now()); !active {
+			if err := r.deleteRolebinding(fw.Name, obj); err != nil {
+				finalError = multierror.Append(finalError, err)
+			}
+			continue
+		}
 		if err := r.ensureRolebinding(fw.Name, rbac.GetGRBSubject(obj), obj); err != nil {
 			finalError = multierror.Append(finalError, err)
 		}
@@ -49,6 +56,22 @@ func (r *rbaccontroller) ensureRestricedAdminForFleet(key string, obj *v3.Global
 	return obj, finalError
 }
 
+// deleteRolebinding deletes the fleetworkspace rolebinding of a GRB that isn't active.
+func (r *rbaccontroller) deleteRolebinding(namespace string, grb *v3.GlobalRoleBinding) error {
+	rbName := fmt.Sprintf("%s-fleetworkspace-%s", grb.Name, rbac.RestrictedAdminClusterRoleBinding)
+	if _, err := r.rbLister.Get(namespace, rbName); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	err := r.roleBindings.DeleteNamespaced(namespace, rbName, &metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (r *rbaccontroller) ensureRolebinding(namespace string, subject k8srbac.Subject, grb *v3.GlobalRoleBinding) error {
 	rbName := fmt.Sprintf("%s-fleetworkspace-%s", grb.Name, rbac.RestrictedAdminClusterRoleBinding)
 	rb := &k8srbac.RoleBinding{
@@ -5,6 +5,7 @@
 	"reflect"
 	"sort"
 
+	"github.com/rancher/rancher/pkg/auth/tokens"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/clusterauthtoken/common"
 	"github.com/rancher/rancher/pkg/features"
 	clusterv3 "github.com/rancher/rancher/pkg/generated/norman/cluster.cattle.io/v3"
@@ -33,6 +34,11 @@ type tokenHandler struct {
 }
 
 func (h *tokenHandler) Create(token *managementv3.Token) (runtime.Object, error) {
+	// the authorized cluster endpoint can't enforce the scope of a token, so scoped tokens only work through Rancher
+	if tokens.IsScoped(token) {
+		return nil, nil
+	}
+
 	_, err := h.clusterAuthTokenLister.Get(h.namespace, token.Name)
 	if !errors.IsNotFound(err) {
 		return h.Updated(token)
@@ -11,6 +11,7 @@
 	"github.com/rancher/rancher/pkg/controllers/managementuser/networkpolicy"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/nodesyncer"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/nsserviceaccount"
+	"github.com/rancher/rancher/pkg/controllers/managementuser/projecttemplate"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/pspdelete"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/rbac"
 	"github.com/rancher/rancher/pkg/controllers/managementuser/rbac/podsecuritypolicy"
@@ -34,6 +35,7 @@ func Register(ctx context.Context, mgmt *config.ScaledContext, cluster *config.U
 	podsecuritypolicy.Register(ctx, cluster)
 	secret.Register(ctx, cluster)
 	resourcequota.Register(ctx, cluster)
+	projecttemplate.Register(ctx, cluster)
 	certsexpiration.Register(ctx, cluster)
 	windows.Register(ctx, clusterRec, cluster)
 	nsserviceaccount.Register(ctx, cluster)
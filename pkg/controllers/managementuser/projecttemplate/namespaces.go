@@ -0,0 +1,118 @@
+package projecttemplate
+
+import (
+	"context"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	rv1 "github.com/rancher/rancher/pkg/generated/norman/core/v1"
+	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
+	"github.com/rancher/rancher/pkg/ref"
+	"github.com/rancher/rancher/pkg/types/config"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const projectIDAnnotation = "field.cattle.io/projectId"
+
+type namespaceHandler struct {
+	clusterName     string
+	namespaces      rv1.NamespaceInterface
+	namespaceLister rv1.NamespaceLister
+	revisionLister  v3.ProjectTemplateRevisionLister
+}
+
+func Register(ctx context.Context, cluster *config.UserContext) {
+	h := &namespaceHandler{
+		clusterName:     cluster.ClusterName,
+		namespaces:      cluster.Core.Namespaces(""),
+		namespaceLister: cluster.Core.Namespaces("").Controller().Lister(),
+		revisionLister:  cluster.Management.Management.ProjectTemplateRevisions("").Controller().Lister(),
+	}
+	cluster.Management.Management.Projects(cluster.ClusterName).AddClusterScopedHandler(ctx, "project-template-namespaces",
+		cluster.ClusterName, h.sync)
+}
+
+// sync creates the namespaces of the revision applied to a project. Namespaces are never removed and a namespace that
+// already belongs to another project is left alone.
+func (h *namespaceHandler) sync(key string, project *v32.Project) (runtime.Object, error) {
+	if project == nil || project.DeletionTimestamp != nil || project.Status.AppliedProjectTemplateRevisionName == "" {
+		return project, nil
+	}
+
+	revisionNamespace, revisionName := ref.Parse(project.Status.AppliedProjectTemplateRevisionName)
+	revision, err := h.revisionLister.Get(revisionNamespace, revisionName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return project, nil
+		}
+		return project, err
+	}
+
+	projectID := h.clusterName + ":" + project.Name
+	for _, templateNamespace := range revision.Spec.Namespaces {
+		if err := h.ensureNamespace(projectID, revision, templateNamespace); err != nil {
+			return project, err
+		}
+	}
+	return project, nil
+}
+
+func (h *namespaceHandler) ensureNamespace(projectID string, revision *v32.ProjectTemplateRevision, templateNamespace v32.ProjectTemplateNamespace) error {
+	ns, err := h.namespaceLister.Get("", templateNamespace.Name)
+	if apierrors.IsNotFound(err) {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        templateNamespace.Name,
+				Labels:      map[string]string{},
+				Annotations: map[string]string{},
+			},
+		}
+	} else if err != nil {
+		return err
+	} else if owner := ns.Annotations[projectIDAnnotation]; owner != "" && owner != projectID {
+		logrus.Warnf("[project-template] namespace %s of revision %s already belongs to project %s, not moving it to project %s",
+			ns.Name, revision.Name, owner, projectID)
+		return nil
+	} else {
+		ns = ns.DeepCopy()
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		if ns.Annotations == nil {
+			ns.Annotations = map[string]string{}
+		}
+	}
+
+	changed := false
+	for k, v := range templateNamespace.Labels {
+		changed = setValue(ns.Labels, k, v) || changed
+	}
+	for k, v := range templateNamespace.Annotations {
+		changed = setValue(ns.Annotations, k, v) || changed
+	}
+	changed = setValue(ns.Labels, v32.ProjectTemplateRevisionLabel, revision.Name) || changed
+	changed = setValue(ns.Annotations, projectIDAnnotation, projectID) || changed
+
+	if ns.ResourceVersion == "" {
+		_, err = h.namespaces.Create(ns)
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	if changed {
+		_, err = h.namespaces.Update(ns)
+	}
+	return err
+}
+
+func setValue(m map[string]string, key, value string) bool {
+	if m[key] == value {
+		return false
+	}
+	m[key] = value
+	return true
+}
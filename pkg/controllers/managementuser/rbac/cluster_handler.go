@@ -77,7 +77,7 @@ func (h *clusterHandler) doSync(cluster *v3.Cluster) error {
 
 		for _, x := range grbs {
 			grb, ok := x.(*v32.GlobalRoleBinding)
-			if !ok || grb == nil {
+			if !ok || grb == nil || !bindingActive(grb.NotBefore, grb.ExpiresAt) {
 				continue
 			}
 			bindingName := rbac.GrbCRBName(grb)
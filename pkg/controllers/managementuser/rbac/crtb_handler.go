@@ -23,6 +23,9 @@ type crtbLifecycle struct {
 }
 
 func (c *crtbLifecycle) Create(obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+	if !bindingActive(obj.NotBefore, obj.ExpiresAt) {
+		return obj, c.ensureCRTBDelete(obj)
+	}
 	err := c.syncCRTB(obj)
 	return obj, err
 }
@@ -31,6 +34,9 @@ func (c *crtbLifecycle) Updated(obj *v3.ClusterRoleTemplateBinding) (runtime.Obj
 	if err := c.reconcileCRTBUserClusterLabels(obj); err != nil {
 		return obj, err
 	}
+	if !bindingActive(obj.NotBefore, obj.ExpiresAt) {
+		return obj, c.ensureCRTBDelete(obj)
+	}
 	err := c.syncCRTB(obj)
 	return obj, err
 }
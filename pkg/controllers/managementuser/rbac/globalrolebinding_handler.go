@@ -90,9 +90,29 @@ func (c *grbHandler) sync(key string, obj *apisv3.GlobalRoleBinding) (runtime.Ob
 
 	logrus.Debugf("%v is an admin role", obj.GlobalRoleName)
 
+	if !bindingActive(obj.NotBefore, obj.ExpiresAt) {
+		return obj, c.deleteClusterAdminBinding(obj)
+	}
 	return obj, c.ensureClusterAdminBinding(obj)
 }
 
+// deleteClusterAdminBinding deletes the ClusterRoleBinding of a GRB that isn't active in the downstream cluster.
+func (c *grbHandler) deleteClusterAdminBinding(obj *apisv3.GlobalRoleBinding) error {
+	bindingName := rbac.GrbCRBName(obj)
+	if _, err := c.crbLister.Get("", bindingName); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ClusterRoleBinding '%s' from the cache: %w", bindingName, err)
+	}
+
+	err := c.clusterRoleBindings.Delete(bindingName, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ClusterRoleBinding '%s' for admin in downstream '%s': %w", bindingName, c.clusterName, err)
+	}
+	return nil
+}
+
 // ensureClusterAdminBinding creates a ClusterRoleBinding for GRB subject to
 // the Kubernetes "cluster-admin" ClusterRole in the downstream cluster.
 func (c *grbHandler) ensureClusterAdminBinding(obj *apisv3.GlobalRoleBinding) error {
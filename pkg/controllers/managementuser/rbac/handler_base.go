@@ -5,6 +5,7 @@
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rancher/norman/objectclient"
@@ -382,6 +383,13 @@ func (m *manager) gatherRolesRecurse(rt *v3.RoleTemplate, roleTemplates map[stri
 	return nil
 }
 
+// bindingActive returns whether a binding with the given NotBefore and ExpiresAt times grants its role now. The mgmt auth
+// controllers enqueue bindings when that changes.
+func bindingActive(notBefore, expiresAt *metav1.Time) bool {
+	active, _ := pkgrbac.BindingActive(notBefore, expiresAt, time.Now())
+	return active
+}
+
 func (m *manager) ensureClusterBindings(roles map[string]*v3.RoleTemplate, binding *v3.ClusterRoleTemplateBinding) error {
 	create := func(objectMeta metav1.ObjectMeta, subjects []rbacv1.Subject, roleRef rbacv1.RoleRef) runtime.Object {
 		return &rbacv1.ClusterRoleBinding{
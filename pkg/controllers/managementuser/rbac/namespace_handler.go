@@ -227,6 +227,10 @@ func (n *nsLifecycle) ensurePRTBAddToNamespace(ns *v1.Namespace) (bool, error) {
 			continue
 		}
 
+		if !bindingActive(prtb.NotBefore, prtb.ExpiresAt) {
+			continue
+		}
+
 		if prtb.RoleTemplateName == "" {
 			logrus.Warnf("ProjectRoleTemplateBinding %v has no role template set. Skipping.", prtb.Name)
 			continue
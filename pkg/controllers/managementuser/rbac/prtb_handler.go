@@ -64,6 +64,9 @@ type prtbLifecycle struct {
 }
 
 func (p *prtbLifecycle) Create(obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+	if !bindingActive(obj.NotBefore, obj.ExpiresAt) {
+		return obj, p.ensurePRTBDelete(obj)
+	}
 	err := p.syncPRTB(obj)
 	return obj, err
 }
@@ -72,6 +75,9 @@ func (p *prtbLifecycle) Updated(obj *v3.ProjectRoleTemplateBinding) (runtime.Obj
 	if err := p.reconcilePRTBUserClusterLabels(obj); err != nil {
 		return obj, err
 	}
+	if !bindingActive(obj.NotBefore, obj.ExpiresAt) {
+		return obj, p.ensurePRTBDelete(obj)
+	}
 	err := p.syncPRTB(obj)
 	return obj, err
 }
@@ -84,7 +84,7 @@ func (c *rtSync) syncRT(template *v3.RoleTemplate, usedInProjects bool, prtbs []
 
 	for _, obj := range prtbs {
 		prtb, ok := obj.(*v3.ProjectRoleTemplateBinding)
-		if !ok {
+		if !ok || !bindingActive(prtb.NotBefore, prtb.ExpiresAt) {
 			continue
 		}
 
@@ -125,7 +125,7 @@ func (c *rtSync) syncRT(template *v3.RoleTemplate, usedInProjects bool, prtbs []
 
 	for _, obj := range crtbs {
 		crtb, ok := obj.(*v3.ClusterRoleTemplateBinding)
-		if !ok {
+		if !ok || !bindingActive(crtb.NotBefore, crtb.ExpiresAt) {
 			continue
 		}
 		if err := c.m.ensureClusterBindings(roles, crtb); err != nil {
@@ -7,26 +7,19 @@
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	v3 "github.com/rancher/rancher/pkg/generated/norman/management.cattle.io/v3"
 	"github.com/rancher/rancher/pkg/ref"
+	validate "github.com/rancher/rancher/pkg/resourcequota"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func convertResourceListToLimit(rList corev1.ResourceList) (*v32.ResourceQuotaLimit, error) {
-	converted, err := convert.EncodeToMap(rList)
-	if err != nil {
-		return nil, err
-	}
-
 	convertedMap := map[string]string{}
-	for key, value := range converted {
-		convertedMap[key] = convert.ToString(value)
+	for key, value := range rList {
+		convertedMap[string(key)] = value.String()
 	}
 
-	toReturn := &v32.ResourceQuotaLimit{}
-	err = convert.ToObj(convertedMap, toReturn)
-
-	return toReturn, err
+	return validate.MapToLimit(convertedMap)
 }
 
 func convertResourceLimitResourceQuotaSpec(limit *v32.ResourceQuotaLimit) (*corev1.ResourceQuotaSpec, error) {
@@ -41,24 +34,14 @@ func convertResourceLimitResourceQuotaSpec(limit *v32.ResourceQuotaLimit) (*core
 }
 
 func convertProjectResourceLimitToResourceList(limit *v32.ResourceQuotaLimit) (corev1.ResourceList, error) {
-	in, err := json.Marshal(limit)
-	if err != nil {
-		return nil, err
-	}
-	limitsMap := map[string]string{}
-	err = json.Unmarshal(in, &limitsMap)
+	limitsMap, err := validate.LimitToMap(limit)
 	if err != nil {
 		return nil, err
 	}
 
 	limits := corev1.ResourceList{}
 	for key, value := range limitsMap {
-		var resourceName corev1.ResourceName
-		if val, ok := resourceQuotaConversion[key]; ok {
-			resourceName = corev1.ResourceName(val)
-		} else {
-			resourceName = corev1.ResourceName(key)
-		}
+		resourceName := validate.ResourceQuotaName(key)
 
 		resourceQuantity, err := resource.ParseQuantity(value)
 		if err != nil {
@@ -124,19 +107,6 @@ func convertContainerResourceLimitToResourceList(limit *v32.ContainerResourceLim
 	"limitsMemory": "memory",
 }
 
-var resourceQuotaConversion = map[string]string{
-	"replicationControllers": "replicationcontrollers",
-	"configMaps":             "configmaps",
-	"persistentVolumeClaims": "persistentvolumeclaims",
-	"servicesNodePorts":      "services.nodeports",
-	"servicesLoadBalancers":  "services.loadbalancers",
-	"requestsCpu":            "requests.cpu",
-	"requestsMemory":         "requests.memory",
-	"requestsStorage":        "requests.storage",
-	"limitsCpu":              "limits.cpu",
-	"limitsMemory":           "limits.memory",
-}
-
 func getNamespaceResourceQuota(ns *corev1.Namespace) string {
 	if ns.Annotations == nil {
 		return ""
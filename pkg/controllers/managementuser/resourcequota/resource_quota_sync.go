@@ -429,11 +429,11 @@ func completeQuota(requestedQuota *v32.ResourceQuotaLimit, defaultQuota *v32.Res
 	if requestedQuota == nil || defaultQuota == nil {
 		return nil, nil
 	}
-	requestedQuotaMap, err := convert.EncodeToMap(requestedQuota)
+	requestedQuotaMap, err := validate.LimitToMap(requestedQuota)
 	if err != nil {
 		return nil, err
 	}
-	newLimitMap, err := convert.EncodeToMap(defaultQuota)
+	newLimitMap, err := validate.LimitToMap(defaultQuota)
 	if err != nil {
 		return nil, err
 	}
@@ -441,9 +441,7 @@ func completeQuota(requestedQuota *v32.ResourceQuotaLimit, defaultQuota *v32.Res
 		newLimitMap[key] = value
 	}
 
-	toReturn := &v32.ResourceQuotaLimit{}
-	err = convert.ToObj(newLimitMap, toReturn)
-	return toReturn, err
+	return validate.MapToLimit(newLimitMap)
 }
 
 func completeLimit(existingLimit *v32.ContainerResourceLimit, defaultLimit *v32.ContainerResourceLimit) (*v32.ContainerResourceLimit, error) {
@@ -476,7 +474,7 @@ func completeLimit(existingLimit *v32.ContainerResourceLimit, defaultLimit *v32.
 // zeroOutResourceQuotaLimit takes a resource quota limit and a list of resources exceeding the quota,
 // and returns a new quota limit with exceeded resources zeroed out.
 func zeroOutResourceQuotaLimit(limit *v32.ResourceQuotaLimit, exceeded corev1.ResourceList) (*v32.ResourceQuotaLimit, error) {
-	limitMap, err := convert.EncodeToMap(limit)
+	limitMap, err := validate.LimitToMap(limit)
 	if err != nil {
 		return nil, err
 	}
@@ -486,7 +484,5 @@ func zeroOutResourceQuotaLimit(limit *v32.ResourceQuotaLimit, exceeded corev1.Re
 		limitMap[resource] = "0"
 	}
 
-	toReturn := &v32.ResourceQuotaLimit{}
-	err = convert.ToObj(limitMap, toReturn)
-	return toReturn, err
+	return validate.MapToLimit(limitMap)
 }
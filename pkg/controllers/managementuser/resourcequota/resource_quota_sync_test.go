@@ -107,3 +107,92 @@ func TestSemanticDeepEqual(t *testing.T) {
 	}
 
 }
+
+func TestConvertProjectResourceLimitToResourceListExtended(t *testing.T) {
+	limit := &v32.ResourceQuotaLimit{
+		Pods:        "30",
+		RequestsCPU: "1",
+		Extended: map[string]string{
+			"count/deployments.apps":                            "10",
+			"requests.nvidia.com/gpu":                           "4",
+			"gold.storageclass.storage.k8s.io/requests.storage": "100Gi",
+		},
+	}
+
+	result, err := convertProjectResourceLimitToResourceList(limit)
+	assert.NoError(t, err)
+	expected := corev1.ResourceList{
+		corev1.ResourcePods:                                 resource.MustParse("30"),
+		corev1.ResourceRequestsCPU:                          resource.MustParse("1"),
+		"count/deployments.apps":                            resource.MustParse("10"),
+		"requests.nvidia.com/gpu":                           resource.MustParse("4"),
+		"gold.storageclass.storage.k8s.io/requests.storage": resource.MustParse("100Gi"),
+	}
+	assert.True(t, apiequality.Semantic.DeepEqual(expected, result))
+}
+
+func TestCompleteQuotaExtended(t *testing.T) {
+	requested := &v32.ResourceQuotaLimit{
+		Pods: "5",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "1",
+		},
+	}
+	defaults := &v32.ResourceQuotaLimit{
+		Pods:      "10",
+		LimitsCPU: "2",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "2",
+			"count/deployments.apps":  "3",
+		},
+	}
+
+	result, err := completeQuota(requested, defaults)
+	assert.NoError(t, err)
+	assert.Equal(t, &v32.ResourceQuotaLimit{
+		Pods:      "5",
+		LimitsCPU: "2",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "1",
+			"count/deployments.apps":  "3",
+		},
+	}, result)
+}
+
+func TestZeroOutResourceQuotaLimitExtended(t *testing.T) {
+	limit := &v32.ResourceQuotaLimit{
+		Pods: "5",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "1",
+			"count/deployments.apps":  "3",
+		},
+	}
+	exceeded := corev1.ResourceList{
+		"pods":                    resource.MustParse("6"),
+		"requests.nvidia.com/gpu": resource.MustParse("2"),
+	}
+
+	result, err := zeroOutResourceQuotaLimit(limit, exceeded)
+	assert.NoError(t, err)
+	assert.Equal(t, &v32.ResourceQuotaLimit{
+		Pods: "0",
+		Extended: map[string]string{
+			"requests.nvidia.com/gpu": "0",
+			"count/deployments.apps":  "3",
+		},
+	}, result)
+}
+
+func TestConvertResourceListToLimitExtended(t *testing.T) {
+	result, err := convertResourceListToLimit(corev1.ResourceList{
+		"requestsCpu":            resource.MustParse("500m"),
+		"count/deployments.apps": resource.MustParse("2"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &v32.ResourceQuotaLimit{
+		RequestsCPU: "500m",
+		Extended: map[string]string{
+			"count/deployments.apps": "2",
+		},
+	}, result)
+}
@@ -193,6 +193,10 @@ func (h *handler) OnChange(key string, configMap *corev1.ConfigMap) (runtime.Obj
 				if originalSnapshotFile.Message != "" && snapshot.SnapshotFile.Message == "" {
 					snapshot.SnapshotFile.Message = originalSnapshotFile.Message
 				}
+				// the configmap doesn't have digests, the planner records them
+				if originalSnapshotFile.SHA256 != "" && snapshot.SnapshotFile.SHA256 == "" {
+					snapshot.SnapshotFile.SHA256 = originalSnapshotFile.SHA256
+				}
 				if !equality.Semantic.DeepEqual(snapshot.SnapshotFile, originalSnapshotFile) {
 					updated = true
 					logrus.Debugf("[snapshotbackpopulate] rkecluster %s/%s: snapshot %s/%s SnapshotFile contents were different, triggering update", cluster.Namespace, cluster.Name, snapshot.Namespace, snapshot.Name)
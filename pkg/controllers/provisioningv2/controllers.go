@@ -17,6 +17,7 @@
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/plansecret"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/provisioningcluster"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/provisioninglog"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/restoredrill"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/rkecluster"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/rkecontrolplane"
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2/secret"
@@ -57,6 +58,7 @@ func Register(ctx context.Context, clients *wrangler.Context) error {
 		rkecontrolplane.Register(ctx, clients)
 		managesystemagent.Register(ctx, clients)
 		machinedrain.Register(ctx, clients)
+		restoredrill.Register(ctx, clients, kubeconfigManager)
 	}
 
 	if features.EmbeddedClusterAPI.Enabled() {
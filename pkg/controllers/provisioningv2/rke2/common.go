@@ -78,15 +78,16 @@
 	RKEMachineAPIVersion           = "rke-machine.cattle.io/v1"
 	RKEAPIVersion                  = "rke.cattle.io/v1"
 
-	Provisioned         = condition.Cond("Provisioned")
-	Updated             = condition.Cond("Updated")
-	Reconciled          = condition.Cond("Reconciled")
-	Ready               = condition.Cond("Ready")
-	Waiting             = condition.Cond("Waiting")
-	Pending             = condition.Cond("Pending")
-	Removed             = condition.Cond("Removed")
-	PlanApplied         = condition.Cond("PlanApplied")
-	InfrastructureReady = condition.Cond(capi.InfrastructureReadyCondition)
+	Provisioned              = condition.Cond("Provisioned")
+	Updated                  = condition.Cond("Updated")
+	Reconciled               = condition.Cond("Reconciled")
+	Ready                    = condition.Cond("Ready")
+	Waiting                  = condition.Cond("Waiting")
+	Pending                  = condition.Cond("Pending")
+	Removed                  = condition.Cond("Removed")
+	PlanApplied              = condition.Cond("PlanApplied")
+	InfrastructureReady      = condition.Cond(capi.InfrastructureReadyCondition)
+	MaintenanceWindowPending = condition.Cond("MaintenanceWindowPending")
 
 	RuntimeK3S  = "k3s"
 	RuntimeRKE2 = "rke2"
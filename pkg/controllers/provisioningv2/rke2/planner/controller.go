@@ -50,6 +50,8 @@ func Register(ctx context.Context, clients *wrangler.Context, planner *planner.P
 		}
 		return nil, nil
 	}, clients.RKE.RKEControlPlane(), clients.Core.Secret(), clients.CAPI.Machine())
+
+	registerPreview(ctx, clients, planner)
 }
 
 func (h *handler) OnChange(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus) (rkev1.RKEControlPlaneStatus, error) {
@@ -88,6 +90,12 @@ func (h *handler) OnChange(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPla
 				rke2.Ready.Reason(&status, "Waiting")
 				err = nil
 			}
+		} else if errors.As(err, new(planner.ErrInvalidConfig)) {
+			// the control plane is reconciled again once its spec is fixed
+			logrus.Errorf("[planner] rkecluster %s/%s: %v", cp.Namespace, cp.Name, err)
+			rke2.Ready.SetError(&status, "", err)
+			rke2.Reconciled.SetError(&status, "", err)
+			err = nil
 		} else if !errors.Is(err, generic.ErrSkip) {
 			logrus.Errorf("[planner] rkecluster %s/%s: error encountered during plan processing was %v", cp.Namespace, cp.Name, err)
 			rke2.Ready.SetError(&status, "", err)
@@ -0,0 +1,96 @@
+package planner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	v1 "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/provisioningv2/rke2/planner"
+	"github.com/rancher/rancher/pkg/wrangler"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type previewer interface {
+	Preview(controlPlane *rkev1.RKEControlPlane) ([]rkev1.RKEPlanPreviewMachine, error)
+}
+
+type previewHandler struct {
+	planner           previewer
+	previews          v1.RKEPlanPreviewClient
+	controlPlaneCache v1.RKEControlPlaneCache
+}
+
+func registerPreview(ctx context.Context, clients *wrangler.Context, planner *planner.Planner) {
+	h := previewHandler{
+		planner:           planner,
+		previews:          clients.RKE.RKEPlanPreview(),
+		controlPlaneCache: clients.RKE.RKEControlPlane().Cache(),
+	}
+	clients.RKE.RKEPlanPreview().OnChange(ctx, "planner-preview", h.OnChange)
+}
+
+// OnChange previews the plans of the proposed spec once per generation of the preview. The preview isn't updated when
+// the cluster changes, it is recreated or updated to preview again.
+func (h *previewHandler) OnChange(_ string, preview *rkev1.RKEPlanPreview) (*rkev1.RKEPlanPreview, error) {
+	if preview == nil || preview.DeletionTimestamp != nil || preview.Status.ObservedGeneration == preview.Generation {
+		return preview, nil
+	}
+
+	status, err := h.preview(preview)
+	if err != nil {
+		// the error is shown until the preview succeeds, and the preview is retried
+		status = preview.Status
+		status.Error = err.Error()
+	}
+	if !equality.Semantic.DeepEqual(preview.Status, status) {
+		preview = preview.DeepCopy()
+		preview.Status = status
+		var updateErr error
+		if preview, updateErr = h.previews.UpdateStatus(preview); updateErr != nil {
+			return preview, updateErr
+		}
+	}
+	return preview, err
+}
+
+func (h *previewHandler) preview(preview *rkev1.RKEPlanPreview) (rkev1.RKEPlanPreviewStatus, error) {
+	status := rkev1.RKEPlanPreviewStatus{ObservedGeneration: preview.Generation}
+
+	controlPlane, err := h.controlPlaneCache.Get(preview.Namespace, preview.Spec.ClusterName)
+	if apierrors.IsNotFound(err) {
+		status.Error = fmt.Sprintf("cluster %s/%s is not an RKE2/K3s cluster or doesn't exist", preview.Namespace, preview.Spec.ClusterName)
+		return status, nil
+	} else if err != nil {
+		return status, err
+	}
+
+	controlPlane = controlPlane.DeepCopy()
+	controlPlane.Spec.RKEClusterSpecCommon = *preview.Spec.RKEConfig.DeepCopy()
+	if preview.Spec.KubernetesVersion != "" {
+		controlPlane.Spec.KubernetesVersion = preview.Spec.KubernetesVersion
+	}
+
+	machines, err := h.planner.Preview(controlPlane)
+	if errors.As(err, new(planner.ErrInvalidConfig)) {
+		status.Error = err.Error()
+		return status, nil
+	} else if err != nil {
+		return status, err
+	}
+	status.Machines = machines
+	for _, machine := range machines {
+		if machine.Changed {
+			status.Changed++
+		}
+		if machine.RestartStampChanged {
+			status.Restarted++
+		}
+		if machine.Drain {
+			status.Drained++
+		}
+	}
+	return status, nil
+}
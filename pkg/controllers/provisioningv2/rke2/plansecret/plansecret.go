@@ -68,6 +68,12 @@ func (h *handler) OnChange(key string, secret *corev1.Secret) (*corev1.Secret, e
 		}
 	}
 
+	if v, ok := node.PeriodicOutput["etcd-snapshot-sha256-local"]; ok && v.ExitCode == 0 && len(v.Stdout) > 0 {
+		if err := h.reconcileEtcdSnapshotDigests(secret, v.Stdout); err != nil {
+			logrus.Errorf("[plansecret] error reconciling snapshot digests for secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+	}
+
 	appliedChecksum := string(secret.Data["applied-checksum"])
 	failedChecksum := string(secret.Data["failed-checksum"])
 	plan := secret.Data["plan"]
@@ -245,6 +251,73 @@ func (h *handler) reconcileEtcdSnapshotList(secret *corev1.Secret, s3 bool, list
 	return nil
 }
 
+// reconcileEtcdSnapshotDigests records the digests of the local snapshots of a node on their etcd snapshots, and on the
+// etcd snapshots of their copies in S3. A digest is only recorded once, so that a snapshot that changes later on fails
+// the check before it's restored.
+func (h *handler) reconcileEtcdSnapshotDigests(secret *corev1.Secret, sha256Stdout []byte) error {
+	cnl := secret.Labels[rke2.ClusterNameLabel]
+	if len(cnl) == 0 {
+		return fmt.Errorf("node secret did not have label %s", rke2.ClusterNameLabel)
+	}
+
+	machineName, ok := secret.Labels[rke2.MachineNameLabel]
+	if !ok {
+		return fmt.Errorf("did not find machine label on secret %s/%s", secret.Namespace, secret.Name)
+	}
+	machine, err := h.machinesCache.Get(secret.Namespace, machineName)
+	if err != nil {
+		return err
+	}
+	machineID := machine.Labels[rke2.MachineIDLabel]
+	if machineID == "" {
+		return fmt.Errorf("error finding machine ID for machine %s/%s", machine.Namespace, machine.Name)
+	}
+
+	digests := outputToEtcdSnapshotDigests(sha256Stdout)
+	etcdSnapshots, err := h.etcdSnapshotsCache.List(secret.Namespace, labels.SelectorFromSet(map[string]string{
+		rke2.ClusterNameLabel: cnl,
+	}))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range etcdSnapshots {
+		if v.SnapshotFile.SHA256 != "" || (v.SnapshotFile.S3 == nil && v.Labels[rke2.MachineIDLabel] != machineID) {
+			continue
+		}
+		digest, ok := digests[snapshotKey(v.SnapshotFile.Name)]
+		if !ok {
+			continue
+		}
+		v = v.DeepCopy()
+		v.SnapshotFile.SHA256 = digest
+		logrus.Debugf("[plansecret] machine %s/%s: recording SHA-256 digest of etcd snapshot %s/%s", machine.Namespace, machine.Name, v.Namespace, v.Name)
+		if _, err := h.etcdSnapshotsClient.Update(v); err != nil {
+			return fmt.Errorf("error while recording digest of etcd snapshot %s/%s: %w", v.Namespace, v.Name, err)
+		}
+	}
+	return nil
+}
+
+// outputToEtcdSnapshotDigests parses the output of sha256sum into the digests of snapshots, by snapshotKey.
+func outputToEtcdSnapshotDigests(collectedOutput []byte) map[string]string {
+	scanner := bufio.NewScanner(bytes.NewBuffer(collectedOutput))
+	digests := map[string]string{}
+	for scanner.Scan() {
+		s := strings.Fields(scanner.Text())
+		if len(s) != 2 || len(s[0]) != 64 || strings.Trim(s[0], "0123456789abcdef") != "" {
+			continue
+		}
+		digests[snapshotKey(strings.TrimPrefix(s[1], "*"))] = s[0]
+	}
+	return digests
+}
+
+// snapshotKey is the name of a snapshot the way it's stored in etcd snapshots created from snapshot lists.
+func snapshotKey(name string) string {
+	return strings.ToLower(sb.InvalidKeyChars.ReplaceAllString(name, "-"))
+}
+
 type snapshot struct {
 	Name     string
 	Location string
@@ -0,0 +1,19 @@
+package plansecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputToEtcdSnapshotDigests(t *testing.T) {
+	output := []byte(`16a0eeb0791b6c92451fd284dd9f599e0a7dbe7f6ebea6e2d2d06c7f74aec112  etcd-snapshot-Node_1-1664582400
+5bd9a8a8a4bd0ab4de1e3e63e8d3d0ef2d0e11d6b6e9c2e0c1b9a5b2e31b9f6c *on-demand-node1-1664582460.zip
+sha256sum: etcd-snapshot-node1-1664582520: Permission denied
+16a0eeb0  truncated
+`)
+	assert.Equal(t, map[string]string{
+		"etcd-snapshot-node-1-1664582400": "16a0eeb0791b6c92451fd284dd9f599e0a7dbe7f6ebea6e2d2d06c7f74aec112",
+		"on-demand-node1-1664582460.zip":  "5bd9a8a8a4bd0ab4de1e3e63e8d3d0ef2d0e11d6b6e9c2e0c1b9a5b2e31b9f6c",
+	}, outputToEtcdSnapshotDigests(output))
+}
@@ -0,0 +1,66 @@
+package restoredrill
+
+import (
+	"context"
+	"fmt"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// smokeChecks checks that the API server of the restored cluster answers, that enough of its nodes are ready and that
+// the namespaces of the drill were restored.
+func smokeChecks(ctx context.Context, client kubernetes.Interface, spec rkev1.ETCDRestoreDrillSpec) ([]rkev1.ETCDRestoreDrillCheck, bool) {
+	version, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return []rkev1.ETCDRestoreDrillCheck{{Name: "api", Message: err.Error()}}, false
+	}
+	checks := []rkev1.ETCDRestoreDrillCheck{{Name: "api", Passed: true, Message: version.GitVersion}}
+
+	minReadyNodes := spec.MinReadyNodes
+	if minReadyNodes <= 0 {
+		minReadyNodes = defaultMinReadyNodes
+	}
+	nodes := rkev1.ETCDRestoreDrillCheck{Name: "nodes"}
+	if list, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); err != nil {
+		nodes.Message = err.Error()
+	} else {
+		ready := 0
+		for _, node := range list.Items {
+			if nodeReady(&node) {
+				ready++
+			}
+		}
+		nodes.Passed = ready >= minReadyNodes
+		nodes.Message = fmt.Sprintf("%d of %d nodes are ready, %d required", ready, len(list.Items), minReadyNodes)
+	}
+	checks = append(checks, nodes)
+
+	for _, namespace := range spec.Namespaces {
+		check := rkev1.ETCDRestoreDrillCheck{Name: "namespace/" + namespace}
+		if _, err := client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{}); err != nil {
+			check.Message = err.Error()
+		} else {
+			check.Passed = true
+		}
+		checks = append(checks, check)
+	}
+
+	for _, check := range checks {
+		if !check.Passed {
+			return checks, false
+		}
+	}
+	return checks, true
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,463 @@
+// Package restoredrill runs ETCDRestoreDrills: it restores the newest S3 snapshot of a cluster into a scratch cluster
+// that is provisioned with the machine pools of the cluster, with the etcd restore of the planner, checks the restored
+// cluster and removes the scratch cluster again. The planner removes the Rancher agents of the cluster, which are
+// restored with the snapshot, before the scratch cluster is started.
+package restoredrill
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	provisioningcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/provisioningv2/kubeconfig"
+	"github.com/rancher/rancher/pkg/wrangler"
+	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"github.com/rancher/wrangler/pkg/name"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DrillLabel is set on the scratch clusters of a drill to the name of the drill.
+	DrillLabel = "rke.cattle.io/etcd-restore-drill"
+
+	defaultTimeout          = time.Hour
+	defaultMinReadyNodes    = 1
+	defaultRunsHistoryLimit = 10
+	pollInterval            = 30 * time.Second
+	checkTimeout            = 30 * time.Second
+)
+
+type handler struct {
+	ctx               context.Context
+	drills            rkecontrollers.ETCDRestoreDrillController
+	clusterCache      provisioningcontrollers.ClusterCache
+	clusters          provisioningcontrollers.ClusterClient
+	controlPlaneCache rkecontrollers.RKEControlPlaneCache
+	etcdSnapshotCache rkecontrollers.ETCDSnapshotCache
+	secretCache       corecontrollers.SecretCache
+	secrets           corecontrollers.SecretClient
+	clientFor         func(cluster *provv1.Cluster) (kubernetes.Interface, error)
+	now               func() time.Time
+}
+
+func Register(ctx context.Context, clients *wrangler.Context, kubeconfigManager *kubeconfig.Manager) {
+	h := &handler{
+		ctx:               ctx,
+		drills:            clients.RKE.ETCDRestoreDrill(),
+		clusterCache:      clients.Provisioning.Cluster().Cache(),
+		clusters:          clients.Provisioning.Cluster(),
+		controlPlaneCache: clients.RKE.RKEControlPlane().Cache(),
+		etcdSnapshotCache: clients.RKE.ETCDSnapshot().Cache(),
+		secretCache:       clients.Core.Secret().Cache(),
+		secrets:           clients.Core.Secret(),
+		clientFor: func(cluster *provv1.Cluster) (kubernetes.Interface, error) {
+			restConfig, err := kubeconfigManager.GetRESTConfig(cluster, cluster.Status)
+			if err != nil {
+				return nil, err
+			}
+			restConfig.Timeout = checkTimeout
+			return kubernetes.NewForConfig(restConfig)
+		},
+		now: time.Now,
+	}
+
+	clients.RKE.ETCDRestoreDrill().OnChange(ctx, "etcd-restore-drill", h.OnChange)
+}
+
+func (h *handler) OnChange(_ string, drill *rkev1.ETCDRestoreDrill) (*rkev1.ETCDRestoreDrill, error) {
+	if drill == nil || drill.DeletionTimestamp != nil {
+		// the scratch cluster of a drill that is removed is garbage collected
+		return drill, nil
+	}
+
+	status := *drill.Status.DeepCopy()
+	var (
+		requeue time.Duration
+		err     error
+	)
+	switch status.Phase {
+	case rkev1.ETCDRestoreDrillPhaseProvisioning:
+		requeue, err = h.provision(drill, &status)
+	case rkev1.ETCDRestoreDrillPhaseRestoring:
+		requeue, err = h.restore(drill, &status)
+	case rkev1.ETCDRestoreDrillPhaseChecking:
+		requeue, err = h.check(drill, &status)
+	case rkev1.ETCDRestoreDrillPhaseTearingDown:
+		requeue, err = h.tearDown(drill, &status)
+	default:
+		requeue, err = h.schedule(drill, &status)
+	}
+
+	if !equality.Semantic.DeepEqual(drill.Status, status) {
+		drill = drill.DeepCopy()
+		drill.Status = status
+		var updateErr error
+		if drill, updateErr = h.drills.UpdateStatus(drill); updateErr != nil {
+			return drill, updateErr
+		}
+	}
+	if requeue > 0 {
+		h.drills.EnqueueAfter(drill.Namespace, drill.Name, requeue)
+	}
+	return drill, err
+}
+
+// schedule starts a run when the schedule of the drill is due: it creates the scratch cluster with the server token of
+// the source cluster, which the snapshot can only be restored with.
+func (h *handler) schedule(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (time.Duration, error) {
+	if drill.Spec.Paused {
+		return 0, nil
+	}
+	schedule, err := cron.ParseStandard(drill.Spec.Schedule)
+	if err != nil {
+		return 0, fmt.Errorf("etcd restore drill %s/%s has an invalid schedule [%s]: %w", drill.Namespace, drill.Name, drill.Spec.Schedule, err)
+	}
+	last := drill.CreationTimestamp.Time
+	if status.LastScheduleTime != nil {
+		last = status.LastScheduleTime.Time
+	}
+	now := h.now()
+	if next := schedule.Next(last.UTC()); now.Before(next) {
+		return next.Sub(now), nil
+	}
+
+	scratchName := scratchClusterName(drill)
+	if _, err := h.clusterCache.Get(drill.Namespace, scratchName); err == nil {
+		logrus.Infof("[restoredrill] etcd restore drill %s/%s: waiting for scratch cluster %s of the last run to be removed", drill.Namespace, drill.Name, scratchName)
+		return pollInterval, nil
+	} else if !apierrors.IsNotFound(err) {
+		return 0, err
+	}
+
+	// the run is only recorded once it started or failed, errors are retried without waiting for the next schedule
+	run := &rkev1.ETCDRestoreDrillRun{StartTime: &metav1.Time{Time: now}}
+	start := func() {
+		status.LastScheduleTime = &metav1.Time{Time: now}
+		status.Current = run
+	}
+
+	source, err := h.clusterCache.Get(drill.Namespace, drill.Spec.ClusterName)
+	if apierrors.IsNotFound(err) {
+		start()
+		h.finish(drill, status, false, fmt.Sprintf("cluster %s not found", drill.Spec.ClusterName))
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	if source.Spec.RKEConfig == nil || source.Spec.RKEConfig.ETCD == nil || source.Spec.RKEConfig.ETCD.S3 == nil {
+		start()
+		h.finish(drill, status, false, fmt.Sprintf("cluster %s doesn't store its etcd snapshots in S3", source.Name))
+		return 0, nil
+	}
+	if len(source.Spec.RKEConfig.MachinePools) == 0 {
+		start()
+		h.finish(drill, status, false, fmt.Sprintf("cluster %s has no machine pools to provision the scratch cluster with", source.Name))
+		return 0, nil
+	}
+	snapshot, err := h.newestS3Snapshot(source)
+	if err != nil {
+		return 0, err
+	}
+	if snapshot == nil {
+		start()
+		h.finish(drill, status, false, fmt.Sprintf("cluster %s has no successful etcd snapshot in S3", source.Name))
+		return 0, nil
+	}
+	run.SnapshotName = snapshot.Name
+
+	if err := h.copyTokens(drill, source.Name, scratchName); err != nil {
+		return 0, err
+	}
+	if _, err := h.clusters.Create(newScratchCluster(drill, source, scratchName)); err != nil && !apierrors.IsAlreadyExists(err) {
+		return 0, err
+	}
+	start()
+	logrus.Infof("[restoredrill] etcd restore drill %s/%s: restoring etcd snapshot %s into scratch cluster %s", drill.Namespace, drill.Name, snapshot.Name, scratchName)
+	status.Phase = rkev1.ETCDRestoreDrillPhaseProvisioning
+	status.ScratchClusterName = scratchName
+	return pollInterval, nil
+}
+
+// provision waits for the nodes of the scratch cluster to be registered and the cluster to be ready, and then starts the
+// restore of the snapshot.
+func (h *handler) provision(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (time.Duration, error) {
+	cluster, err := h.scratchCluster(drill, status)
+	if err != nil || cluster == nil {
+		return 0, err
+	}
+	if !cluster.Status.Ready {
+		return h.waitOrTimeOut(drill, status, "the scratch cluster to be ready")
+	}
+
+	cluster = cluster.DeepCopy()
+	cluster.Spec.RKEConfig.ETCDSnapshotRestore = &rkev1.ETCDSnapshotRestore{
+		Name:             status.Current.SnapshotName,
+		Generation:       1,
+		RestoreRKEConfig: "none",
+	}
+	if _, err := h.clusters.Update(cluster); err != nil {
+		return 0, err
+	}
+	status.Phase = rkev1.ETCDRestoreDrillPhaseRestoring
+	status.Current.ProvisionedTime = &metav1.Time{Time: h.now()}
+	return pollInterval, nil
+}
+
+// restore waits for the planner to finish restoring the snapshot into the scratch cluster.
+func (h *handler) restore(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (time.Duration, error) {
+	cluster, err := h.scratchCluster(drill, status)
+	if err != nil || cluster == nil {
+		return 0, err
+	}
+	controlPlane, err := h.controlPlaneCache.Get(cluster.Namespace, cluster.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return 0, err
+	}
+	if controlPlane != nil && controlPlane.Status.ETCDSnapshotRestore != nil &&
+		controlPlane.Status.ETCDSnapshotRestore.Name == status.Current.SnapshotName {
+		switch controlPlane.Status.ETCDSnapshotRestorePhase {
+		case rkev1.ETCDSnapshotPhaseFinished:
+			status.Phase = rkev1.ETCDRestoreDrillPhaseChecking
+			status.Current.RestoredTime = &metav1.Time{Time: h.now()}
+			return pollInterval, nil
+		case rkev1.ETCDSnapshotPhaseFailed:
+			h.finish(drill, status, false, fmt.Sprintf("restoring etcd snapshot %s failed", status.Current.SnapshotName))
+			return pollInterval, nil
+		}
+	}
+	return h.waitOrTimeOut(drill, status, "the etcd snapshot to be restored")
+}
+
+// check runs the smoke checks against the restored cluster until they pass or the run times out.
+func (h *handler) check(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (time.Duration, error) {
+	cluster, err := h.scratchCluster(drill, status)
+	if err != nil || cluster == nil {
+		return 0, err
+	}
+	client, err := h.clientFor(cluster)
+	if err != nil {
+		status.Current.Checks = []rkev1.ETCDRestoreDrillCheck{{Name: "api", Message: err.Error()}}
+		return h.waitOrTimeOut(drill, status, "the checks to pass")
+	}
+	checks, passed := smokeChecks(h.ctx, client, drill.Spec)
+	status.Current.Checks = checks
+	if !passed {
+		return h.waitOrTimeOut(drill, status, "the checks to pass")
+	}
+	h.finish(drill, status, true, fmt.Sprintf("restored etcd snapshot %s in %s", status.Current.SnapshotName,
+		h.now().Sub(status.Current.StartTime.Time).Round(time.Second)))
+	return pollInterval, nil
+}
+
+// tearDown removes the scratch cluster and its tokens, and records the run once the cluster is gone.
+func (h *handler) tearDown(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (time.Duration, error) {
+	if status.ScratchClusterName != "" {
+		cluster, err := h.clusterCache.Get(drill.Namespace, status.ScratchClusterName)
+		if err == nil {
+			if cluster.DeletionTimestamp == nil {
+				if err := h.clusters.Delete(cluster.Namespace, cluster.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return 0, err
+				}
+			}
+			return pollInterval / 2, nil
+		} else if !apierrors.IsNotFound(err) {
+			return 0, err
+		}
+		if err := h.secrets.Delete(drill.Namespace, tokensSecretName(status.ScratchClusterName), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return 0, err
+		}
+	}
+
+	if status.Current != nil {
+		logrus.Infof("[restoredrill] etcd restore drill %s/%s: run finished, passed: %t: %s", drill.Namespace, drill.Name, status.Current.Passed, status.Current.Message)
+		status.Runs = append([]rkev1.ETCDRestoreDrillRun{*status.Current}, status.Runs...)
+		limit := drill.Spec.RunsHistoryLimit
+		if limit <= 0 {
+			limit = defaultRunsHistoryLimit
+		}
+		if len(status.Runs) > limit {
+			status.Runs = status.Runs[:limit]
+		}
+	}
+	status.Current = nil
+	status.Phase = ""
+	status.ScratchClusterName = ""
+	return 0, nil
+}
+
+// finish records the result of the current run, which is then torn down.
+func (h *handler) finish(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus, passed bool, message string) {
+	if !passed {
+		logrus.Warnf("[restoredrill] etcd restore drill %s/%s: run failed: %s", drill.Namespace, drill.Name, message)
+	}
+	status.Current.Passed = passed
+	status.Current.Message = message
+	status.Current.CompletionTime = &metav1.Time{Time: h.now()}
+	status.Phase = rkev1.ETCDRestoreDrillPhaseTearingDown
+}
+
+// waitOrTimeOut fails the current run if it took longer than the timeout of the drill, and polls again otherwise.
+func (h *handler) waitOrTimeOut(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus, waitingFor string) (time.Duration, error) {
+	timeout := defaultTimeout
+	if drill.Spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(drill.Spec.TimeoutSeconds) * time.Second
+	}
+	if h.now().Sub(status.Current.StartTime.Time) > timeout {
+		h.finish(drill, status, false, fmt.Sprintf("timed out after %s waiting for %s", timeout, waitingFor))
+	}
+	return pollInterval, nil
+}
+
+// scratchCluster returns the scratch cluster of the current run, or fails the run if it is gone.
+func (h *handler) scratchCluster(drill *rkev1.ETCDRestoreDrill, status *rkev1.ETCDRestoreDrillStatus) (*provv1.Cluster, error) {
+	cluster, err := h.clusterCache.Get(drill.Namespace, status.ScratchClusterName)
+	if apierrors.IsNotFound(err) {
+		h.finish(drill, status, false, fmt.Sprintf("scratch cluster %s was removed", status.ScratchClusterName))
+		return nil, nil
+	}
+	return cluster, err
+}
+
+// newestS3Snapshot returns the newest successful snapshot of a cluster in S3, or nil if there is none.
+func (h *handler) newestS3Snapshot(cluster *provv1.Cluster) (*rkev1.ETCDSnapshot, error) {
+	snapshots, err := h.etcdSnapshotCache.List(cluster.Namespace, labels.SelectorFromSet(map[string]string{
+		rke2.ClusterNameLabel: cluster.Name,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*rkev1.ETCDSnapshot
+	for _, snapshot := range snapshots {
+		if snapshot.SnapshotFile.S3 != nil && snapshot.SnapshotFile.Status != "failed" && !snapshot.Status.Missing {
+			candidates = append(candidates, snapshot)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return snapshotCreated(candidates[i]).After(snapshotCreated(candidates[j]))
+	})
+	return candidates[0], nil
+}
+
+func snapshotCreated(snapshot *rkev1.ETCDSnapshot) time.Time {
+	if snapshot.SnapshotFile.CreatedAt != nil {
+		return snapshot.SnapshotFile.CreatedAt.Time
+	}
+	return snapshot.CreationTimestamp.Time
+}
+
+// copyTokens creates the cluster state secret of the scratch cluster with the tokens of the source cluster, the planner
+// uses an existing secret instead of generating new tokens.
+func (h *handler) copyTokens(drill *rkev1.ETCDRestoreDrill, sourceName, scratchName string) error {
+	source, err := h.secretCache.Get(drill.Namespace, tokensSecretName(sourceName))
+	if err != nil {
+		return fmt.Errorf("failed to get the tokens of cluster %s: %w", sourceName, err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tokensSecretName(scratchName),
+			Namespace:       drill.Namespace,
+			Labels:          map[string]string{DrillLabel: drill.Name},
+			OwnerReferences: []metav1.OwnerReference{drillOwnerRef(drill)},
+		},
+		Data: map[string][]byte{
+			"serverToken": source.Data["serverToken"],
+			"agentToken":  source.Data["agentToken"],
+		},
+		Type: source.Type,
+	}
+	_, err = h.secrets.Create(secret)
+	if apierrors.IsAlreadyExists(err) {
+		// left over from a run whose cluster was removed by someone else, the tokens of the source may have changed
+		existing, err := h.secrets.Get(secret.Namespace, secret.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		existing = existing.DeepCopy()
+		existing.Data = secret.Data
+		_, err = h.secrets.Update(existing)
+		return err
+	}
+	return err
+}
+
+// newScratchCluster returns a cluster with the Kubernetes version, configuration and machine pools of the source
+// cluster, so that its machines are provisioned with the node drivers of the source. It keeps the S3 configuration of
+// the source for the credential the snapshot is downloaded with, but doesn't take snapshots of its own.
+func newScratchCluster(drill *rkev1.ETCDRestoreDrill, source *provv1.Cluster, name string) *provv1.Cluster {
+	common := *source.Spec.RKEConfig.RKEClusterSpecCommon.DeepCopy()
+	common.ETCD = &rkev1.ETCD{
+		DisableSnapshots: true,
+		S3:               source.Spec.RKEConfig.ETCD.S3.DeepCopy(),
+	}
+	common.ProvisionGeneration = 0
+	// the snapshot is restored to a single node of each etcd and control plane pool, and a single worker
+	var machinePools []provv1.RKEMachinePool
+	hasWorker := false
+	for _, pool := range source.Spec.RKEConfig.MachinePools {
+		hasWorker = hasWorker || (pool.WorkerRole && (pool.EtcdRole || pool.ControlPlaneRole))
+	}
+	for _, pool := range source.Spec.RKEConfig.MachinePools {
+		pool := *pool.DeepCopy()
+		pool.Paused = false
+		quantity := int32(1)
+		if !pool.EtcdRole && !pool.ControlPlaneRole {
+			if hasWorker {
+				quantity = 0
+			}
+			hasWorker = true
+		}
+		pool.Quantity = &quantity
+		machinePools = append(machinePools, pool)
+	}
+	return &provv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       drill.Namespace,
+			Labels:          map[string]string{DrillLabel: drill.Name},
+			OwnerReferences: []metav1.OwnerReference{drillOwnerRef(drill)},
+		},
+		Spec: provv1.ClusterSpec{
+			KubernetesVersion:         source.Spec.KubernetesVersion,
+			CloudCredentialSecretName: source.Spec.CloudCredentialSecretName,
+			AgentEnvVars:              source.Spec.AgentEnvVars,
+			RKEConfig: &provv1.RKEConfig{
+				RKEClusterSpecCommon: common,
+				MachinePools:         machinePools,
+			},
+		},
+	}
+}
+
+func drillOwnerRef(drill *rkev1.ETCDRestoreDrill) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: rke2.RKEAPIVersion,
+		Kind:       "ETCDRestoreDrill",
+		Name:       drill.Name,
+		UID:        drill.UID,
+	}
+}
+
+func scratchClusterName(drill *rkev1.ETCDRestoreDrill) string {
+	return name.SafeConcatName(drill.Name, "scratch")
+}
+
+// tokensSecretName is the name of the cluster state secret of the planner.
+func tokensSecretName(clusterName string) string {
+	return name.SafeConcatName(clusterName, "rke", "state")
+}
@@ -0,0 +1,450 @@
+package restoredrill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	provv1 "github.com/rancher/rancher/pkg/apis/provisioning.cattle.io/v1"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	provisioningcontrollers "github.com/rancher/rancher/pkg/generated/controllers/provisioning.cattle.io/v1"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// The fakes embed the interfaces they implement, the methods the handler doesn't call aren't implemented.
+
+type fakeDrills struct {
+	rkecontrollers.ETCDRestoreDrillController
+	enqueued time.Duration
+}
+
+func (f *fakeDrills) UpdateStatus(drill *rkev1.ETCDRestoreDrill) (*rkev1.ETCDRestoreDrill, error) {
+	return drill, nil
+}
+
+func (f *fakeDrills) EnqueueAfter(_, _ string, duration time.Duration) {
+	f.enqueued = duration
+}
+
+type clusterStore map[string]*provv1.Cluster
+
+type fakeClusterCache struct {
+	provisioningcontrollers.ClusterCache
+	clusters clusterStore
+}
+
+func (f fakeClusterCache) Get(namespace, name string) (*provv1.Cluster, error) {
+	if cluster, ok := f.clusters[namespace+"/"+name]; ok {
+		return cluster, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, name)
+}
+
+type fakeClusterClient struct {
+	provisioningcontrollers.ClusterClient
+	clusters clusterStore
+}
+
+func (f fakeClusterClient) Create(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+	if _, ok := f.clusters[cluster.Namespace+"/"+cluster.Name]; ok {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "clusters"}, cluster.Name)
+	}
+	f.clusters[cluster.Namespace+"/"+cluster.Name] = cluster
+	return cluster, nil
+}
+
+func (f fakeClusterClient) Update(cluster *provv1.Cluster) (*provv1.Cluster, error) {
+	f.clusters[cluster.Namespace+"/"+cluster.Name] = cluster
+	return cluster, nil
+}
+
+func (f fakeClusterClient) Delete(namespace, name string, _ *metav1.DeleteOptions) error {
+	delete(f.clusters, namespace+"/"+name)
+	return nil
+}
+
+type secretStore map[string]*corev1.Secret
+
+type fakeSecretCache struct {
+	corecontrollers.SecretCache
+	secrets secretStore
+}
+
+func (f fakeSecretCache) Get(namespace, name string) (*corev1.Secret, error) {
+	if secret, ok := f.secrets[namespace+"/"+name]; ok {
+		return secret, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+type fakeSecretClient struct {
+	corecontrollers.SecretClient
+	secrets secretStore
+}
+
+func (f fakeSecretClient) Create(secret *corev1.Secret) (*corev1.Secret, error) {
+	if _, ok := f.secrets[secret.Namespace+"/"+secret.Name]; ok {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, secret.Name)
+	}
+	f.secrets[secret.Namespace+"/"+secret.Name] = secret
+	return secret, nil
+}
+
+func (f fakeSecretClient) Get(namespace, name string, _ metav1.GetOptions) (*corev1.Secret, error) {
+	return fakeSecretCache{secrets: f.secrets}.Get(namespace, name)
+}
+
+func (f fakeSecretClient) Update(secret *corev1.Secret) (*corev1.Secret, error) {
+	f.secrets[secret.Namespace+"/"+secret.Name] = secret
+	return secret, nil
+}
+
+func (f fakeSecretClient) Delete(namespace, name string, _ *metav1.DeleteOptions) error {
+	delete(f.secrets, namespace+"/"+name)
+	return nil
+}
+
+type fakeControlPlaneCache struct {
+	rkecontrollers.RKEControlPlaneCache
+	controlPlanes map[string]*rkev1.RKEControlPlane
+}
+
+func (f fakeControlPlaneCache) Get(namespace, name string) (*rkev1.RKEControlPlane, error) {
+	if controlPlane, ok := f.controlPlanes[namespace+"/"+name]; ok {
+		return controlPlane, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "rkecontrolplanes"}, name)
+}
+
+type fakeETCDSnapshotCache struct {
+	rkecontrollers.ETCDSnapshotCache
+	snapshots []*rkev1.ETCDSnapshot
+}
+
+func (f fakeETCDSnapshotCache) List(namespace string, selector labels.Selector) (result []*rkev1.ETCDSnapshot, _ error) {
+	for _, snapshot := range f.snapshots {
+		if snapshot.Namespace == namespace && selector.Matches(labels.Set(snapshot.Labels)) {
+			result = append(result, snapshot)
+		}
+	}
+	return result, nil
+}
+
+func newTestSnapshot(name string, created time.Time, s3 bool) *rkev1.ETCDSnapshot {
+	snapshot := &rkev1.ETCDSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "fleet-default",
+			Labels:    map[string]string{rke2.ClusterNameLabel: "prod"},
+		},
+		SnapshotFile: rkev1.ETCDSnapshotFile{
+			Name:      name,
+			CreatedAt: &metav1.Time{Time: created},
+			Status:    "successful",
+		},
+	}
+	if s3 {
+		snapshot.SnapshotFile.S3 = &rkev1.ETCDSnapshotS3{Bucket: "snapshots"}
+	}
+	return snapshot
+}
+
+type testHandler struct {
+	*handler
+	drills        *fakeDrills
+	clusters      clusterStore
+	secrets       secretStore
+	controlPlanes map[string]*rkev1.RKEControlPlane
+	now           time.Time
+}
+
+func newTestHandler(t *testing.T, downstream kubernetes.Interface) *testHandler {
+	created := time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC)
+	three := int32(3)
+	th := &testHandler{
+		drills: &fakeDrills{},
+		clusters: clusterStore{
+			"fleet-default/prod": {
+				ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "fleet-default"},
+				Spec: provv1.ClusterSpec{
+					KubernetesVersion: "v1.24.4+rke2r1",
+					RKEConfig: &provv1.RKEConfig{
+						RKEClusterSpecCommon: rkev1.RKEClusterSpecCommon{
+							ETCD: &rkev1.ETCD{
+								SnapshotScheduleCron: "0 */5 * * *",
+								S3:                   &rkev1.ETCDSnapshotS3{Bucket: "snapshots", CloudCredentialName: "cattle-global-data:cc-s3"},
+							},
+						},
+						MachinePools: []provv1.RKEMachinePool{{
+							Name:             "pool",
+							EtcdRole:         true,
+							ControlPlaneRole: true,
+							Quantity:         &three,
+							NodeConfig:       &corev1.ObjectReference{Kind: "Amazonec2Config", Name: "amazonec2-prod"},
+						}, {
+							Name:       "workers",
+							WorkerRole: true,
+							Quantity:   &three,
+							NodeConfig: &corev1.ObjectReference{Kind: "Amazonec2Config", Name: "amazonec2-prod"},
+						}, {
+							Name:       "gpu-workers",
+							WorkerRole: true,
+							Quantity:   &three,
+							NodeConfig: &corev1.ObjectReference{Kind: "Amazonec2Config", Name: "amazonec2-prod-gpu"},
+						}},
+					},
+					CloudCredentialSecretName: "cattle-global-data:cc-aws",
+				},
+			},
+		},
+		secrets: secretStore{
+			"fleet-default/prod-rke-state": {
+				ObjectMeta: metav1.ObjectMeta{Name: "prod-rke-state", Namespace: "fleet-default"},
+				Data:       map[string][]byte{"serverToken": []byte("server"), "agentToken": []byte("agent")},
+				Type:       "rke.cattle.io/cluster-state",
+			},
+		},
+		controlPlanes: map[string]*rkev1.RKEControlPlane{},
+		now:           created.Add(time.Hour + 30*time.Second),
+	}
+	th.handler = &handler{
+		ctx:               context.Background(),
+		drills:            th.drills,
+		clusterCache:      fakeClusterCache{clusters: th.clusters},
+		clusters:          fakeClusterClient{clusters: th.clusters},
+		controlPlaneCache: fakeControlPlaneCache{controlPlanes: th.controlPlanes},
+		etcdSnapshotCache: fakeETCDSnapshotCache{snapshots: []*rkev1.ETCDSnapshot{
+			newTestSnapshot("prod-etcd-snapshot-1", created.Add(-10*time.Hour), true),
+			newTestSnapshot("prod-etcd-snapshot-2", created.Add(-5*time.Hour), true),
+			newTestSnapshot("prod-etcd-snapshot-3", created, false),
+		}},
+		secretCache: fakeSecretCache{secrets: th.secrets},
+		secrets:     fakeSecretClient{secrets: th.secrets},
+		clientFor: func(cluster *provv1.Cluster) (kubernetes.Interface, error) {
+			assert.Equal(t, "drill-scratch", cluster.Name)
+			return downstream, nil
+		},
+		now: func() time.Time { return th.now },
+	}
+	return th
+}
+
+func newTestDrill() *rkev1.ETCDRestoreDrill {
+	return &rkev1.ETCDRestoreDrill{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "drill",
+			Namespace:         "fleet-default",
+			UID:               "drill-uid",
+			CreationTimestamp: metav1.Time{Time: time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC)},
+		},
+		Spec: rkev1.ETCDRestoreDrillSpec{
+			ClusterName: "prod",
+			Schedule:    "0 * * * *",
+			Namespaces:  []string{"payments"},
+		},
+	}
+}
+
+func readyNode(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}},
+	}
+}
+
+func TestRun(t *testing.T) {
+	downstream := fake.NewSimpleClientset(readyNode("node1"), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments"}})
+	th := newTestHandler(t, downstream)
+
+	drill, err := th.OnChange("", newTestDrill())
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseProvisioning, drill.Status.Phase)
+	assert.Equal(t, "drill-scratch", drill.Status.ScratchClusterName)
+	require.NotNil(t, drill.Status.Current)
+	assert.Equal(t, "prod-etcd-snapshot-2", drill.Status.Current.SnapshotName, "the newest snapshot in S3 is restored")
+
+	scratch := th.clusters["fleet-default/drill-scratch"]
+	require.NotNil(t, scratch)
+	assert.Equal(t, "v1.24.4+rke2r1", scratch.Spec.KubernetesVersion)
+	if assert.Len(t, scratch.Spec.RKEConfig.MachinePools, 3, "the machines of the scratch cluster are provisioned with the pools of the source") {
+		assert.Equal(t, "amazonec2-prod", scratch.Spec.RKEConfig.MachinePools[0].NodeConfig.Name)
+		assert.Equal(t, int32(1), *scratch.Spec.RKEConfig.MachinePools[0].Quantity)
+		assert.Equal(t, int32(1), *scratch.Spec.RKEConfig.MachinePools[1].Quantity)
+		assert.Equal(t, int32(0), *scratch.Spec.RKEConfig.MachinePools[2].Quantity, "a single worker is provisioned")
+	}
+	assert.Equal(t, "cattle-global-data:cc-aws", scratch.Spec.CloudCredentialSecretName)
+	assert.True(t, scratch.Spec.RKEConfig.ETCD.DisableSnapshots)
+	assert.Equal(t, "cattle-global-data:cc-s3", scratch.Spec.RKEConfig.ETCD.S3.CloudCredentialName)
+	assert.Equal(t, "drill", scratch.Labels[DrillLabel])
+	assert.Equal(t, []byte("server"), th.secrets["fleet-default/drill-scratch-rke-state"].Data["serverToken"],
+		"the snapshot can only be restored with the token of the source cluster")
+
+	th.now = th.now.Add(5 * time.Minute)
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseProvisioning, drill.Status.Phase, "the scratch cluster isn't ready yet")
+	assert.Equal(t, pollInterval, th.drills.enqueued)
+
+	th.clusters["fleet-default/drill-scratch"].Status.Ready = true
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseRestoring, drill.Status.Phase)
+	assert.Equal(t, &rkev1.ETCDSnapshotRestore{Name: "prod-etcd-snapshot-2", Generation: 1, RestoreRKEConfig: "none"},
+		th.clusters["fleet-default/drill-scratch"].Spec.RKEConfig.ETCDSnapshotRestore)
+
+	th.controlPlanes["fleet-default/drill-scratch"] = &rkev1.RKEControlPlane{Status: rkev1.RKEControlPlaneStatus{
+		ETCDSnapshotRestore:      &rkev1.ETCDSnapshotRestore{Name: "prod-etcd-snapshot-2", Generation: 1},
+		ETCDSnapshotRestorePhase: rkev1.ETCDSnapshotPhaseFinished,
+	}}
+	th.now = th.now.Add(5 * time.Minute)
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseChecking, drill.Status.Phase)
+
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseTearingDown, drill.Status.Phase)
+	assert.True(t, drill.Status.Current.Passed)
+	assert.Equal(t, "restored etcd snapshot prod-etcd-snapshot-2 in 10m0s", drill.Status.Current.Message)
+	assert.Len(t, drill.Status.Current.Checks, 3)
+
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.NotContains(t, th.clusters, "fleet-default/drill-scratch")
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.NotContains(t, th.secrets, "fleet-default/drill-scratch-rke-state")
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhase(""), drill.Status.Phase)
+	assert.Nil(t, drill.Status.Current)
+	require.Len(t, drill.Status.Runs, 1)
+	run := drill.Status.Runs[0]
+	assert.True(t, run.Passed)
+	assert.Equal(t, th.now.Add(-10*time.Minute), run.StartTime.Time)
+	assert.Equal(t, th.now.Add(-5*time.Minute), run.ProvisionedTime.Time)
+	assert.Equal(t, th.now, run.RestoredTime.Time)
+	assert.Equal(t, th.now, run.CompletionTime.Time)
+
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhase(""), drill.Status.Phase, "the next run is at noon")
+	assert.Equal(t, 49*time.Minute+30*time.Second, th.drills.enqueued)
+}
+
+func TestRunFails(t *testing.T) {
+	downstream := fake.NewSimpleClientset(readyNode("node1"))
+	th := newTestHandler(t, downstream)
+
+	drill, err := th.OnChange("", newTestDrill())
+	require.NoError(t, err)
+	th.clusters["fleet-default/drill-scratch"].Status.Ready = true
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	th.controlPlanes["fleet-default/drill-scratch"] = &rkev1.RKEControlPlane{Status: rkev1.RKEControlPlaneStatus{
+		ETCDSnapshotRestore:      &rkev1.ETCDSnapshotRestore{Name: "prod-etcd-snapshot-2", Generation: 1},
+		ETCDSnapshotRestorePhase: rkev1.ETCDSnapshotPhaseFinished,
+	}}
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseChecking, drill.Status.Phase, "the checks are retried")
+	require.Len(t, drill.Status.Current.Checks, 3)
+	assert.False(t, drill.Status.Current.Checks[2].Passed, "the namespace wasn't restored")
+
+	th.now = th.now.Add(2 * time.Hour)
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseTearingDown, drill.Status.Phase)
+	assert.False(t, drill.Status.Current.Passed)
+	assert.Equal(t, "timed out after 1h0m0s waiting for the checks to pass", drill.Status.Current.Message)
+}
+
+type failingClusterClient struct {
+	fakeClusterClient
+}
+
+func (f failingClusterClient) Create(*provv1.Cluster) (*provv1.Cluster, error) {
+	return nil, apierrors.NewServiceUnavailable("unavailable")
+}
+
+func TestRunRetriesCreate(t *testing.T) {
+	th := newTestHandler(t, nil)
+	th.handler.clusters = failingClusterClient{}
+
+	drill, err := th.OnChange("", newTestDrill())
+	assert.Error(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhase(""), drill.Status.Phase)
+	assert.Nil(t, drill.Status.LastScheduleTime, "the run is retried without waiting for the next schedule")
+	assert.Nil(t, drill.Status.Current)
+
+	th.handler.clusters = fakeClusterClient{clusters: th.clusters}
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseProvisioning, drill.Status.Phase)
+	assert.Equal(t, th.now, drill.Status.LastScheduleTime.Time)
+	require.NotNil(t, drill.Status.Current)
+	assert.Equal(t, "prod-etcd-snapshot-2", drill.Status.Current.SnapshotName)
+}
+
+func TestRunWithoutSnapshot(t *testing.T) {
+	th := newTestHandler(t, nil)
+	th.etcdSnapshotCache = fakeETCDSnapshotCache{}
+
+	drill, err := th.OnChange("", newTestDrill())
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseTearingDown, drill.Status.Phase)
+	assert.Equal(t, "cluster prod has no successful etcd snapshot in S3", drill.Status.Current.Message)
+	assert.NotContains(t, th.clusters, "fleet-default/drill-scratch")
+
+	drill, err = th.OnChange("", drill)
+	require.NoError(t, err)
+	require.Len(t, drill.Status.Runs, 1)
+	assert.False(t, drill.Status.Runs[0].Passed)
+}
+
+func TestRunWithoutMachinePools(t *testing.T) {
+	th := newTestHandler(t, nil)
+	th.clusters["fleet-default/prod"].Spec.RKEConfig.MachinePools = nil
+
+	drill, err := th.OnChange("", newTestDrill())
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhaseTearingDown, drill.Status.Phase)
+	assert.Equal(t, "cluster prod has no machine pools to provision the scratch cluster with", drill.Status.Current.Message)
+	assert.NotContains(t, th.clusters, "fleet-default/drill-scratch")
+}
+
+func TestSchedule(t *testing.T) {
+	th := newTestHandler(t, nil)
+	th.now = time.Date(2022, 10, 1, 10, 59, 0, 0, time.UTC)
+
+	drill, err := th.OnChange("", newTestDrill())
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhase(""), drill.Status.Phase)
+	assert.Equal(t, time.Minute, th.drills.enqueued)
+
+	paused := newTestDrill()
+	paused.Spec.Paused = true
+	th.now = th.now.Add(time.Hour)
+	drill, err = th.OnChange("", paused)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.ETCDRestoreDrillPhase(""), drill.Status.Phase)
+
+	invalid := newTestDrill()
+	invalid.Spec.Schedule = "every hour"
+	_, err = th.OnChange("", invalid)
+	assert.Error(t, err)
+}
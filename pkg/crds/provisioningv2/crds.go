@@ -110,6 +110,19 @@ func rke2() []crd.CRD {
 			}
 			return clusterIndexed(c)
 		}),
+		newRKECRD(&rkev1.ETCDRestoreDrill{}, func(c crd.CRD) crd.CRD {
+			return c.
+				WithColumn("Cluster", ".spec.clusterName").
+				WithColumn("Schedule", ".spec.schedule").
+				WithColumn("Phase", ".status.phase")
+		}),
+		newRKECRD(&rkev1.RKEPlanPreview{}, func(c crd.CRD) crd.CRD {
+			return c.
+				WithColumn("Cluster", ".spec.clusterName").
+				WithColumn("Changed", ".status.changed").
+				WithColumn("Restarted", ".status.restarted").
+				WithColumn("Drained", ".status.drained")
+		}),
 	}
 }
 
@@ -0,0 +1,70 @@
+// Package etcdretention decides which etcd snapshots to keep with grandfather-father-son retention, for both RKE1
+// backups and RKE2 snapshots.
+package etcdretention
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Policy is how many hourly, daily, weekly and monthly snapshots are kept. The snapshot of a period is the newest
+// snapshot taken in it, periods are in UTC and weeks start on Monday.
+type Policy struct {
+	Hourly  int
+	Daily   int
+	Weekly  int
+	Monthly int
+}
+
+// Enabled returns whether a policy keeps any snapshots. Policies that don't must not be enforced, they would remove
+// every snapshot.
+func (p Policy) Enabled() bool {
+	return p.Hourly > 0 || p.Daily > 0 || p.Weekly > 0 || p.Monthly > 0
+}
+
+type tier struct {
+	count  int
+	period func(time.Time) string
+}
+
+// Keep returns which of the snapshots taken at the given times are kept: the snapshots of the newest Hourly hours,
+// Daily days, Weekly weeks and Monthly months that have a snapshot. Periods without a snapshot don't count, so that
+// a cluster that was down doesn't lose its older snapshots.
+func Keep(policy Policy, created []time.Time) []bool {
+	// newest first
+	order := make([]int, len(created))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return created[order[i]].After(created[order[j]])
+	})
+
+	keep := make([]bool, len(created))
+	for _, tier := range []tier{
+		{policy.Hourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{policy.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{policy.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{policy.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+	} {
+		if tier.count <= 0 {
+			continue
+		}
+		seen := map[string]bool{}
+		for _, i := range order {
+			if len(seen) == tier.count {
+				break
+			}
+			period := tier.period(created[i].UTC())
+			if !seen[period] {
+				seen[period] = true
+				keep[i] = true
+			}
+		}
+	}
+	return keep
+}
@@ -0,0 +1,69 @@
+package etcdretention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func kept(policy Policy, created []time.Time) []string {
+	var result []string
+	for i, keep := range Keep(policy, created) {
+		if keep {
+			result = append(result, created[i].Format(time.RFC3339))
+		}
+	}
+	return result
+}
+
+func TestKeep(t *testing.T) {
+	// a snapshot every 6 hours for 10 weeks, from Monday 2022-08-01 to Sunday 2022-10-09
+	start := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+	var created []time.Time
+	for t := start; t.Before(start.AddDate(0, 0, 70)); t = t.Add(6 * time.Hour) {
+		created = append(created, t)
+	}
+	// out of order, like lists of snapshots are
+	created[0], created[len(created)-1] = created[len(created)-1], created[0]
+
+	assert.ElementsMatch(t, []string{
+		"2022-10-09T18:00:00Z",
+		"2022-10-09T12:00:00Z",
+		"2022-10-09T06:00:00Z",
+	}, kept(Policy{Hourly: 3}, created), "every snapshot is in an hour of its own")
+
+	assert.ElementsMatch(t, []string{
+		"2022-10-09T18:00:00Z",
+		"2022-10-08T18:00:00Z",
+	}, kept(Policy{Daily: 2}, created), "the newest snapshot of a day is kept")
+
+	assert.ElementsMatch(t, []string{
+		"2022-10-09T18:00:00Z", // week 40 and October
+		"2022-10-02T18:00:00Z", // week 39
+		"2022-09-30T18:00:00Z", // September
+		"2022-08-31T18:00:00Z", // August
+	}, kept(Policy{Weekly: 2, Monthly: 5}, created), "tiers share snapshots, and months without snapshots don't count")
+
+	assert.ElementsMatch(t, []string{
+		"2022-10-09T18:00:00Z",
+		"2022-10-09T12:00:00Z",
+		"2022-10-08T18:00:00Z",
+		"2022-10-02T18:00:00Z",
+	}, kept(Policy{Hourly: 2, Daily: 2, Weekly: 2}, created))
+
+	assert.Empty(t, kept(Policy{}, created))
+	assert.Empty(t, kept(Policy{Hourly: -1}, created))
+	assert.False(t, Policy{Hourly: -1}.Enabled())
+	assert.True(t, Policy{Monthly: 1}.Enabled())
+}
+
+func TestKeepTimeZones(t *testing.T) {
+	// 20:30 in New York on October 1st is already October 2nd in UTC, 19:30 isn't
+	newYork := time.FixedZone("EDT", -4*60*60)
+	created := []time.Time{
+		time.Date(2022, 10, 1, 19, 30, 0, 0, newYork),
+		time.Date(2022, 10, 1, 20, 30, 0, 0, newYork),
+	}
+	assert.Equal(t, []bool{true, true}, Keep(Policy{Daily: 2}, created))
+}
@@ -32,6 +32,10 @@ func (c *FakeRkeV1) CustomMachines(namespace string) v1.CustomMachineInterface {
 	return &FakeCustomMachines{c, namespace}
 }
 
+func (c *FakeRkeV1) ETCDRestoreDrills(namespace string) v1.ETCDRestoreDrillInterface {
+	return &FakeETCDRestoreDrills{c, namespace}
+}
+
 func (c *FakeRkeV1) ETCDSnapshots(namespace string) v1.ETCDSnapshotInterface {
 	return &FakeETCDSnapshots{c, namespace}
 }
@@ -52,6 +56,10 @@ func (c *FakeRkeV1) RKEControlPlanes(namespace string) v1.RKEControlPlaneInterfa
 	return &FakeRKEControlPlanes{c, namespace}
 }
 
+func (c *FakeRkeV1) RKEPlanPreviews(namespace string) v1.RKEPlanPreviewInterface {
+	return &FakeRKEPlanPreviews{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRkeV1) RESTClient() rest.Interface {
@@ -20,6 +20,8 @@
 
 type CustomMachineExpansion interface{}
 
+type ETCDRestoreDrillExpansion interface{}
+
 type ETCDSnapshotExpansion interface{}
 
 type RKEBootstrapExpansion interface{}
@@ -29,3 +31,5 @@ type RKEBootstrapTemplateExpansion interface{
 type RKEClusterExpansion interface{}
 
 type RKEControlPlaneExpansion interface{}
+
+type RKEPlanPreviewExpansion interface{}
@@ -29,11 +29,13 @@
 type RkeV1Interface interface {
 	RESTClient() rest.Interface
 	CustomMachinesGetter
+	ETCDRestoreDrillsGetter
 	ETCDSnapshotsGetter
 	RKEBootstrapsGetter
 	RKEBootstrapTemplatesGetter
 	RKEClustersGetter
 	RKEControlPlanesGetter
+	RKEPlanPreviewsGetter
 }
 
 // RkeV1Client is used to interact with features provided by the rke.cattle.io group.
@@ -45,6 +47,10 @@ func (c *RkeV1Client) CustomMachines(namespace string) CustomMachineInterface {
 	return newCustomMachines(c, namespace)
 }
 
+func (c *RkeV1Client) ETCDRestoreDrills(namespace string) ETCDRestoreDrillInterface {
+	return newETCDRestoreDrills(c, namespace)
+}
+
 func (c *RkeV1Client) ETCDSnapshots(namespace string) ETCDSnapshotInterface {
 	return newETCDSnapshots(c, namespace)
 }
@@ -65,6 +71,10 @@ func (c *RkeV1Client) RKEControlPlanes(namespace string) RKEControlPlaneInterfac
 	return newRKEControlPlanes(c, namespace)
 }
 
+func (c *RkeV1Client) RKEPlanPreviews(namespace string) RKEPlanPreviewInterface {
+	return newRKEPlanPreviews(c, namespace)
+}
+
 // NewForConfig creates a new RkeV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -56,6 +56,7 @@ type Interface interface {
 	ClusterTemplateRevision() ClusterTemplateRevisionController
 	ComposeConfig() ComposeConfigController
 	DynamicSchema() DynamicSchemaController
+	ElevationRequest() ElevationRequestController
 	EtcdBackup() EtcdBackupController
 	Feature() FeatureController
 	FleetWorkspace() FleetWorkspaceController
@@ -94,6 +95,8 @@ type Interface interface {
 	ProjectMonitorGraph() ProjectMonitorGraphController
 	ProjectNetworkPolicy() ProjectNetworkPolicyController
 	ProjectRoleTemplateBinding() ProjectRoleTemplateBindingController
+	ProjectTemplate() ProjectTemplateController
+	ProjectTemplateRevision() ProjectTemplateRevisionController
 	RancherUserNotification() RancherUserNotificationController
 	RkeAddon() RkeAddonController
 	RkeK8sServiceOption() RkeK8sServiceOptionController
@@ -198,6 +201,9 @@ func (c *version) ComposeConfig() ComposeConfigController {
 func (c *version) DynamicSchema() DynamicSchemaController {
 	return NewDynamicSchemaController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "DynamicSchema"}, "dynamicschemas", false, c.controllerFactory)
 }
+func (c *version) ElevationRequest() ElevationRequestController {
+	return NewElevationRequestController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ElevationRequest"}, "elevationrequests", false, c.controllerFactory)
+}
 func (c *version) EtcdBackup() EtcdBackupController {
 	return NewEtcdBackupController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "EtcdBackup"}, "etcdbackups", true, c.controllerFactory)
 }
@@ -312,6 +318,14 @@ func (c *version) ProjectNetworkPolicy() ProjectNetworkPolicyController {
 func (c *version) ProjectRoleTemplateBinding() ProjectRoleTemplateBindingController {
 	return NewProjectRoleTemplateBindingController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectRoleTemplateBinding"}, "projectroletemplatebindings", true, c.controllerFactory)
 }
+
+func (c *version) ProjectTemplate() ProjectTemplateController {
+	return NewProjectTemplateController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectTemplate"}, "projecttemplates", true, c.controllerFactory)
+}
+
+func (c *version) ProjectTemplateRevision() ProjectTemplateRevisionController {
+	return NewProjectTemplateRevisionController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "ProjectTemplateRevision"}, "projecttemplaterevisions", true, c.controllerFactory)
+}
 func (c *version) RancherUserNotification() RancherUserNotificationController {
 	return NewRancherUserNotificationController(schema.GroupVersionKind{Group: "management.cattle.io", Version: "v3", Kind: "RancherUserNotification"}, "rancherusernotifications", false, c.controllerFactory)
 }
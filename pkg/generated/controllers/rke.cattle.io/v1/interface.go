@@ -31,11 +31,13 @@ func init() {
 
 type Interface interface {
 	CustomMachine() CustomMachineController
+	ETCDRestoreDrill() ETCDRestoreDrillController
 	ETCDSnapshot() ETCDSnapshotController
 	RKEBootstrap() RKEBootstrapController
 	RKEBootstrapTemplate() RKEBootstrapTemplateController
 	RKECluster() RKEClusterController
 	RKEControlPlane() RKEControlPlaneController
+	RKEPlanPreview() RKEPlanPreviewController
 }
 
 func New(controllerFactory controller.SharedControllerFactory) Interface {
@@ -51,6 +53,9 @@ type version struct {
 func (c *version) CustomMachine() CustomMachineController {
 	return NewCustomMachineController(schema.GroupVersionKind{Group: "rke.cattle.io", Version: "v1", Kind: "CustomMachine"}, "custommachines", true, c.controllerFactory)
 }
+func (c *version) ETCDRestoreDrill() ETCDRestoreDrillController {
+	return NewETCDRestoreDrillController(schema.GroupVersionKind{Group: "rke.cattle.io", Version: "v1", Kind: "ETCDRestoreDrill"}, "etcdrestoredrills", true, c.controllerFactory)
+}
 func (c *version) ETCDSnapshot() ETCDSnapshotController {
 	return NewETCDSnapshotController(schema.GroupVersionKind{Group: "rke.cattle.io", Version: "v1", Kind: "ETCDSnapshot"}, "etcdsnapshots", true, c.controllerFactory)
 }
@@ -66,3 +71,6 @@ func (c *version) RKECluster() RKEClusterController {
 func (c *version) RKEControlPlane() RKEControlPlaneController {
 	return NewRKEControlPlaneController(schema.GroupVersionKind{Group: "rke.cattle.io", Version: "v1", Kind: "RKEControlPlane"}, "rkecontrolplanes", true, c.controllerFactory)
 }
+func (c *version) RKEPlanPreview() RKEPlanPreviewController {
+	return NewRKEPlanPreviewController(schema.GroupVersionKind{Group: "rke.cattle.io", Version: "v1", Kind: "RKEPlanPreview"}, "rkeplanpreviews", true, c.controllerFactory)
+}
@@ -0,0 +1,325 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ElevationRequestGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ElevationRequest",
+	}
+	ElevationRequestResource = metav1.APIResource{
+		Name:         "elevationrequests",
+		SingularName: "elevationrequest",
+		Namespaced:   false,
+		Kind:         ElevationRequestGroupVersionKind.Kind,
+	}
+
+	ElevationRequestGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "elevationrequests",
+	}
+)
+
+func init() {
+	resource.Put(ElevationRequestGroupVersionResource)
+}
+
+// Deprecated: use v3.ElevationRequest instead
+type ElevationRequest = v3.ElevationRequest
+
+func NewElevationRequest(namespace, name string, obj v3.ElevationRequest) *v3.ElevationRequest {
+	obj.APIVersion, obj.Kind = ElevationRequestGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ElevationRequestHandlerFunc func(key string, obj *v3.ElevationRequest) (runtime.Object, error)
+
+type ElevationRequestChangeHandlerFunc func(obj *v3.ElevationRequest) (runtime.Object, error)
+
+type ElevationRequestLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ElevationRequest, err error)
+	Get(namespace, name string) (*v3.ElevationRequest, error)
+}
+
+type ElevationRequestController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ElevationRequestLister
+	AddHandler(ctx context.Context, name string, handler ElevationRequestHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ElevationRequestHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ElevationRequestHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ElevationRequestHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ElevationRequestInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ElevationRequest) (*v3.ElevationRequest, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ElevationRequest, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ElevationRequest, error)
+	Update(*v3.ElevationRequest) (*v3.ElevationRequest, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ElevationRequestList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ElevationRequestList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ElevationRequestController
+	AddHandler(ctx context.Context, name string, sync ElevationRequestHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ElevationRequestHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ElevationRequestLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ElevationRequestLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ElevationRequestHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ElevationRequestHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ElevationRequestLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ElevationRequestLifecycle)
+}
+
+type elevationRequestLister struct {
+	ns         string
+	controller *elevationRequestController
+}
+
+func (l *elevationRequestLister) List(namespace string, selector labels.Selector) (ret []*v3.ElevationRequest, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ElevationRequest))
+	})
+	return
+}
+
+func (l *elevationRequestLister) Get(namespace, name string) (*v3.ElevationRequest, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ElevationRequestGroupVersionKind.Group,
+			Resource: ElevationRequestGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ElevationRequest), nil
+}
+
+type elevationRequestController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *elevationRequestController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *elevationRequestController) Lister() ElevationRequestLister {
+	return &elevationRequestLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *elevationRequestController) AddHandler(ctx context.Context, name string, handler ElevationRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ElevationRequest); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *elevationRequestController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ElevationRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ElevationRequest); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *elevationRequestController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ElevationRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ElevationRequest); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *elevationRequestController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ElevationRequestHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ElevationRequest); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type elevationRequestFactory struct {
+}
+
+func (c elevationRequestFactory) Object() runtime.Object {
+	return &v3.ElevationRequest{}
+}
+
+func (c elevationRequestFactory) List() runtime.Object {
+	return &v3.ElevationRequestList{}
+}
+
+func (s *elevationRequestClient) Controller() ElevationRequestController {
+	genericController := controller.NewGenericController(s.ns, ElevationRequestGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ElevationRequestGroupVersionResource, ElevationRequestGroupVersionKind.Kind, false))
+
+	return &elevationRequestController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type elevationRequestClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ElevationRequestController
+}
+
+func (s *elevationRequestClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *elevationRequestClient) Create(o *v3.ElevationRequest) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) Get(name string, opts metav1.GetOptions) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) Update(o *v3.ElevationRequest) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) UpdateStatus(o *v3.ElevationRequest) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *elevationRequestClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *elevationRequestClient) List(opts metav1.ListOptions) (*v3.ElevationRequestList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ElevationRequestList), err
+}
+
+func (s *elevationRequestClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ElevationRequestList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ElevationRequestList), err
+}
+
+func (s *elevationRequestClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *elevationRequestClient) Patch(o *v3.ElevationRequest, patchType types.PatchType, data []byte, subresources ...string) (*v3.ElevationRequest, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ElevationRequest), err
+}
+
+func (s *elevationRequestClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *elevationRequestClient) AddHandler(ctx context.Context, name string, sync ElevationRequestHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *elevationRequestClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ElevationRequestHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *elevationRequestClient) AddLifecycle(ctx context.Context, name string, lifecycle ElevationRequestLifecycle) {
+	sync := NewElevationRequestLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *elevationRequestClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ElevationRequestLifecycle) {
+	sync := NewElevationRequestLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *elevationRequestClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ElevationRequestHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *elevationRequestClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ElevationRequestHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *elevationRequestClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ElevationRequestLifecycle) {
+	sync := NewElevationRequestLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *elevationRequestClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ElevationRequestLifecycle) {
+	sync := NewElevationRequestLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ElevationRequestLifecycle interface {
+	Create(obj *v3.ElevationRequest) (runtime.Object, error)
+	Remove(obj *v3.ElevationRequest) (runtime.Object, error)
+	Updated(obj *v3.ElevationRequest) (runtime.Object, error)
+}
+
+type elevationRequestLifecycleAdapter struct {
+	lifecycle ElevationRequestLifecycle
+}
+
+func (w *elevationRequestLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *elevationRequestLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *elevationRequestLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ElevationRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *elevationRequestLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ElevationRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *elevationRequestLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ElevationRequest))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewElevationRequestLifecycleAdapter(name string, clusterScoped bool, client ElevationRequestInterface, l ElevationRequestLifecycle) ElevationRequestHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ElevationRequestGroupVersionResource)
+	}
+	adapter := &elevationRequestLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ElevationRequest) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
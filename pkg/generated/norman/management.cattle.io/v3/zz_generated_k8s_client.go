@@ -23,6 +23,7 @@ type Interface interface {
 	PodSecurityPolicyTemplateProjectBindingsGetter
 	ClusterRoleTemplateBindingsGetter
 	ProjectRoleTemplateBindingsGetter
+	ElevationRequestsGetter
 	ClustersGetter
 	ClusterRegistrationTokensGetter
 	CatalogsGetter
@@ -70,6 +71,8 @@ type Interface interface {
 	CloudCredentialsGetter
 	ClusterTemplatesGetter
 	ClusterTemplateRevisionsGetter
+	ProjectTemplatesGetter
+	ProjectTemplateRevisionsGetter
 	RkeK8sSystemImagesGetter
 	RkeK8sServiceOptionsGetter
 	RkeAddonsGetter
@@ -281,6 +284,20 @@ func (c *Client) ProjectRoleTemplateBindings(namespace string) ProjectRoleTempla
 	}
 }
 
+type ElevationRequestsGetter interface {
+	ElevationRequests(namespace string) ElevationRequestInterface
+}
+
+func (c *Client) ElevationRequests(namespace string) ElevationRequestInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ElevationRequestGroupVersionResource, ElevationRequestGroupVersionKind.Kind, false)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ElevationRequestResource, ElevationRequestGroupVersionKind, elevationRequestFactory{})
+	return &elevationRequestClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ClustersGetter interface {
 	Clusters(namespace string) ClusterInterface
 }
@@ -939,6 +956,34 @@ func (c *Client) ClusterTemplateRevisions(namespace string) ClusterTemplateRevis
 	}
 }
 
+type ProjectTemplatesGetter interface {
+	ProjectTemplates(namespace string) ProjectTemplateInterface
+}
+
+func (c *Client) ProjectTemplates(namespace string) ProjectTemplateInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ProjectTemplateGroupVersionResource, ProjectTemplateGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ProjectTemplateResource, ProjectTemplateGroupVersionKind, projectTemplateFactory{})
+	return &projectTemplateClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ProjectTemplateRevisionsGetter interface {
+	ProjectTemplateRevisions(namespace string) ProjectTemplateRevisionInterface
+}
+
+func (c *Client) ProjectTemplateRevisions(namespace string) ProjectTemplateRevisionInterface {
+	sharedClient := c.clientFactory.ForResourceKind(ProjectTemplateRevisionGroupVersionResource, ProjectTemplateRevisionGroupVersionKind.Kind, true)
+	objectClient := objectclient.NewObjectClient(namespace, sharedClient, &ProjectTemplateRevisionResource, ProjectTemplateRevisionGroupVersionKind, projectTemplateRevisionFactory{})
+	return &projectTemplateRevisionClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type RkeK8sSystemImagesGetter interface {
 	RkeK8sSystemImages(namespace string) RkeK8sSystemImageInterface
 }
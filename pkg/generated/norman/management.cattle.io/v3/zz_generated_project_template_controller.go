@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ProjectTemplateGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ProjectTemplate",
+	}
+	ProjectTemplateResource = metav1.APIResource{
+		Name:         "projecttemplates",
+		SingularName: "projecttemplate",
+		Namespaced:   true,
+
+		Kind: ProjectTemplateGroupVersionKind.Kind,
+	}
+
+	ProjectTemplateGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "projecttemplates",
+	}
+)
+
+func init() {
+	resource.Put(ProjectTemplateGroupVersionResource)
+}
+
+// Deprecated: use v3.ProjectTemplate instead
+type ProjectTemplate = v3.ProjectTemplate
+
+func NewProjectTemplate(namespace, name string, obj v3.ProjectTemplate) *v3.ProjectTemplate {
+	obj.APIVersion, obj.Kind = ProjectTemplateGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ProjectTemplateHandlerFunc func(key string, obj *v3.ProjectTemplate) (runtime.Object, error)
+
+type ProjectTemplateChangeHandlerFunc func(obj *v3.ProjectTemplate) (runtime.Object, error)
+
+type ProjectTemplateLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ProjectTemplate, err error)
+	Get(namespace, name string) (*v3.ProjectTemplate, error)
+}
+
+type ProjectTemplateController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ProjectTemplateLister
+	AddHandler(ctx context.Context, name string, handler ProjectTemplateHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ProjectTemplateHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ProjectTemplateHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ProjectTemplateInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ProjectTemplate) (*v3.ProjectTemplate, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectTemplate, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ProjectTemplate, error)
+	Update(*v3.ProjectTemplate) (*v3.ProjectTemplate, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ProjectTemplateList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectTemplateList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ProjectTemplateController
+	AddHandler(ctx context.Context, name string, sync ProjectTemplateHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ProjectTemplateLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectTemplateLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectTemplateHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectTemplateHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectTemplateLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectTemplateLifecycle)
+}
+
+type projectTemplateLister struct {
+	ns         string
+	controller *projectTemplateController
+}
+
+func (l *projectTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectTemplate, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectTemplate))
+	})
+	return
+}
+
+func (l *projectTemplateLister) Get(namespace, name string) (*v3.ProjectTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ProjectTemplateGroupVersionKind.Group,
+			Resource: ProjectTemplateGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ProjectTemplate), nil
+}
+
+type projectTemplateController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *projectTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectTemplateController) Lister() ProjectTemplateLister {
+	return &projectTemplateLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *projectTemplateController) AddHandler(ctx context.Context, name string, handler ProjectTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ProjectTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ProjectTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ProjectTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectTemplateFactory struct {
+}
+
+func (c projectTemplateFactory) Object() runtime.Object {
+	return &v3.ProjectTemplate{}
+}
+
+func (c projectTemplateFactory) List() runtime.Object {
+	return &v3.ProjectTemplateList{}
+}
+
+func (s *projectTemplateClient) Controller() ProjectTemplateController {
+	genericController := controller.NewGenericController(s.ns, ProjectTemplateGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ProjectTemplateGroupVersionResource, ProjectTemplateGroupVersionKind.Kind, true))
+
+	return &projectTemplateController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type projectTemplateClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ProjectTemplateController
+}
+
+func (s *projectTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *projectTemplateClient) Create(o *v3.ProjectTemplate) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) Update(o *v3.ProjectTemplate) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) UpdateStatus(o *v3.ProjectTemplate) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *projectTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectTemplateClient) List(opts metav1.ListOptions) (*v3.ProjectTemplateList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ProjectTemplateList), err
+}
+
+func (s *projectTemplateClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectTemplateList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ProjectTemplateList), err
+}
+
+func (s *projectTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *projectTemplateClient) Patch(o *v3.ProjectTemplate, patchType types.PatchType, data []byte, subresources ...string) (*v3.ProjectTemplate, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ProjectTemplate), err
+}
+
+func (s *projectTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectTemplateClient) AddHandler(ctx context.Context, name string, sync ProjectTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle ProjectTemplateLifecycle) {
+	sync := NewProjectTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectTemplateLifecycle) {
+	sync := NewProjectTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectTemplateLifecycle) {
+	sync := NewProjectTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectTemplateLifecycle) {
+	sync := NewProjectTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
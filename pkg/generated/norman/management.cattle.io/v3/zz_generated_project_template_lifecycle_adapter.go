@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ProjectTemplateLifecycle interface {
+	Create(obj *v3.ProjectTemplate) (runtime.Object, error)
+	Remove(obj *v3.ProjectTemplate) (runtime.Object, error)
+	Updated(obj *v3.ProjectTemplate) (runtime.Object, error)
+}
+
+type projectTemplateLifecycleAdapter struct {
+	lifecycle ProjectTemplateLifecycle
+}
+
+func (w *projectTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewProjectTemplateLifecycleAdapter(name string, clusterScoped bool, client ProjectTemplateInterface, l ProjectTemplateLifecycle) ProjectTemplateHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ProjectTemplateGroupVersionResource)
+	}
+	adapter := &projectTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ProjectTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
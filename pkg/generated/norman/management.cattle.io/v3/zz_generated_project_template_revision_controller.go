@@ -0,0 +1,326 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ProjectTemplateRevisionGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ProjectTemplateRevision",
+	}
+	ProjectTemplateRevisionResource = metav1.APIResource{
+		Name:         "projecttemplaterevisions",
+		SingularName: "projecttemplaterevision",
+		Namespaced:   true,
+
+		Kind: ProjectTemplateRevisionGroupVersionKind.Kind,
+	}
+
+	ProjectTemplateRevisionGroupVersionResource = schema.GroupVersionResource{
+		Group:    GroupName,
+		Version:  Version,
+		Resource: "projecttemplaterevisions",
+	}
+)
+
+func init() {
+	resource.Put(ProjectTemplateRevisionGroupVersionResource)
+}
+
+// Deprecated: use v3.ProjectTemplateRevision instead
+type ProjectTemplateRevision = v3.ProjectTemplateRevision
+
+func NewProjectTemplateRevision(namespace, name string, obj v3.ProjectTemplateRevision) *v3.ProjectTemplateRevision {
+	obj.APIVersion, obj.Kind = ProjectTemplateRevisionGroupVersionKind.ToAPIVersionAndKind()
+	obj.Name = name
+	obj.Namespace = namespace
+	return &obj
+}
+
+type ProjectTemplateRevisionHandlerFunc func(key string, obj *v3.ProjectTemplateRevision) (runtime.Object, error)
+
+type ProjectTemplateRevisionChangeHandlerFunc func(obj *v3.ProjectTemplateRevision) (runtime.Object, error)
+
+type ProjectTemplateRevisionLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v3.ProjectTemplateRevision, err error)
+	Get(namespace, name string) (*v3.ProjectTemplateRevision, error)
+}
+
+type ProjectTemplateRevisionController interface {
+	Generic() controller.GenericController
+	Informer() cache.SharedIndexInformer
+	Lister() ProjectTemplateRevisionLister
+	AddHandler(ctx context.Context, name string, handler ProjectTemplateRevisionHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateRevisionHandlerFunc)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, handler ProjectTemplateRevisionHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, handler ProjectTemplateRevisionHandlerFunc)
+	Enqueue(namespace, name string)
+	EnqueueAfter(namespace, name string, after time.Duration)
+}
+
+type ProjectTemplateRevisionInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v3.ProjectTemplateRevision) (*v3.ProjectTemplateRevision, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectTemplateRevision, error)
+	Get(name string, opts metav1.GetOptions) (*v3.ProjectTemplateRevision, error)
+	Update(*v3.ProjectTemplateRevision) (*v3.ProjectTemplateRevision, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v3.ProjectTemplateRevisionList, error)
+	ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectTemplateRevisionList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ProjectTemplateRevisionController
+	AddHandler(ctx context.Context, name string, sync ProjectTemplateRevisionHandlerFunc)
+	AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateRevisionHandlerFunc)
+	AddLifecycle(ctx context.Context, name string, lifecycle ProjectTemplateRevisionLifecycle)
+	AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectTemplateRevisionLifecycle)
+	AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectTemplateRevisionHandlerFunc)
+	AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectTemplateRevisionHandlerFunc)
+	AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectTemplateRevisionLifecycle)
+	AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectTemplateRevisionLifecycle)
+}
+
+type projectTemplateRevisionLister struct {
+	ns         string
+	controller *projectTemplateRevisionController
+}
+
+func (l *projectTemplateRevisionLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectTemplateRevision, err error) {
+	if namespace == "" {
+		namespace = l.ns
+	}
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectTemplateRevision))
+	})
+	return
+}
+
+func (l *projectTemplateRevisionLister) Get(namespace, name string) (*v3.ProjectTemplateRevision, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ProjectTemplateRevisionGroupVersionKind.Group,
+			Resource: ProjectTemplateRevisionGroupVersionResource.Resource,
+		}, key)
+	}
+	return obj.(*v3.ProjectTemplateRevision), nil
+}
+
+type projectTemplateRevisionController struct {
+	ns string
+	controller.GenericController
+}
+
+func (c *projectTemplateRevisionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectTemplateRevisionController) Lister() ProjectTemplateRevisionLister {
+	return &projectTemplateRevisionLister{
+		ns:         c.ns,
+		controller: c,
+	}
+}
+
+func (c *projectTemplateRevisionController) AddHandler(ctx context.Context, name string, handler ProjectTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplateRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateRevisionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler ProjectTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplateRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateRevisionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler ProjectTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplateRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectTemplateRevisionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler ProjectTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectTemplateRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectTemplateRevisionFactory struct {
+}
+
+func (c projectTemplateRevisionFactory) Object() runtime.Object {
+	return &v3.ProjectTemplateRevision{}
+}
+
+func (c projectTemplateRevisionFactory) List() runtime.Object {
+	return &v3.ProjectTemplateRevisionList{}
+}
+
+func (s *projectTemplateRevisionClient) Controller() ProjectTemplateRevisionController {
+	genericController := controller.NewGenericController(s.ns, ProjectTemplateRevisionGroupVersionKind.Kind+"Controller",
+		s.client.controllerFactory.ForResourceKind(ProjectTemplateRevisionGroupVersionResource, ProjectTemplateRevisionGroupVersionKind.Kind, true))
+
+	return &projectTemplateRevisionController{
+		ns:                s.ns,
+		GenericController: genericController,
+	}
+}
+
+type projectTemplateRevisionClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ProjectTemplateRevisionController
+}
+
+func (s *projectTemplateRevisionClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *projectTemplateRevisionClient) Create(o *v3.ProjectTemplateRevision) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) Update(o *v3.ProjectTemplateRevision) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) UpdateStatus(o *v3.ProjectTemplateRevision) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.UpdateStatus(o.Name, o)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *projectTemplateRevisionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectTemplateRevisionClient) List(opts metav1.ListOptions) (*v3.ProjectTemplateRevisionList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*v3.ProjectTemplateRevisionList), err
+}
+
+func (s *projectTemplateRevisionClient) ListNamespaced(namespace string, opts metav1.ListOptions) (*v3.ProjectTemplateRevisionList, error) {
+	obj, err := s.objectClient.ListNamespaced(namespace, opts)
+	return obj.(*v3.ProjectTemplateRevisionList), err
+}
+
+func (s *projectTemplateRevisionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *projectTemplateRevisionClient) Patch(o *v3.ProjectTemplateRevision, patchType types.PatchType, data []byte, subresources ...string) (*v3.ProjectTemplateRevision, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, patchType, data, subresources...)
+	return obj.(*v3.ProjectTemplateRevision), err
+}
+
+func (s *projectTemplateRevisionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectTemplateRevisionClient) AddHandler(ctx context.Context, name string, sync ProjectTemplateRevisionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ProjectTemplateRevisionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddLifecycle(ctx context.Context, name string, lifecycle ProjectTemplateRevisionLifecycle) {
+	sync := NewProjectTemplateRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ProjectTemplateRevisionLifecycle) {
+	sync := NewProjectTemplateRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ProjectTemplateRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ProjectTemplateRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ProjectTemplateRevisionLifecycle) {
+	sync := NewProjectTemplateRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectTemplateRevisionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ProjectTemplateRevisionLifecycle) {
+	sync := NewProjectTemplateRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/resource"
+	"github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ProjectTemplateRevisionLifecycle interface {
+	Create(obj *v3.ProjectTemplateRevision) (runtime.Object, error)
+	Remove(obj *v3.ProjectTemplateRevision) (runtime.Object, error)
+	Updated(obj *v3.ProjectTemplateRevision) (runtime.Object, error)
+}
+
+type projectTemplateRevisionLifecycleAdapter struct {
+	lifecycle ProjectTemplateRevisionLifecycle
+}
+
+func (w *projectTemplateRevisionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectTemplateRevisionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectTemplateRevisionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectTemplateRevisionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectTemplateRevisionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewProjectTemplateRevisionLifecycleAdapter(name string, clusterScoped bool, client ProjectTemplateRevisionInterface, l ProjectTemplateRevisionLifecycle) ProjectTemplateRevisionHandlerFunc {
+	if clusterScoped {
+		resource.PutClusterScoped(ProjectTemplateRevisionGroupVersionResource)
+	}
+	adapter := &projectTemplateRevisionLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v3.ProjectTemplateRevision) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
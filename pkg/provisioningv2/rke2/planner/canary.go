@@ -0,0 +1,393 @@
+package planner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const waitingForCanariesMessage = "waiting for canary machines"
+
+// canaryRollout is the canary strategy of a cluster during a run of the planner. The rollouts of the tiers of the
+// cluster are kept in the status of its control plane.
+type canaryRollout struct {
+	strategy *rkev1.CanaryStrategy
+	selector labels.Selector
+	status   *rkev1.RKEControlPlaneStatus
+	now      time.Time
+	// query returns whether the health query of a tier whose soak period started at the given time is done, and its
+	// error if it failed.
+	query func(tier string, soakStart time.Time, gate *rkev1.CanaryQueryHealthGate) (bool, error)
+}
+
+// canaryTier is the rollout of the machines of a tier during a run of the planner.
+type canaryTier struct {
+	*canaryRollout
+	name     string
+	canaries []string
+	others   []string
+	// held are the other machines whose plan changes wait for the canaries, changed are the canaries whose plan
+	// changes, failed are the canaries whose plan failed to apply and degraded are the canaries that run their plan
+	// but aren't healthy.
+	held, changed, failed, degraded []string
+	// configured are the canaries that are excluded from the tier because an earlier tier configures them, like the
+	// init node.
+	configured map[string]bool
+}
+
+func newCanaryRollout(strategy *rkev1.CanaryStrategy, status *rkev1.RKEControlPlaneStatus, now time.Time) (*canaryRollout, error) {
+	c := &canaryRollout{strategy: strategy, status: status, now: now}
+	if strategy == nil {
+		status.CanaryRollouts = nil
+		return c, nil
+	}
+	if strategy.MachineSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(strategy.MachineSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid canary machine selector: %w", err)
+		}
+		if !selector.Empty() {
+			c.selector = selector
+		}
+	}
+	return c, nil
+}
+
+func (c *canaryRollout) isCanary(entry *planEntry) bool {
+	if c.strategy == nil {
+		return false
+	}
+	if c.selector != nil && c.selector.Matches(labels.Set(entry.Machine.Labels)) {
+		return true
+	}
+	for _, pool := range c.strategy.MachinePools {
+		if pool != "" && entry.Machine.Labels[rke2.RKEMachinePoolNameLabel] == pool {
+			return true
+		}
+	}
+	return false
+}
+
+// tier returns the rollout of the machines of a tier. Machines that are deleted are not part of it, and neither are
+// the machines that are excluded from the tier unless they are canaries, which were configured by an earlier tier.
+func (c *canaryRollout) tier(name string, entries []*planEntry, exclude roleFilter) *canaryTier {
+	t := &canaryTier{canaryRollout: c, name: name, configured: map[string]bool{}}
+	for _, entry := range entries {
+		if isDeleting(entry) {
+			continue
+		}
+		if !c.isCanary(entry) {
+			if !exclude(entry) {
+				t.others = append(t.others, entry.Machine.Name)
+			}
+			continue
+		}
+		t.canaries = append(t.canaries, entry.Machine.Name)
+		if exclude(entry) {
+			t.configured[entry.Machine.Name] = true
+		}
+		switch {
+		case entry.Plan == nil:
+		case entry.Plan.Failed:
+			t.failed = append(t.failed, entry.Machine.Name)
+		case entry.Plan.InSync && (!conditions.IsTrue(entry.Machine, capi.MachineNodeHealthyCondition) ||
+			(c.strategy.ProbeHealthGate && !entry.Plan.Healthy)):
+			t.degraded = append(t.degraded, entry.Machine.Name)
+		}
+	}
+	return t
+}
+
+func (t *canaryTier) rollout() *rkev1.CanaryRollout {
+	for i := range t.status.CanaryRollouts {
+		if t.status.CanaryRollouts[i].Tier == t.name {
+			return t.status.CanaryRollouts[i].DeepCopy()
+		}
+	}
+	return nil
+}
+
+func (t *canaryTier) setRollout(rollout *rkev1.CanaryRollout) {
+	var rollouts []rkev1.CanaryRollout
+	for _, r := range t.status.CanaryRollouts {
+		if r.Tier != t.name {
+			rollouts = append(rollouts, r)
+		}
+	}
+	if rollout != nil {
+		rollouts = append(rollouts, *rollout)
+	}
+	t.status.CanaryRollouts = rollouts
+}
+
+// check records whether the plan of a canary machine of the tier changes.
+func (t *canaryTier) check(entry *planEntry, desiredPlan plan.NodePlan) {
+	if t.isCanary(entry) && !isDeleting(entry) && (entry.Plan == nil || !equality.Semantic.DeepEqual(entry.Plan.Plan, desiredPlan)) {
+		t.changed = append(t.changed, entry.Machine.Name)
+	}
+}
+
+// hold returns whether the plan change of a machine that isn't a canary waits until the canaries of the tier were
+// healthy for the soak period. Like maintenance windows, a machine that is being drained or uncordoned isn't held.
+func (t *canaryTier) hold(entry *planEntry) bool {
+	if len(t.canaries) == 0 || t.isCanary(entry) || isInDrain(entry) {
+		return false
+	}
+	// a promoted rollout is paused as soon as a canary degrades
+	if rollout := t.rollout(); rollout != nil && rollout.Phase == rkev1.CanaryRolloutPhasePromoted && len(t.failed) == 0 && len(t.degraded) == 0 {
+		return false
+	}
+	t.held = append(t.held, entry.Machine.Name)
+	return true
+}
+
+// update moves the rollout of the tier to its next phase given the machines of the tier that are ready, and returns
+// how long until the soak period of the rollout ends, if it is soaking.
+func (t *canaryTier) update(ready []string) time.Duration {
+	readyMachines := map[string]bool{}
+	for _, name := range ready {
+		readyMachines[name] = true
+	}
+	canariesReady := len(t.failed) == 0 && len(t.degraded) == 0
+	for _, name := range t.canaries {
+		canariesReady = canariesReady && (readyMachines[name] || t.configured[name])
+	}
+	othersReady := true
+	for _, name := range t.others {
+		othersReady = othersReady && readyMachines[name]
+	}
+
+	rollout := t.rollout()
+	if len(t.canaries) == 0 || (rollout == nil && len(t.held) == 0) || (len(t.held) == 0 && canariesReady && othersReady) {
+		// there is nothing to roll out, or the rollout is finished
+		t.setRollout(nil)
+		return 0
+	}
+	if rollout == nil {
+		rollout = &rkev1.CanaryRollout{Tier: t.name, Phase: rkev1.CanaryRolloutPhaseCanary}
+	}
+	rollout.Machines = t.canaries
+
+	var requeue time.Duration
+	switch rollout.Phase {
+	case rkev1.CanaryRolloutPhaseSoaking, rkev1.CanaryRolloutPhasePromoted:
+		if len(t.failed) > 0 || len(t.degraded) > 0 {
+			t.pause(rollout)
+		} else if len(t.changed) > 0 {
+			// the plans changed again, the new plans are rolled out to the canaries first
+			rollout.Phase = rkev1.CanaryRolloutPhaseCanary
+			rollout.SoakStartTime = nil
+			rollout.Message = fmt.Sprintf("rolling out to canary machine(s) %s", atMostThree(append([]string{}, t.canaries...)))
+		} else if rollout.Phase == rkev1.CanaryRolloutPhaseSoaking {
+			requeue = t.soak(rollout, canariesReady)
+		}
+	default:
+		if len(t.failed) > 0 {
+			t.pause(rollout)
+		} else if canariesReady {
+			rollout.Phase = rkev1.CanaryRolloutPhaseSoaking
+			rollout.SoakStartTime = &metav1.Time{Time: t.now}
+			requeue = t.soak(rollout, canariesReady)
+		} else if rollout.Phase != rkev1.CanaryRolloutPhasePaused {
+			rollout.Phase = rkev1.CanaryRolloutPhaseCanary
+			rollout.Message = fmt.Sprintf("rolling out to canary machine(s) %s", atMostThree(append([]string{}, t.canaries...)))
+		}
+	}
+	t.setRollout(rollout)
+	return requeue
+}
+
+// soak promotes a soaking rollout once its soak period is over and the canaries pass the health gates.
+func (t *canaryTier) soak(rollout *rkev1.CanaryRollout, canariesReady bool) time.Duration {
+	end := rollout.SoakStartTime.Add(t.strategy.SoakPeriod.Duration)
+	if t.now.Before(end) || !canariesReady {
+		rollout.Message = fmt.Sprintf("soaking canary machine(s) %s until %s", atMostThree(append([]string{}, t.canaries...)), end.Format(time.RFC3339))
+		return end.Sub(t.now)
+	}
+	if t.strategy.QueryHealthGate != nil && t.query != nil {
+		done, err := t.query(t.name, rollout.SoakStartTime.Time, t.strategy.QueryHealthGate)
+		if !done {
+			// the control plane is enqueued once the query is done
+			rollout.Message = fmt.Sprintf("soaking canary machine(s) %s, waiting for the health query", atMostThree(append([]string{}, t.canaries...)))
+			return 0
+		}
+		if err != nil {
+			rollout.Phase = rkev1.CanaryRolloutPhasePaused
+			rollout.SoakStartTime = nil
+			rollout.Message = fmt.Sprintf("paused, health query of canary machine(s) %s failed: %v", atMostThree(append([]string{}, t.canaries...)), err)
+			return 0
+		}
+	}
+	rollout.Phase = rkev1.CanaryRolloutPhasePromoted
+	rollout.Message = fmt.Sprintf("canary machine(s) %s are healthy, rolling out to the other %s machines", atMostThree(append([]string{}, t.canaries...)), t.name)
+	return 0
+}
+
+func (t *canaryTier) pause(rollout *rkev1.CanaryRollout) {
+	rollout.Phase = rkev1.CanaryRolloutPhasePaused
+	rollout.SoakStartTime = nil
+	if len(t.failed) > 0 {
+		rollout.Message = fmt.Sprintf("paused, plan failed to apply on canary machine(s) %s", atMostThree(append([]string{}, t.failed...)))
+	} else {
+		rollout.Message = fmt.Sprintf("paused, canary machine(s) %s are not healthy", atMostThree(append([]string{}, t.degraded...)))
+	}
+}
+
+// updateCanaryRollout updates the rollout of a tier, and enqueues the control plane for the end of its soak period.
+func (p *Planner) updateCanaryRollout(controlPlane *rkev1.RKEControlPlane, t *canaryTier, ready []string) {
+	before := t.rollout()
+	if requeue := t.update(ready); requeue > 0 {
+		p.rkeControlPlanes.EnqueueAfter(controlPlane.Namespace, controlPlane.Name, requeue)
+	}
+	if after := t.rollout(); after != nil && (before == nil || before.Phase != after.Phase) {
+		logrus.Infof("[planner] rkecluster %s/%s: canary rollout of %s tier: %s", controlPlane.Namespace, controlPlane.Name, t.name, after.Message)
+	}
+}
+
+// healthGateQueries are the results of the health queries of canary rollouts, which run in the background so the
+// planner doesn't wait for Prometheus.
+type healthGateQueries struct {
+	sync.Mutex
+	results map[string]*healthGateResult
+}
+
+type healthGateResult struct {
+	done     bool
+	err      error
+	finished time.Time
+}
+
+// queryHealthGate returns the health query of the canary rollouts of a control plane. A query is started in the
+// background the first time it is asked for, and the control plane is enqueued once its result is known.
+func (p *Planner) queryHealthGate(controlPlane *rkev1.RKEControlPlane) func(string, time.Time, *rkev1.CanaryQueryHealthGate) (bool, error) {
+	namespace, name := controlPlane.Namespace, controlPlane.Name
+	return func(tier string, soakStart time.Time, gate *rkev1.CanaryQueryHealthGate) (bool, error) {
+		key := strings.Join([]string{namespace, name, tier, soakStart.UTC().Format(time.RFC3339), gate.URL, gate.Query}, "/")
+
+		p.healthGates.Lock()
+		defer p.healthGates.Unlock()
+		for k, result := range p.healthGates.results {
+			// results of rollouts that moved on without them
+			if result.done && time.Since(result.finished) > time.Hour {
+				delete(p.healthGates.results, k)
+			}
+		}
+		if result, ok := p.healthGates.results[key]; ok {
+			if result.done {
+				delete(p.healthGates.results, key)
+			}
+			return result.done, result.err
+		}
+
+		result := &healthGateResult{}
+		p.healthGates.results[key] = result
+		gate = gate.DeepCopy()
+		go func() {
+			err := runHealthGateQuery(gate)
+			p.healthGates.Lock()
+			result.done, result.err, result.finished = true, err, time.Now()
+			p.healthGates.Unlock()
+			p.rkeControlPlanes.Enqueue(namespace, name)
+		}()
+		return false, nil
+	}
+}
+
+// allowedHealthGateURL returns whether a health gate URL has the scheme and host of one of the URLs of the
+// canary-health-gate-urls setting, and starts with its path.
+func allowedHealthGateURL(u *url.URL) bool {
+	for _, allowed := range strings.Split(settings.CanaryHealthGateURLs.Get(), ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "" {
+			continue
+		}
+		a, err := url.Parse(allowed)
+		if err != nil {
+			logrus.Warnf("Ignoring invalid URL %q in setting %s", allowed, settings.CanaryHealthGateURLs.Name)
+			continue
+		}
+		prefix := strings.TrimSuffix(a.Path, "/")
+		if u.Scheme == a.Scheme && u.Host == a.Host && (u.Path == prefix || strings.HasPrefix(u.Path, prefix+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// runHealthGateQuery runs the Prometheus query of a health gate, which fails unless it returns samples that aren't 0.
+// Its errors end up in the status of the control plane, so they don't include the contents of the response.
+func runHealthGateQuery(gate *rkev1.CanaryQueryHealthGate) error {
+	u, err := url.Parse(gate.URL)
+	if err != nil {
+		return err
+	}
+	u.Path = path.Clean("/" + u.Path)
+	if u.User != nil || !allowedHealthGateURL(u) {
+		return fmt.Errorf("URL %s is not allowed by setting %s", gate.URL, settings.CanaryHealthGateURLs.Name)
+	}
+	u.Path = path.Join(u.Path, "/api/v1/query")
+	u.RawQuery = url.Values{"query": []string{gate.Query}}.Encode()
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+		// a redirect could leave the allowed URLs
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Status string `json:"status"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result); err != nil {
+		return fmt.Errorf("invalid response with status %d", resp.StatusCode)
+	}
+	if result.Status != "success" {
+		return fmt.Errorf("query failed with status %d", resp.StatusCode)
+	}
+	if result.Data.ResultType != "vector" {
+		return errors.New("query didn't return a vector")
+	}
+	var samples []struct {
+		Value []interface{} `json:"value"`
+	}
+	if err := json.Unmarshal(result.Data.Result, &samples); err != nil {
+		return errors.New("query returned an invalid vector")
+	}
+	if len(samples) == 0 {
+		return errors.New("query returned no samples")
+	}
+	for _, sample := range samples {
+		if len(sample.Value) != 2 || sample.Value[1] == "0" {
+			return errors.New("query returned samples that are 0")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func newTestCanaryEntry(name, pool string, nodePlan plan.NodePlan, healthy bool) *planEntry {
+	machine := &capi.Machine{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{rke2.RKEMachinePoolNameLabel: pool},
+	}}
+	if healthy {
+		conditions.MarkTrue(machine, capi.MachineNodeHealthyCondition)
+	} else {
+		conditions.MarkFalse(machine, capi.MachineNodeHealthyCondition, "NodeConditionsFailed", capi.ConditionSeverityWarning, "")
+	}
+	return &planEntry{
+		Machine:  machine,
+		Metadata: &plan.Metadata{},
+		Plan:     &plan.Node{Plan: nodePlan, AppliedPlan: &nodePlan, InSync: true, Healthy: true},
+	}
+}
+
+// runCanaryTier runs the canary rollout of a tier like reconcile does, and returns the machines that were held.
+func runCanaryTier(c *canaryRollout, entries []*planEntry, desiredPlan plan.NodePlan, ready []string) ([]string, time.Duration) {
+	t := c.tier(workerTier, entries, isDeleting)
+	for _, entry := range entries {
+		t.check(entry, desiredPlan)
+		if !equality.Semantic.DeepEqual(entry.Plan.Plan, desiredPlan) {
+			t.hold(entry)
+		}
+	}
+	return t.held, t.update(ready)
+}
+
+func TestCanaryRollout(t *testing.T) {
+	oldPlan := testNodePlan(`{"kubelet-arg": ["max-pods=110"]}`, "a")
+	newPlan := testNodePlan(`{"kubelet-arg": ["max-pods=250"]}`, "b")
+	strategy := &rkev1.CanaryStrategy{
+		MachinePools: []string{"canary"},
+		SoakPeriod:   metav1.Duration{Duration: time.Hour},
+	}
+	status := &rkev1.RKEControlPlaneStatus{}
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// the plan change is rolled out to the canary only
+	c, err := newCanaryRollout(strategy, status, now)
+	require.NoError(t, err)
+	entries := []*planEntry{
+		newTestCanaryEntry("canary-0", "canary", oldPlan, true),
+		newTestCanaryEntry("worker-0", "worker", oldPlan, true),
+		newTestCanaryEntry("worker-1", "worker", oldPlan, true),
+	}
+	held, requeue := runCanaryTier(c, entries, newPlan, nil)
+	assert.Equal(t, []string{"worker-0", "worker-1"}, held)
+	assert.Zero(t, requeue)
+	require.Len(t, status.CanaryRollouts, 1)
+	assert.Equal(t, rkev1.CanaryRolloutPhaseCanary, status.CanaryRollouts[0].Phase)
+	assert.Equal(t, []string{"canary-0"}, status.CanaryRollouts[0].Machines)
+
+	// the canary is soaked once it is ready
+	entries[0] = newTestCanaryEntry("canary-0", "canary", newPlan, true)
+	held, requeue = runCanaryTier(c, entries, newPlan, []string{"canary-0"})
+	assert.Len(t, held, 2)
+	assert.Equal(t, time.Hour, requeue)
+	assert.Equal(t, rkev1.CanaryRolloutPhaseSoaking, status.CanaryRollouts[0].Phase)
+	assert.Equal(t, now, status.CanaryRollouts[0].SoakStartTime.Time)
+
+	// and promoted at the end of the soak period
+	c.now = now.Add(time.Hour)
+	held, _ = runCanaryTier(c, entries, newPlan, []string{"canary-0"})
+	assert.Len(t, held, 2)
+	assert.Equal(t, rkev1.CanaryRolloutPhasePromoted, status.CanaryRollouts[0].Phase)
+
+	held, _ = runCanaryTier(c, entries, newPlan, []string{"canary-0"})
+	assert.Empty(t, held, "the other machines aren't held once the canaries are promoted")
+	assert.Equal(t, rkev1.CanaryRolloutPhasePromoted, status.CanaryRollouts[0].Phase)
+
+	// the rollout is paused if the canary degrades
+	entries[0] = newTestCanaryEntry("canary-0", "canary", newPlan, false)
+	entries[1] = newTestCanaryEntry("worker-0", "worker", newPlan, true)
+	held, _ = runCanaryTier(c, entries, newPlan, []string{"worker-0"})
+	assert.Equal(t, []string{"worker-1"}, held)
+	assert.Equal(t, rkev1.CanaryRolloutPhasePaused, status.CanaryRollouts[0].Phase)
+	assert.Equal(t, "paused, canary machine(s) canary-0 are not healthy", status.CanaryRollouts[0].Message)
+
+	// and resumed with a new soak period once it is healthy again
+	entries[0] = newTestCanaryEntry("canary-0", "canary", newPlan, true)
+	held, requeue = runCanaryTier(c, entries, newPlan, []string{"canary-0", "worker-0"})
+	assert.Equal(t, []string{"worker-1"}, held)
+	assert.Equal(t, time.Hour, requeue)
+	assert.Equal(t, rkev1.CanaryRolloutPhaseSoaking, status.CanaryRollouts[0].Phase)
+
+	c.now = now.Add(3 * time.Hour)
+	runCanaryTier(c, entries, newPlan, []string{"canary-0", "worker-0"})
+	assert.Equal(t, rkev1.CanaryRolloutPhasePromoted, status.CanaryRollouts[0].Phase)
+
+	// the rollout is finished once all machines are ready
+	entries[2] = newTestCanaryEntry("worker-1", "worker", newPlan, true)
+	held, _ = runCanaryTier(c, entries, newPlan, []string{"canary-0", "worker-0", "worker-1"})
+	assert.Empty(t, held)
+	assert.Empty(t, status.CanaryRollouts)
+}
+
+func TestCanaryRolloutFailures(t *testing.T) {
+	oldPlan := testNodePlan(`{"kubelet-arg": ["max-pods=110"]}`, "a")
+	newPlan := testNodePlan(`{"kubelet-arg": ["max-pods=250"]}`, "b")
+	strategy := &rkev1.CanaryStrategy{
+		MachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{rke2.RKEMachinePoolNameLabel: "canary"}},
+		QueryHealthGate: &rkev1.CanaryQueryHealthGate{URL: "http://prometheus:9090", Query: "up"},
+	}
+	status := &rkev1.RKEControlPlaneStatus{}
+	c, err := newCanaryRollout(strategy, status, time.Now())
+	require.NoError(t, err)
+	var done bool
+	c.query = func(tier string, soakStart time.Time, gate *rkev1.CanaryQueryHealthGate) (bool, error) {
+		assert.Equal(t, workerTier, tier)
+		return done, errors.New("query returned no samples")
+	}
+
+	// the rollout soaks until the health query is done
+	entries := []*planEntry{
+		newTestCanaryEntry("canary-0", "canary", newPlan, true),
+		newTestCanaryEntry("worker-0", "worker", oldPlan, true),
+	}
+	runCanaryTier(c, entries, newPlan, []string{"canary-0"})
+	require.Len(t, status.CanaryRollouts, 1)
+	assert.Equal(t, rkev1.CanaryRolloutPhaseSoaking, status.CanaryRollouts[0].Phase)
+	assert.Equal(t, "soaking canary machine(s) canary-0, waiting for the health query", status.CanaryRollouts[0].Message)
+
+	// the health query fails at the end of the soak period
+	done = true
+	runCanaryTier(c, entries, newPlan, []string{"canary-0"})
+	require.Len(t, status.CanaryRollouts, 1)
+	assert.Equal(t, rkev1.CanaryRolloutPhasePaused, status.CanaryRollouts[0].Phase)
+	assert.Equal(t, "paused, health query of canary machine(s) canary-0 failed: query returned no samples", status.CanaryRollouts[0].Message)
+
+	// the plan of the canary fails to apply
+	entries[0].Plan.Failed = true
+	held, _ := runCanaryTier(c, entries, newPlan, nil)
+	assert.Equal(t, []string{"worker-0"}, held)
+	assert.Equal(t, "paused, plan failed to apply on canary machine(s) canary-0", status.CanaryRollouts[0].Message)
+
+	// the rollout is dropped with the strategy
+	_, err = newCanaryRollout(nil, status, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, status.CanaryRollouts)
+}
+
+func TestCanaryRolloutConfiguredCanary(t *testing.T) {
+	oldPlan := testNodePlan(`{"kubelet-arg": ["max-pods=110"]}`, "a")
+	newPlan := testNodePlan(`{"kubelet-arg": ["max-pods=250"]}`, "b")
+	status := &rkev1.RKEControlPlaneStatus{}
+	c, err := newCanaryRollout(&rkev1.CanaryStrategy{MachinePools: []string{"canary"}}, status, time.Now())
+	require.NoError(t, err)
+
+	// the init node is configured by the bootstrap tier, it is a canary of the etcd tier that is ready
+	initNode := newTestCanaryEntry("canary-0", "canary", newPlan, true)
+	initNode.Metadata.Labels = map[string]string{rke2.InitNodeLabel: "true"}
+	other := newTestCanaryEntry("etcd-0", "etcd", oldPlan, true)
+	tier := c.tier(etcdTier, []*planEntry{initNode, other}, isInitNodeOrDeleting)
+	assert.Equal(t, []string{"canary-0"}, tier.canaries)
+	assert.Equal(t, []string{"etcd-0"}, tier.others)
+	assert.True(t, tier.hold(other))
+	tier.update(nil)
+	require.Len(t, status.CanaryRollouts, 1)
+	assert.Equal(t, rkev1.CanaryRolloutPhasePromoted, status.CanaryRollouts[0].Phase)
+}
+
+type enqueuedControlPlanes struct {
+	rkecontrollers.RKEControlPlaneController
+	enqueued chan string
+}
+
+func (e *enqueuedControlPlanes) Enqueue(namespace, name string) {
+	e.enqueued <- namespace + "/" + name
+}
+
+func TestQueryHealthGate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"instance":"a"},"value":[1664625600,"1"]}]}}`)
+	}))
+	defer server.Close()
+	defer settings.CanaryHealthGateURLs.Set(settings.CanaryHealthGateURLs.Get())
+	require.NoError(t, settings.CanaryHealthGateURLs.Set(server.URL))
+
+	controlPlanes := &enqueuedControlPlanes{enqueued: make(chan string, 1)}
+	p := &Planner{rkeControlPlanes: controlPlanes, healthGates: &healthGateQueries{results: map[string]*healthGateResult{}}}
+	cp := &rkev1.RKEControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "fleet-default", Name: "test"}}
+	query := p.queryHealthGate(cp)
+	gate := &rkev1.CanaryQueryHealthGate{URL: server.URL, Query: "up"}
+	soakStart := time.Now()
+
+	// the query runs in the background and the control plane is enqueued with its result
+	done, err := query(workerTier, soakStart, gate)
+	assert.False(t, done)
+	assert.NoError(t, err)
+	select {
+	case key := <-controlPlanes.enqueued:
+		assert.Equal(t, "fleet-default/test", key)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the control plane wasn't enqueued")
+	}
+	done, err = query(workerTier, soakStart, gate)
+	assert.True(t, done)
+	assert.NoError(t, err)
+
+	// the result is only returned once, a later soak period queries again
+	done, _ = query(workerTier, soakStart, gate)
+	assert.False(t, done)
+	<-controlPlanes.enqueued
+}
+
+func TestRunHealthGateQuery(t *testing.T) {
+	responses := map[string]string{
+		"up":      `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"instance":"a"},"value":[1664625600,"1"]}]}}`,
+		"down":    `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"instance":"a"},"value":[1664625600,"0"]}]}}`,
+		"empty":   `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"scalar":  `{"status":"success","data":{"resultType":"scalar","result":[1664625600,"1"]}}`,
+		"invalid": `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+		"secret":  `secret contents`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect/api/v1/query" {
+			http.Redirect(w, r, "http://169.254.169.254/", http.StatusFound)
+			return
+		}
+		assert.Equal(t, "/prometheus/api/v1/query", r.URL.Path)
+		fmt.Fprint(w, responses[r.URL.Query().Get("query")])
+	}))
+	defer server.Close()
+	defer settings.CanaryHealthGateURLs.Set(settings.CanaryHealthGateURLs.Get())
+	require.NoError(t, settings.CanaryHealthGateURLs.Set("http://prometheus:9090, "+server.URL+"/prometheus,"+server.URL+"/redirect"))
+
+	query := func(q string) error {
+		return runHealthGateQuery(&rkev1.CanaryQueryHealthGate{URL: server.URL + "/prometheus", Query: q})
+	}
+	assert.NoError(t, query("up"))
+	assert.EqualError(t, query("down"), "query returned samples that are 0")
+	assert.EqualError(t, query("empty"), "query returned no samples")
+	assert.EqualError(t, query("scalar"), "query didn't return a vector")
+	assert.EqualError(t, query("invalid"), "query failed with status 200")
+	assert.EqualError(t, query("secret"), "invalid response with status 200", "the response isn't part of the error")
+
+	// only the URLs of the setting are queried
+	for _, u := range []string{server.URL, server.URL + "/prometheus-other", server.URL + "/prometheus/../admin", "http://prometheus:9091", "http://user@prometheus:9090"} {
+		err := runHealthGateQuery(&rkev1.CanaryQueryHealthGate{URL: u, Query: "up"})
+		assert.EqualError(t, err, "URL "+u+" is not allowed by setting canary-health-gate-urls", u)
+	}
+	// and redirects aren't followed
+	err := runHealthGateQuery(&rkev1.CanaryQueryHealthGate{URL: server.URL + "/redirect", Query: "up"})
+	assert.EqualError(t, err, "invalid response with status 302")
+}
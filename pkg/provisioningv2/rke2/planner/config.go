@@ -41,7 +41,9 @@ func (p *Planner) addETCD(config map[string]interface{}, controlPlane *rkev1.RKE
 	if controlPlane.Spec.ETCD.DisableSnapshots {
 		config["etcd-disable-snapshots"] = true
 	}
-	if controlPlane.Spec.ETCD.SnapshotRetention > 0 {
+	if snapshotRetentionPolicy(controlPlane).Enabled() {
+		config["etcd-snapshot-retention"] = policySnapshotRetention
+	} else if controlPlane.Spec.ETCD.SnapshotRetention > 0 {
 		config["etcd-snapshot-retention"] = controlPlane.Spec.ETCD.SnapshotRetention
 	}
 	if controlPlane.Spec.ETCD.SnapshotScheduleCron != "" {
@@ -0,0 +1,300 @@
+package planner
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/channelserver/pkg/model"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/wrangler/pkg/schemas"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// managedConfigKeys are set by the planner on every machine, the values of the config of the cluster are overwritten.
+var managedConfigKeys = map[string]bool{
+	"agent-token":  true,
+	"cluster-init": true,
+	"server":       true,
+	"token":        true,
+}
+
+// ErrInvalidConfig is returned if the config of a cluster is rejected, no plan is changed until the config is fixed.
+type ErrInvalidConfig string
+
+func (e ErrInvalidConfig) Error() string {
+	return string(e)
+}
+
+// configSource is the config of a cluster a key of the merged config of a machine comes from.
+type configSource struct {
+	path  *field.Path
+	value interface{}
+}
+
+// checkConfig rejects the config of a cluster that isn't valid for its Kubernetes version. Only a config or a version
+// that differs from the applied spec is checked, clusters whose config was accepted before aren't stopped.
+func checkConfig(controlPlane *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus, release model.Release, clusterPlan *plan.Plan) error {
+	if applied := status.AppliedSpec; applied != nil && applied.KubernetesVersion == controlPlane.Spec.KubernetesVersion &&
+		equality.Semantic.DeepEqual(applied.MachineGlobalConfig, controlPlane.Spec.MachineGlobalConfig) &&
+		equality.Semantic.DeepEqual(applied.MachineSelectorConfig, controlPlane.Spec.MachineSelectorConfig) {
+		return nil
+	}
+	if errs := validateConfig(controlPlane, release, clusterPlan); len(errs) > 0 {
+		return ErrInvalidConfig(fmt.Sprintf("invalid config: %v", errs.ToAggregate()))
+	}
+	return nil
+}
+
+// validateConfig checks the config of a cluster against the arguments the distribution of its Kubernetes version
+// accepts, as published in KDM: the names of the flags, the types of their values and their options. The config of
+// each machine is merged like addUserConfig merges it and checked for conflicts too. Nothing is checked if there is
+// no KDM data for the version.
+func validateConfig(controlPlane *rkev1.RKEControlPlane, release model.Release, clusterPlan *plan.Plan) field.ErrorList {
+	if len(release.ServerArgs) == 0 && len(release.AgentArgs) == 0 {
+		return nil
+	}
+
+	var (
+		errs     field.ErrorList
+		root     = field.NewPath("spec", "rkeConfig")
+		global   = root.Child("machineGlobalConfig")
+		distro   = fmt.Sprintf("%s %s", rke2.GetRuntime(controlPlane.Spec.KubernetesVersion), controlPlane.Spec.KubernetesVersion)
+		configs  = []*field.Path{global}
+		data     = []map[string]interface{}{controlPlane.Spec.MachineGlobalConfig.Data}
+		selected = []labels.Selector{labels.Everything()}
+	)
+	for i, opts := range controlPlane.Spec.MachineSelectorConfig {
+		path := root.Child("machineSelectorConfig").Index(i)
+		selector, err := metav1.LabelSelectorAsSelector(opts.MachineLabelSelector)
+		if err != nil {
+			errs = append(errs, field.Invalid(path.Child("machineLabelSelector"), opts.MachineLabelSelector.String(), err.Error()))
+			continue
+		}
+		if opts.MachineLabelSelector == nil {
+			selector = labels.Everything()
+		}
+		configs = append(configs, path.Child("config"))
+		data = append(data, opts.Config.Data)
+		selected = append(selected, selector)
+	}
+
+	for i, config := range data {
+		errs = append(errs, validateConfigData(configs[i], config, release, distro)...)
+	}
+	if clusterPlan != nil {
+		errs = append(errs, validateMachineConfigs(configs, data, selected, release, clusterPlan)...)
+	}
+	return errs
+}
+
+func validateConfigData(path *field.Path, config map[string]interface{}, release model.Release, distro string) (errs field.ErrorList) {
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := config[k]
+		if v == nil {
+			continue
+		}
+		if managedConfigKeys[k] {
+			errs = append(errs, field.Forbidden(path.Key(k), "is managed by Rancher"))
+			continue
+		}
+		arg, ok := release.ServerArgs[k]
+		if !ok {
+			arg, ok = release.AgentArgs[k]
+		}
+		if !ok {
+			errs = append(errs, field.NotSupported(path.Key(k), k, nil))
+			errs[len(errs)-1].Detail = "unknown flag for " + distro
+			continue
+		}
+		if err := validateConfigValue(path.Key(k), k, v, arg); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if k == "cni" {
+			errs = append(errs, validateCNI(path.Key(k), v)...)
+		}
+	}
+	return errs
+}
+
+// validateConfigValue checks a value against the type of its flag. Like filterField, values that aren't a string, a
+// boolean or a list are not supported.
+func validateConfigValue(path *field.Path, k string, v interface{}, arg schemas.Field) *field.Error {
+	shown := v
+	if redactedConfigKeys.MatchString(k) {
+		shown = "[redacted]"
+	}
+
+	switch value := v.(type) {
+	case bool:
+		if arg.Type != "boolean" && arg.Type != "string" {
+			return field.Invalid(path, shown, fmt.Sprintf("must be a %s", describeArgType(arg)))
+		}
+	case string:
+		if arg.Type == "boolean" {
+			if _, err := strconv.ParseBool(value); err != nil {
+				return field.Invalid(path, shown, "must be a boolean")
+			}
+		} else if arg.Type == "enum" && !validOption(arg, value) {
+			return field.NotSupported(path, shown, arg.Options)
+		} else if arg.Type == "array[enum]" {
+			// like on the command line, a string is a comma separated list
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" && !validOption(arg, item) {
+					return field.NotSupported(path, item, arg.Options)
+				}
+			}
+		}
+	case []interface{}:
+		if !strings.HasPrefix(arg.Type, "array") {
+			return field.Invalid(path, shown, fmt.Sprintf("must be a %s, not a list", describeArgType(arg)))
+		}
+		for i, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return field.Invalid(path.Index(i), item, "must be a string")
+			}
+			if arg.Type == "array[enum]" && !validOption(arg, s) {
+				return field.NotSupported(path.Index(i), s, arg.Options)
+			}
+		}
+	default:
+		return field.Invalid(path, shown, fmt.Sprintf("must be a %s, numbers and objects are not supported and would be ignored", describeArgType(arg)))
+	}
+	return nil
+}
+
+func describeArgType(arg schemas.Field) string {
+	switch {
+	case arg.Type == "boolean":
+		return "boolean"
+	case strings.HasPrefix(arg.Type, "array"):
+		return "string or a list of strings"
+	default:
+		return "string"
+	}
+}
+
+func validOption(arg schemas.Field, value string) bool {
+	if len(arg.Options) == 0 {
+		return true
+	}
+	for _, option := range arg.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// cniValues returns the CNIs of a cni flag, which is a comma separated string or a list.
+func cniValues(v interface{}) (result []string) {
+	var values []string
+	switch value := v.(type) {
+	case string:
+		values = strings.Split(value, ",")
+	case []interface{}:
+		for _, item := range value {
+			values = append(values, strings.Split(fmt.Sprint(item), ",")...)
+		}
+	}
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func validateCNI(path *field.Path, v interface{}) (errs field.ErrorList) {
+	cnis := cniValues(v)
+	for _, cni := range cnis {
+		if cni == "none" && len(cnis) > 1 {
+			errs = append(errs, field.Invalid(path, v, "none can't be combined with another CNI"))
+			break
+		}
+	}
+	if len(cnis) == 1 && cnis[0] == "multus" {
+		errs = append(errs, field.Invalid(path, v, "multus must be combined with another CNI"))
+	}
+	return errs
+}
+
+// validateMachineConfigs merges the configs of each machine of the cluster, and checks that all etcd and control plane
+// machines use the same CNI and that configs that only select workers don't set server flags, which are ignored.
+func validateMachineConfigs(configs []*field.Path, data []map[string]interface{}, selected []labels.Selector, release model.Release, clusterPlan *plan.Plan) (errs field.ErrorList) {
+	var (
+		selectsServer = make([]bool, len(configs))
+		selectsAgent  = make([]bool, len(configs))
+		firstCNI      *configSource
+		firstCNIOn    string
+		reported      = map[string]bool{}
+	)
+
+	for _, entry := range collect(clusterPlan, anyRole) {
+		if isDeleting(entry) {
+			continue
+		}
+		server := isControlPlane(entry) || isEtcd(entry)
+		merged := map[string]configSource{}
+		for i := range configs {
+			if !selected[i].Matches(labels.Set(entry.Machine.Labels)) {
+				continue
+			}
+			if server {
+				selectsServer[i] = true
+			} else {
+				selectsAgent[i] = true
+			}
+			for k, v := range data[i] {
+				merged[k] = configSource{path: configs[i].Key(k), value: v}
+			}
+		}
+		if !server {
+			continue
+		}
+
+		cni, ok := merged["cni"]
+		if !ok {
+			continue
+		}
+		if firstCNI == nil {
+			firstCNI, firstCNIOn = &cni, entry.Machine.Name
+		} else if !equality.Semantic.DeepEqual(cniValues(cni.value), cniValues(firstCNI.value)) && !reported[cni.path.String()] {
+			reported[cni.path.String()] = true
+			errs = append(errs, field.Invalid(cni.path, cni.value, fmt.Sprintf("etcd and control plane machines must use the same CNI, machine %s uses %v from %s",
+				firstCNIOn, firstCNI.value, firstCNI.path)))
+		}
+	}
+
+	// the global config applies to every machine, server flags are ignored on workers on purpose
+	for i := 1; i < len(configs); i++ {
+		if selectsServer[i] || !selectsAgent[i] {
+			continue
+		}
+		keys := make([]string, 0, len(data[i]))
+		for k := range data[i] {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if _, agentArg := release.AgentArgs[k]; !agentArg && release.ServerArgs[k].Type != "" {
+				errs = append(errs, field.Invalid(configs[i].Key(k), k, "is only supported on etcd and control plane machines, and the machine selector only selects workers"))
+			}
+		}
+	}
+	return errs
+}
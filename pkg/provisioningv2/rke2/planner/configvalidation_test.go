@@ -0,0 +1,178 @@
+package planner
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rancher/channelserver/pkg/model"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/wrangler/pkg/schemas"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+var testConfigRelease = model.Release{
+	ServerArgs: map[string]schemas.Field{
+		"cni":                {Type: "array[enum]", Options: []string{"none", "canal", "cilium", "calico", "multus"}},
+		"disable-kube-proxy": {Type: "boolean"},
+		"kube-apiserver-arg": {Type: "array"},
+		"profile":            {Type: "enum", Options: []string{"cis-1.6"}},
+		"tls-san":            {Type: "array"},
+	},
+	AgentArgs: map[string]schemas.Field{
+		"kubelet-arg":             {Type: "array"},
+		"protect-kernel-defaults": {Type: "boolean"},
+		"system-default-registry": {Type: "string"},
+	},
+}
+
+func newTestConfigPlan() *plan.Plan {
+	clusterPlan := &plan.Plan{
+		Nodes:    map[string]*plan.Node{},
+		Machines: map[string]*capi.Machine{},
+		Metadata: map[string]*plan.Metadata{},
+	}
+	for name, role := range map[string]string{"cp-0": "controlplane", "cp-1": "controlplane", "worker-0": "worker"} {
+		clusterPlan.Machines[name] = &capi.Machine{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"name": name, "role": role}}}
+		roleLabel := rke2.ControlPlaneRoleLabel
+		if role == "worker" {
+			roleLabel = rke2.WorkerRoleLabel
+		}
+		clusterPlan.Metadata[name] = &plan.Metadata{Labels: map[string]string{roleLabel: "true"}}
+	}
+	return clusterPlan
+}
+
+func newTestConfigControlPlane(global string, selectors ...rkev1.RKESystemConfig) *rkev1.RKEControlPlane {
+	cp := createTestControlPlane("v1.25.3+rke2r1")
+	if global != "" {
+		if err := json.Unmarshal([]byte(global), &cp.Spec.MachineGlobalConfig.Data); err != nil {
+			panic(err)
+		}
+	}
+	cp.Spec.MachineSelectorConfig = selectors
+	return cp
+}
+
+func testSelectorConfig(selector *metav1.LabelSelector, config string) rkev1.RKESystemConfig {
+	result := rkev1.RKESystemConfig{MachineLabelSelector: selector}
+	if err := json.Unmarshal([]byte(config), &result.Config.Data); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestValidateConfig(t *testing.T) {
+	workers := &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}
+	cp0 := &metav1.LabelSelector{MatchLabels: map[string]string{"name": "cp-0"}}
+
+	tests := []struct {
+		name      string
+		global    string
+		selectors []rkev1.RKESystemConfig
+		errs      []string
+	}{
+		{
+			name:   "valid",
+			global: `{"cni": "calico", "disable-kube-proxy": "true", "kube-apiserver-arg": ["a=b"], "tls-san": "example.com", "profile": null}`,
+			selectors: []rkev1.RKESystemConfig{
+				testSelectorConfig(workers, `{"kubelet-arg": ["max-pods=250"], "protect-kernel-defaults": true}`),
+				testSelectorConfig(nil, `{"system-default-registry": "registry.example.com"}`),
+			},
+		},
+		{
+			name:   "unknown flag",
+			global: `{"kube-apiserver-args": ["a=b"]}`,
+			errs:   []string{`spec.rkeConfig.machineGlobalConfig[kube-apiserver-args]: Unsupported value: "kube-apiserver-args": unknown flag for rke2 v1.25.3+rke2r1`},
+		},
+		{
+			name:   "wrong types",
+			global: `{"kube-apiserver-arg": {"a": "b"}, "disable-kube-proxy": "yes", "system-default-registry": ["a", "b"], "kubelet-arg": [1]}`,
+			errs: []string{
+				`spec.rkeConfig.machineGlobalConfig[disable-kube-proxy]: Invalid value: "yes": must be a boolean`,
+				`spec.rkeConfig.machineGlobalConfig[kube-apiserver-arg]: Invalid value: map[string]interface {}{"a":"b"}: must be a string or a list of strings, numbers and objects are not supported and would be ignored`,
+				`spec.rkeConfig.machineGlobalConfig[kubelet-arg][0]: Invalid value: 1: must be a string`,
+				`spec.rkeConfig.machineGlobalConfig[system-default-registry]: Invalid value: []interface {}{"a", "b"}: must be a string, not a list`,
+			},
+		},
+		{
+			name:   "options",
+			global: `{"cni": ["calico", "flannel"], "profile": "cis-1.5"}`,
+			errs: []string{
+				`spec.rkeConfig.machineGlobalConfig[cni][1]: Unsupported value: "flannel": supported values: "none", "canal", "cilium", "calico", "multus"`,
+				`spec.rkeConfig.machineGlobalConfig[profile]: Unsupported value: "cis-1.5": supported values: "cis-1.6"`,
+			},
+		},
+		{
+			name:      "managed flags",
+			selectors: []rkev1.RKESystemConfig{testSelectorConfig(nil, `{"token": "secret", "server": "https://example.com:9345"}`)},
+			errs: []string{
+				`spec.rkeConfig.machineSelectorConfig[0].config[server]: Forbidden: is managed by Rancher`,
+				`spec.rkeConfig.machineSelectorConfig[0].config[token]: Forbidden: is managed by Rancher`,
+			},
+		},
+		{
+			name:   "conflicting CNIs",
+			global: `{"cni": "none,calico"}`,
+			selectors: []rkev1.RKESystemConfig{
+				testSelectorConfig(cp0, `{"cni": "multus"}`),
+			},
+			errs: []string{
+				`spec.rkeConfig.machineGlobalConfig[cni]: Invalid value: "none,calico": none can't be combined with another CNI`,
+				`spec.rkeConfig.machineSelectorConfig[0].config[cni]: Invalid value: "multus": multus must be combined with another CNI`,
+				`spec.rkeConfig.machineGlobalConfig[cni]: Invalid value: "none,calico": etcd and control plane machines must use the same CNI, machine cp-0 uses multus from spec.rkeConfig.machineSelectorConfig[0].config[cni]`,
+			},
+		},
+		{
+			name: "server flags on workers",
+			selectors: []rkev1.RKESystemConfig{
+				testSelectorConfig(workers, `{"disable-kube-proxy": true, "kubelet-arg": ["max-pods=250"]}`),
+			},
+			errs: []string{
+				`spec.rkeConfig.machineSelectorConfig[0].config[disable-kube-proxy]: Invalid value: "disable-kube-proxy": is only supported on etcd and control plane machines, and the machine selector only selects workers`,
+			},
+		},
+		{
+			name: "invalid selector",
+			selectors: []rkev1.RKESystemConfig{
+				testSelectorConfig(&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "role", Operator: "Is"}}}, `{}`),
+			},
+			errs: []string{
+				`spec.rkeConfig.machineSelectorConfig[0].machineLabelSelector: Invalid value: "&LabelSelector{MatchLabels:map[string]string{},MatchExpressions:[]LabelSelectorRequirement{LabelSelectorRequirement{Key:role,Operator:Is,Values:[],},},}": "Is" is not a valid pod selector operator`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateConfig(newTestConfigControlPlane(tt.global, tt.selectors...), testConfigRelease, newTestConfigPlan())
+			var messages []string
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			assert.Equal(t, tt.errs, messages)
+		})
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	cp := newTestConfigControlPlane(`{"kube-apiserver-args": ["a=b"]}`)
+
+	// a new cluster is checked
+	err := checkConfig(cp, rkev1.RKEControlPlaneStatus{}, testConfigRelease, newTestConfigPlan())
+	assert.ErrorAs(t, err, new(ErrInvalidConfig))
+
+	// the config of a cluster that was applied before isn't checked again
+	status := rkev1.RKEControlPlaneStatus{AppliedSpec: cp.Spec.DeepCopy()}
+	assert.NoError(t, checkConfig(cp, status, testConfigRelease, newTestConfigPlan()))
+
+	// unless the version changes
+	cp.Spec.KubernetesVersion = "v1.25.4+rke2r1"
+	assert.ErrorAs(t, checkConfig(cp, status, testConfigRelease, newTestConfigPlan()), new(ErrInvalidConfig))
+
+	// and nothing is checked without KDM data
+	assert.NoError(t, checkConfig(cp, status, model.Release{}, newTestConfigPlan()))
+}
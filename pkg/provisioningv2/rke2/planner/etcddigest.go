@@ -0,0 +1,117 @@
+package planner
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+)
+
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+var sha256Digest = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// verifyEtcdSnapshotSHA256 checks an S3 snapshot against its digest before the cluster is shut down to restore it.
+// Local snapshots are checked on their node by the restore plan, see etcdSnapshotSHA256Check.
+func (p *Planner) verifyEtcdSnapshotSHA256(controlPlane *rkev1.RKEControlPlane, snapshot *rkev1.ETCDSnapshot) error {
+	if snapshot.SnapshotFile.SHA256 == "" || snapshot.SnapshotFile.S3 == nil {
+		return nil
+	}
+	if !sha256Digest.MatchString(snapshot.SnapshotFile.SHA256) {
+		return fmt.Errorf("etcd snapshot %s/%s has an invalid SHA-256 digest [%s]", snapshot.Namespace, snapshot.Name, snapshot.SnapshotFile.SHA256)
+	}
+	digest, err := p.etcdS3Args.snapshotSHA256(p.ctx, snapshot.SnapshotFile.S3, controlPlane, snapshot.SnapshotFile.Name)
+	if err != nil {
+		return fmt.Errorf("failed to verify etcd snapshot %s/%s: %w", snapshot.Namespace, snapshot.Name, err)
+	}
+	if digest != snapshot.SnapshotFile.SHA256 {
+		return fmt.Errorf("etcd snapshot %s/%s doesn't match its SHA-256 digest, expected [%s] but S3 has [%s]: "+
+			"the snapshot was changed or is corrupt, clear its digest to restore it anyway", snapshot.Namespace, snapshot.Name, snapshot.SnapshotFile.SHA256, digest)
+	}
+	return nil
+}
+
+// etcdSnapshotSHA256Check returns the file and instruction that check a local snapshot against its digest on the node
+// it is restored on, or nothing if the snapshot has no digest. The check fails the restore plan before the etcd data
+// directory is removed.
+func etcdSnapshotSHA256Check(controlPlane *rkev1.RKEControlPlane, snapshot *rkev1.ETCDSnapshot) ([]plan.File, []plan.OneTimeInstruction, error) {
+	if snapshot.SnapshotFile.SHA256 == "" || snapshot.SnapshotFile.S3 != nil {
+		return nil, nil, nil
+	}
+	if !sha256Digest.MatchString(snapshot.SnapshotFile.SHA256) {
+		return nil, nil, fmt.Errorf("etcd snapshot %s/%s has an invalid SHA-256 digest [%s]", snapshot.Namespace, snapshot.Name, snapshot.SnapshotFile.SHA256)
+	}
+	checkFile := configFile(controlPlane, "etcd-snapshot.sha256")
+	return []plan.File{
+		{
+			Content: base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s  /var/lib/rancher/%s/server/db/snapshots/%s\n",
+				snapshot.SnapshotFile.SHA256, rke2.GetRuntime(controlPlane.Spec.KubernetesVersion), snapshot.SnapshotFile.Name))),
+			Path: checkFile,
+		},
+	}, []plan.OneTimeInstruction{
+		{
+			Name:    "verify-snapshot-sha256",
+			Command: "sha256sum",
+			Args:    []string{"-c", checkFile},
+		},
+	}, nil
+}
+
+// snapshotSHA256 downloads a snapshot from S3 with the same settings and credential the snapshot is restored with, and
+// returns its SHA-256 digest.
+func (s *s3Args) snapshotSHA256(ctx context.Context, s3 *rkev1.ETCDSnapshotS3, controlPlane *rkev1.RKEControlPlane, name string) (string, error) {
+	credName := s3.CloudCredentialName
+	if credName == "" && controlPlane.Spec.ETCD != nil && controlPlane.Spec.ETCD.S3 != nil {
+		credName = controlPlane.Spec.ETCD.S3.CloudCredentialName
+	}
+	s3Cred, err := getS3Credential(s.secretCache, controlPlane.Namespace, credName)
+	if err != nil {
+		return "", err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: s3.SkipSSLVerify || s3Cred.SkipSSLVerify,
+	}
+	if ca := first(s3.EndpointCA, s3Cred.EndpointCA); ca != "" {
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+		transport.TLSClientConfig.RootCAs.AppendCertsFromPEM([]byte(ca))
+	}
+	creds := credentials.NewIAM("")
+	if s3Cred.AccessKey != "" {
+		creds = credentials.NewStaticV4(s3Cred.AccessKey, s3Cred.SecretKey, "")
+	}
+	client, err := minio.New(first(first(s3.Endpoint, s3Cred.Endpoint), defaultS3Endpoint), &minio.Options{
+		Creds:     creds,
+		Region:    first(s3.Region, s3Cred.Region),
+		Secure:    true,
+		Transport: transport,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	object, err := client.GetObject(ctx, first(s3.Bucket, s3Cred.Bucket), path.Join(first(s3.Folder, s3Cred.Folder), name), minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, object); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
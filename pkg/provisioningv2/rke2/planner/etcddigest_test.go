@@ -0,0 +1,136 @@
+package planner
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	corecontrollers "github.com/rancher/wrangler/pkg/generated/controllers/core/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// snapshotSHA256 is the digest of the snapshot "snapshot".
+const snapshotSHA256 = "16a0eeb0791b6c92451fd284dd9f599e0a7dbe7f6ebea6e2d2d06c7f74aec112"
+
+type secretCache map[string]*corev1.Secret
+
+func (s secretCache) Get(namespace, name string) (*corev1.Secret, error) {
+	if secret, ok := s[namespace+"/"+name]; ok {
+		return secret, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+func (s secretCache) List(namespace string, selector labels.Selector) ([]*corev1.Secret, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s secretCache) AddIndexer(indexName string, indexer corecontrollers.SecretIndexer) {}
+
+func (s secretCache) GetByIndex(indexName, key string) ([]*corev1.Secret, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func TestVerifyEtcdSnapshotSHA256(t *testing.T) {
+	objects := map[string]string{"/snapshots/rke2/etcd-snapshot-1": "snapshot"}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !strings.Contains(req.Header.Get("Authorization"), "Credential=access-key/") {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+		object, ok := objects[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(rw, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		rw.Header().Set("Last-Modified", "Sat, 01 Oct 2022 00:00:00 GMT")
+		fmt.Fprint(rw, object)
+	}))
+	defer server.Close()
+
+	controlPlane := &rkev1.RKEControlPlane{}
+	controlPlane.Namespace = "fleet-default"
+	controlPlane.Spec.ETCD = &rkev1.ETCD{S3: &rkev1.ETCDSnapshotS3{CloudCredentialName: "cattle-global-data:cc-abcde"}}
+	p := &Planner{
+		ctx: context.Background(),
+		etcdS3Args: s3Args{secretCache: secretCache{
+			"cattle-global-data/cc-abcde": {Data: map[string][]byte{
+				"s3credentialConfig-accessKey":     []byte("access-key"),
+				"s3credentialConfig-secretKey":     []byte("secret-key"),
+				"s3credentialConfig-defaultRegion": []byte("us-east-1"),
+			}},
+		}},
+	}
+	snapshot := &rkev1.ETCDSnapshot{}
+	snapshot.SnapshotFile = rkev1.ETCDSnapshotFile{
+		Name: "etcd-snapshot-1",
+		S3: &rkev1.ETCDSnapshotS3{
+			Endpoint:   strings.TrimPrefix(server.URL, "https://"),
+			EndpointCA: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+			Bucket:     "snapshots",
+			Folder:     "rke2",
+		},
+		SHA256: snapshotSHA256,
+	}
+
+	assert.NoError(t, p.verifyEtcdSnapshotSHA256(controlPlane, snapshot))
+
+	objects["/snapshots/rke2/etcd-snapshot-1"] = "snapsh0t"
+	err := p.verifyEtcdSnapshotSHA256(controlPlane, snapshot)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't match its SHA-256 digest")
+
+	snapshot.SnapshotFile.SHA256 = ""
+	assert.NoError(t, p.verifyEtcdSnapshotSHA256(controlPlane, snapshot), "snapshots without a digest aren't checked")
+
+	snapshot.SnapshotFile.SHA256 = "$(reboot)"
+	assert.Error(t, p.verifyEtcdSnapshotSHA256(controlPlane, snapshot))
+}
+
+func TestEtcdSnapshotSHA256Check(t *testing.T) {
+	controlPlane := createTestControlPlane("v1.24.4+rke2r1")
+	snapshot := &rkev1.ETCDSnapshot{}
+	snapshot.SnapshotFile = rkev1.ETCDSnapshotFile{
+		Name:   "etcd-snapshot-1",
+		SHA256: snapshotSHA256,
+	}
+
+	files, instructions, err := etcdSnapshotSHA256Check(controlPlane, snapshot)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Len(t, instructions, 1)
+	content, err := base64.StdEncoding.DecodeString(files[0].Content)
+	require.NoError(t, err)
+	assert.Equal(t, snapshotSHA256+"  /var/lib/rancher/rke2/server/db/snapshots/etcd-snapshot-1\n", string(content))
+	assert.Equal(t, "sha256sum", instructions[0].Command)
+	assert.Equal(t, []string{"-c", files[0].Path}, instructions[0].Args)
+
+	snapshot.SnapshotFile.SHA256 = "$(reboot)"
+	_, _, err = etcdSnapshotSHA256Check(controlPlane, snapshot)
+	assert.Error(t, err, "digests are checked before they are written to the node")
+
+	snapshot.SnapshotFile.SHA256 = ""
+	files, instructions, err = etcdSnapshotSHA256Check(controlPlane, snapshot)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	assert.Empty(t, instructions)
+
+	snapshot.SnapshotFile.SHA256 = snapshotSHA256
+	snapshot.SnapshotFile.S3 = &rkev1.ETCDSnapshotS3{Bucket: "snapshots"}
+	files, instructions, err = etcdSnapshotSHA256Check(controlPlane, snapshot)
+	assert.NoError(t, err)
+	assert.Empty(t, files, "S3 snapshots are checked by Rancher")
+	assert.Empty(t, instructions)
+}
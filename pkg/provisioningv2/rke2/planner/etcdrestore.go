@@ -8,6 +8,7 @@
 	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 const ETCDRestoreMessage = "etcd restore"
@@ -89,6 +90,11 @@ func (p *Planner) generateEtcdSnapshotRestorePlan(controlPlane *rkev1.RKEControl
 		return plan.NodePlan{}, err
 	}
 
+	checkFiles, checkInstructions, err := etcdSnapshotSHA256Check(controlPlane, snapshot)
+	if err != nil {
+		return plan.NodePlan{}, err
+	}
+
 	// This is likely redundant but can make sense in the event that there is an external watchdog.
 	stopPlan, err := p.generateStopServiceAndKillAllPlan(controlPlane, tokensSecret, server, joinServer)
 	if err != nil {
@@ -98,6 +104,9 @@ func (p *Planner) generateEtcdSnapshotRestorePlan(controlPlane *rkev1.RKEControl
 	// make sure to install the desired version before performing restore
 	stopPlan.Instructions = append(stopPlan.Instructions, p.generateInstallInstructionWithSkipStart(controlPlane, server))
 
+	// check the snapshot before its etcd data directory is removed
+	stopPlan.Instructions = append(stopPlan.Instructions, checkInstructions...)
+
 	planInstructions := append(stopPlan.Instructions,
 		plan.OneTimeInstruction{
 			Name:    "remove-etcd-db-dir",
@@ -108,7 +117,7 @@ func (p *Planner) generateEtcdSnapshotRestorePlan(controlPlane *rkev1.RKEControl
 			}})
 
 	nodePlan := plan.NodePlan{
-		Files: s3Files,
+		Files: append(s3Files, checkFiles...),
 		Instructions: append(planInstructions, plan.OneTimeInstruction{
 			Name:    "restore",
 			Env:     s3Env,
@@ -207,16 +216,61 @@ func (p *Planner) runEtcdRestoreServiceStop(controlPlane *rkev1.RKEControlPlane,
 
 // runEtcdRestoreServiceStart walks through the reconciliation process for the entire cluster.
 // Notably, this function will blatantly ignore drain and concurrency options, as during an etcd snapshot restore, there is no necessity to drain nodes.
-func (p *Planner) runEtcdRestoreServiceStart(controlPlane *rkev1.RKEControlPlane, tokensSecret plan.Secret, clusterPlan *plan.Plan) error {
-	if err := p.runEtcdSnapshotManagementServiceStart(controlPlane, tokensSecret, clusterPlan, isControlPlaneEtcd, "etcd restore"); err != nil {
+func (p *Planner) runEtcdRestoreServiceStart(controlPlane *rkev1.RKEControlPlane, snapshot *rkev1.ETCDSnapshot, tokensSecret plan.Secret, clusterPlan *plan.Plan) error {
+	if err := p.runEtcdSnapshotManagementServiceStart(controlPlane, tokensSecret, clusterPlan, isControlPlaneEtcd, "etcd restore",
+		restoredAgentInstructions(controlPlane, snapshot)...); err != nil {
 		return err
 	}
 	return p.runEtcdSnapshotWorkerServiceStart(controlPlane, tokensSecret, clusterPlan, "etcd restore")
 }
 
+// restoredAgentInstructions returns the instructions that remove the Rancher and Fleet agents from a cluster a snapshot
+// of another cluster was restored into. The agents and their credentials are restored with the snapshot, and would
+// connect to Rancher as the other cluster. The restored workloads would run next to the ones of the other cluster, and
+// write to the same external systems, so deployments and statefulsets are scaled down, daemonsets aren't scheduled
+// anymore and cronjobs are suspended outside of the system namespaces. This happens on the init node once the API
+// server answers, before the other nodes are started, and the agent of the cluster itself is applied from its manifest
+// again.
+func restoredAgentInstructions(controlPlane *rkev1.RKEControlPlane, snapshot *rkev1.ETCDSnapshot) []plan.OneTimeInstruction {
+	if snapshot == nil || snapshot.Labels[rke2.ClusterNameLabel] == "" || snapshot.Labels[rke2.ClusterNameLabel] == controlPlane.Spec.ClusterName {
+		return nil
+	}
+	runtime := rke2.GetRuntime(controlPlane.Spec.KubernetesVersion)
+	kubectl := fmt.Sprintf("/var/lib/rancher/%[1]s/bin/kubectl --kubeconfig /etc/rancher/%[1]s/%[1]s.yaml", runtime)
+	if runtime == rke2.RuntimeK3S {
+		kubectl = "k3s kubectl"
+	}
+	return []plan.OneTimeInstruction{{
+		Name:    "remove-restored-agents",
+		Command: "sh",
+		Args: []string{
+			"-c",
+			fmt.Sprintf(`until %[1]s get --raw /readyz >/dev/null 2>&1; do sleep 5; done
+%[1]s -n cattle-system delete deployment cattle-cluster-agent --ignore-not-found
+%[1]s -n cattle-system delete daemonset cattle-node-agent cattle-node-agent-windows --ignore-not-found
+%[1]s -n cattle-system get secret -o name | grep '^secret/cattle-credentials-' | xargs -r %[1]s -n cattle-system delete
+%[1]s -n cattle-fleet-system delete deployment fleet-agent --ignore-not-found
+%[1]s -n cattle-fleet-system delete secret fleet-agent fleet-agent-bootstrap --ignore-not-found
+for ns in $(%[1]s get namespace -o jsonpath='{.items[*].metadata.name}'); do
+  case "$ns" in kube-*|cattle-*|calico-system|tigera-operator) continue ;; esac
+  %[1]s -n "$ns" scale deployment,statefulset --all --replicas=0
+  for ds in $(%[1]s -n "$ns" get daemonset -o name); do
+    %[1]s -n "$ns" patch "$ds" -p '{"spec":{"template":{"spec":{"nodeSelector":{"cattle.io/restored-workload":"scaled-down"}}}}}'
+  done
+  for cj in $(%[1]s -n "$ns" get cronjob -o name); do
+    %[1]s -n "$ns" patch "$cj" -p '{"spec":{"suspend":true}}'
+  done
+done
+%[1]s -n kube-system delete addon cluster-agent --ignore-not-found`, kubectl),
+		},
+	}}
+}
+
 // runEtcdSnapshotManagementServiceStart walks through the reconciliation process for the controlplane and etcd nodes.
 // Notably, this function will blatantly ignore drain and concurrency options, as during an etcd snapshot operation, there is no necessity to drain nodes.
-func (p *Planner) runEtcdSnapshotManagementServiceStart(controlPlane *rkev1.RKEControlPlane, tokensSecret plan.Secret, clusterPlan *plan.Plan, include roleFilter, operation string) error {
+// The init node runs the given instructions after it has been started, before the other nodes are started.
+func (p *Planner) runEtcdSnapshotManagementServiceStart(controlPlane *rkev1.RKEControlPlane, tokensSecret plan.Secret, clusterPlan *plan.Plan, include roleFilter, operation string,
+	initNodeInstructions ...plan.OneTimeInstruction) error {
 	_, joinServer, initNode, err := p.findInitNode(controlPlane, clusterPlan)
 	if err != nil {
 		return err
@@ -229,6 +283,7 @@ func (p *Planner) runEtcdSnapshotManagementServiceStart(controlPlane *rkev1.RKEC
 	if err != nil {
 		return err
 	}
+	plan.Instructions = append(plan.Instructions, initNodeInstructions...)
 
 	if err = assignAndCheckPlan(p.store, fmt.Sprintf("%s bootstrap restart", operation), initNode, plan, 1, -1); err != nil {
 		return err
@@ -288,7 +343,7 @@ func (p *Planner) retrieveEtcdSnapshot(controlPlane *rkev1.RKEControlPlane) (*rk
 // restoreEtcdSnapshot is called multiple times during an etcd snapshot restoration.
 // restoreEtcdSnapshot utilizes the status of the corresponding control plane object of the cluster to track state
 // The phases are in order:
-// Started -> When the phase is started, it gets set to shutdown
+// Started -> When the phase is started, the snapshot is checked against its digest if it is in S3, then the phase gets set to shutdown
 // Shutdown -> When the phase is shutdown, it attempts to shut down etcd on all nodes (stop etcd)
 // Restore ->  When the phase is restore, it attempts to restore etcd
 // Finished -> When the phase is finished, Restore returns nil.
@@ -303,6 +358,13 @@ func (p *Planner) restoreEtcdSnapshot(cp *rkev1.RKEControlPlane, status rkev1.RK
 
 	switch cp.Status.ETCDSnapshotRestorePhase {
 	case rkev1.ETCDSnapshotPhaseStarted:
+		snapshot, err := p.retrieveEtcdSnapshot(cp)
+		if err != nil {
+			return status, err
+		}
+		if err = p.verifyEtcdSnapshotSHA256(cp, snapshot); err != nil {
+			return status, err
+		}
 		return p.setEtcdSnapshotRestoreState(status, cp.Spec.ETCDSnapshotRestore, rkev1.ETCDSnapshotPhaseShutdown)
 	case rkev1.ETCDSnapshotPhaseShutdown:
 		snapshot, err := p.retrieveEtcdSnapshot(cp)
@@ -324,7 +386,11 @@ func (p *Planner) restoreEtcdSnapshot(cp *rkev1.RKEControlPlane, status rkev1.RK
 		status.ConfigGeneration++
 		return p.setEtcdSnapshotRestoreState(status, cp.Spec.ETCDSnapshotRestore, rkev1.ETCDSnapshotPhaseRestartCluster)
 	case rkev1.ETCDSnapshotPhaseRestartCluster:
-		if err := p.runEtcdRestoreServiceStart(cp, tokensSecret, clusterPlan); err != nil {
+		snapshot, err := p.retrieveEtcdSnapshot(cp)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return status, err
+		}
+		if err := p.runEtcdRestoreServiceStart(cp, snapshot, tokensSecret, clusterPlan); err != nil {
 			return status, err
 		}
 		return p.setEtcdSnapshotRestoreState(status, cp.Spec.ETCDSnapshotRestore, rkev1.ETCDSnapshotPhaseFinished)
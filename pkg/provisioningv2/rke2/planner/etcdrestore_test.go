@@ -0,0 +1,38 @@
+package planner
+
+import (
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestoredAgentInstructions(t *testing.T) {
+	controlPlane := createTestControlPlane("v1.24.4+rke2r1")
+	controlPlane.Spec.ClusterName = "scratch"
+	snapshot := &rkev1.ETCDSnapshot{ObjectMeta: metav1.ObjectMeta{
+		Name:   "prod-etcd-snapshot-1",
+		Labels: map[string]string{rke2.ClusterNameLabel: "scratch"},
+	}}
+
+	assert.Empty(t, restoredAgentInstructions(controlPlane, snapshot), "the agent of a cluster is kept when its own snapshot is restored")
+	assert.Empty(t, restoredAgentInstructions(controlPlane, nil))
+
+	snapshot.Labels[rke2.ClusterNameLabel] = "prod"
+	instructions := restoredAgentInstructions(controlPlane, snapshot)
+	require.Len(t, instructions, 1)
+	require.Len(t, instructions[0].Args, 2)
+	assert.Contains(t, instructions[0].Args[1], "/var/lib/rancher/rke2/bin/kubectl --kubeconfig /etc/rancher/rke2/rke2.yaml -n cattle-system delete deployment cattle-cluster-agent")
+	assert.Contains(t, instructions[0].Args[1], "-n cattle-fleet-system delete deployment fleet-agent")
+	assert.Contains(t, instructions[0].Args[1], `-n "$ns" scale deployment,statefulset --all --replicas=0`, "the restored workloads don't run next to the ones of the cluster")
+	assert.Contains(t, instructions[0].Args[1], `'{"spec":{"suspend":true}}'`)
+	assert.Contains(t, instructions[0].Args[1], "delete addon cluster-agent", "the agent of the cluster is applied again")
+
+	controlPlane.Spec.KubernetesVersion = "v1.24.4+k3s1"
+	instructions = restoredAgentInstructions(controlPlane, snapshot)
+	require.Len(t, instructions, 1)
+	assert.Contains(t, instructions[0].Args[1], "k3s kubectl -n cattle-system delete deployment cattle-cluster-agent")
+}
@@ -0,0 +1,177 @@
+package planner
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/rancher/pkg/etcdretention"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	etcdSnapshotPruneInstructionName = "etcd-snapshot-prune"
+	// policySnapshotRetention keeps the distribution from pruning snapshots by count, the snapshots of a cluster with a
+	// retention policy are pruned by Rancher.
+	policySnapshotRetention = 100000
+	// s3Series is the series of snapshots that are only left in S3, their node is gone.
+	s3Series = "s3"
+)
+
+// etcdSnapshotName matches the names of snapshots that are safe to pass to the distribution in a shell command.
+var etcdSnapshotName = regexp.MustCompile(`^[a-zA-Z0-9][-_.a-zA-Z0-9]*$`)
+
+// snapshotRetentionPolicy returns the retention policy of a cluster, which isn't enabled if the distribution prunes
+// the snapshots of the cluster by count.
+func snapshotRetentionPolicy(controlPlane *rkev1.RKEControlPlane) etcdretention.Policy {
+	if controlPlane.Spec.ETCD == nil || controlPlane.Spec.ETCD.SnapshotRetentionPolicy == nil {
+		return etcdretention.Policy{}
+	}
+	return etcdretention.Policy(*controlPlane.Spec.ETCD.SnapshotRetentionPolicy)
+}
+
+// etcdSnapshotsToPrune returns the names of the snapshots of a cluster that its retention policy doesn't keep, by the
+// ID of the machine that has their local copy. Snapshots that are only left in S3 are returned under s3Series.
+//
+// The local copy of a snapshot and its copy in S3 have the same name and are pruned together, a snapshot is pinned if
+// either copy is. Every node is a series of its own, like the distribution prunes snapshots per node, and only
+// successful snapshots count towards the policy. The snapshot that is being restored, and the snapshot an upgrade is
+// rolled back to, are never pruned.
+func (p *Planner) etcdSnapshotsToPrune(controlPlane *rkev1.RKEControlPlane) (map[string][]string, error) {
+	policy := snapshotRetentionPolicy(controlPlane)
+	if !policy.Enabled() {
+		return nil, nil
+	}
+	snapshots, err := p.etcdSnapshotCache.List(controlPlane.Namespace, labels.SelectorFromSet(map[string]string{
+		rke2.ClusterNameLabel: controlPlane.Spec.ClusterName,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	type snapshotCopies struct {
+		name      string
+		series    string
+		created   time.Time
+		pinned    bool
+		failed    bool
+		restoring bool
+	}
+	byName := map[string]*snapshotCopies{}
+	for _, snapshot := range snapshots {
+		if snapshot.Status.Missing || snapshot.SnapshotFile.Name == "" {
+			continue
+		}
+		copies := byName[snapshot.SnapshotFile.Name]
+		if copies == nil {
+			copies = &snapshotCopies{name: snapshot.SnapshotFile.Name, series: s3Series}
+			byName[copies.name] = copies
+		}
+		if snapshot.SnapshotFile.S3 == nil && snapshot.Labels[rke2.MachineIDLabel] != "" {
+			copies.series = snapshot.Labels[rke2.MachineIDLabel]
+		}
+		created := snapshot.CreationTimestamp.Time
+		if snapshot.SnapshotFile.CreatedAt != nil {
+			created = snapshot.SnapshotFile.CreatedAt.Time
+		}
+		if copies.created.IsZero() || created.Before(copies.created) {
+			copies.created = created
+		}
+		copies.pinned = copies.pinned || snapshot.Spec.Pinned
+		copies.failed = copies.failed || snapshot.SnapshotFile.Status == "failed"
+		copies.restoring = copies.restoring || (controlPlane.Spec.ETCDSnapshotRestore != nil &&
+			controlPlane.Spec.ETCDSnapshotRestore.Name == snapshot.Name) ||
+			upgradeRollbackSnapshot(controlPlane) == snapshot.Name
+	}
+
+	series := map[string][]*snapshotCopies{}
+	for _, copies := range byName {
+		if copies.pinned || copies.failed {
+			continue
+		}
+		series[copies.series] = append(series[copies.series], copies)
+	}
+
+	result := map[string][]string{}
+	for key, snapshots := range series {
+		created := make([]time.Time, len(snapshots))
+		for i, snapshot := range snapshots {
+			created[i] = snapshot.created
+		}
+		for i, keep := range etcdretention.Keep(policy, created) {
+			if keep || snapshots[i].restoring {
+				continue
+			}
+			if !etcdSnapshotName.MatchString(snapshots[i].name) {
+				return nil, fmt.Errorf("etcd snapshot [%s] of cluster %s/%s has an invalid name and can't be pruned",
+					snapshots[i].name, controlPlane.Namespace, controlPlane.Spec.ClusterName)
+			}
+			result[key] = append(result[key], snapshots[i].name)
+		}
+		sort.Strings(result[key])
+	}
+	return result, nil
+}
+
+// addEtcdSnapshotPrune adds the list of snapshots that an etcd node prunes to its plan, and the periodic instruction
+// that deletes them with the distribution. The instruction is given the S3 settings of the cluster, so that the copies
+// of the snapshots in S3 are deleted too. The list is a minor change of the plan, the node isn't drained to update it.
+// The init node also prunes the snapshots that are only left in S3.
+func (p *Planner) addEtcdSnapshotPrune(nodePlan plan.NodePlan, controlPlane *rkev1.RKEControlPlane, entry *planEntry) (plan.NodePlan, error) {
+	if !snapshotRetentionPolicy(controlPlane).Enabled() {
+		return nodePlan, nil
+	}
+	prune, err := p.etcdSnapshotsToPrune(controlPlane)
+	if err != nil {
+		return nodePlan, err
+	}
+	var names []string
+	if entry.Machine != nil && entry.Machine.Labels[rke2.MachineIDLabel] != "" {
+		names = append(names, prune[entry.Machine.Labels[rke2.MachineIDLabel]]...)
+	}
+	if isInitNode(entry) {
+		names = append(names, prune[s3Series]...)
+	}
+	var content string
+	if len(names) > 0 {
+		content = strings.Join(names, "\n") + "\n"
+	}
+
+	var clusterS3 *rkev1.ETCDSnapshotS3
+	if controlPlane.Spec.ETCD != nil {
+		clusterS3 = controlPlane.Spec.ETCD.S3
+	}
+	s3Args, s3Env, s3Files, err := p.etcdS3Args.ToArgs(clusterS3, controlPlane, "etcd-", true)
+	if err != nil {
+		return nodePlan, err
+	}
+
+	pruneFile := configFile(controlPlane, etcdSnapshotPruneInstructionName)
+	nodePlan.Files = append(nodePlan.Files, s3Files...)
+	nodePlan.Files = append(nodePlan.Files, plan.File{
+		Content: base64.StdEncoding.EncodeToString([]byte(content)),
+		Path:    pruneFile,
+		Dynamic: true,
+		Minor:   true,
+	})
+	// the S3 settings are passed as the positional arguments of the script, so they are never parsed by the shell
+	nodePlan.PeriodicInstructions = append(nodePlan.PeriodicInstructions, plan.PeriodicInstruction{
+		Name:    etcdSnapshotPruneInstructionName,
+		Command: "sh",
+		Env:     s3Env,
+		Args: append([]string{
+			"-c",
+			fmt.Sprintf(`[ -s %s ] || exit 0; for s in $(cat %s); do %s etcd-snapshot delete "$@" $s; done`,
+				pruneFile, pruneFile, rke2.GetRuntime(controlPlane.Spec.KubernetesVersion)),
+			etcdSnapshotPruneInstructionName,
+		}, s3Args...),
+		PeriodSeconds: 600,
+	})
+	return nodePlan, nil
+}
@@ -0,0 +1,156 @@
+package planner
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	rkecontrollers "github.com/rancher/rancher/pkg/generated/controllers/rke.cattle.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+type etcdSnapshotCache []*rkev1.ETCDSnapshot
+
+func (e etcdSnapshotCache) Get(namespace, name string) (*rkev1.ETCDSnapshot, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (e etcdSnapshotCache) List(namespace string, selector labels.Selector) (result []*rkev1.ETCDSnapshot, _ error) {
+	for _, snapshot := range e {
+		if snapshot.Namespace == namespace && selector.Matches(labels.Set(snapshot.Labels)) {
+			result = append(result, snapshot)
+		}
+	}
+	return result, nil
+}
+
+func (e etcdSnapshotCache) AddIndexer(indexName string, indexer rkecontrollers.ETCDSnapshotIndexer) {}
+
+func (e etcdSnapshotCache) GetByIndex(indexName, key string) ([]*rkev1.ETCDSnapshot, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func newTestETCDSnapshot(name, machineID string, created time.Time) *rkev1.ETCDSnapshot {
+	snapshot := &rkev1.ETCDSnapshot{}
+	snapshot.Namespace = "fleet-default"
+	snapshot.Name = "test-" + name + "-local"
+	snapshot.Labels = map[string]string{
+		rke2.ClusterNameLabel: "test",
+		rke2.MachineIDLabel:   machineID,
+	}
+	snapshot.SnapshotFile = rkev1.ETCDSnapshotFile{
+		Name:      name,
+		CreatedAt: &metav1.Time{Time: created},
+		Status:    "successful",
+	}
+	if machineID == "" {
+		snapshot.Name = "test-" + name + "-s3"
+		snapshot.Labels = map[string]string{
+			rke2.ClusterNameLabel: "test",
+			rke2.NodeNameLabel:    "s3",
+		}
+		snapshot.SnapshotFile.NodeName = "s3"
+		snapshot.SnapshotFile.S3 = &rkev1.ETCDSnapshotS3{Bucket: "snapshots"}
+	}
+	return snapshot
+}
+
+func TestEtcdSnapshotsToPrune(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	pinned := newTestETCDSnapshot("etcd-snapshot-node1-pinned", "machine1", now.Add(-3*time.Hour))
+	pinned.Spec.Pinned = true
+	failed := newTestETCDSnapshot("etcd-snapshot-node1-failed", "machine1", now.Add(-4*time.Hour))
+	failed.SnapshotFile.Status = "failed"
+	missing := newTestETCDSnapshot("etcd-snapshot-node1-missing", "machine1", now.Add(-5*time.Hour))
+	missing.Status.Missing = true
+	otherCluster := newTestETCDSnapshot("etcd-snapshot-other-1", "machine1", now.Add(-6*time.Hour))
+	otherCluster.Labels[rke2.ClusterNameLabel] = "other"
+	restoring := newTestETCDSnapshot("etcd-snapshot-node2-3", "machine2", now.Add(-7*time.Hour))
+
+	p := &Planner{etcdSnapshotCache: etcdSnapshotCache{
+		newTestETCDSnapshot("etcd-snapshot-node1-1", "machine1", now),
+		newTestETCDSnapshot("etcd-snapshot-node1-1", "", now.Add(time.Minute)),
+		newTestETCDSnapshot("etcd-snapshot-node1-2", "machine1", now.Add(-time.Hour)),
+		newTestETCDSnapshot("etcd-snapshot-node1-2", "", now.Add(-time.Hour)),
+		pinned,
+		newTestETCDSnapshot("etcd-snapshot-node1-pinned", "", now.Add(-3*time.Hour)),
+		failed,
+		missing,
+		otherCluster,
+		newTestETCDSnapshot("etcd-snapshot-node2-1", "machine2", now),
+		newTestETCDSnapshot("etcd-snapshot-node2-2", "machine2", now.Add(-time.Hour)),
+		restoring,
+		newTestETCDSnapshot("etcd-snapshot-node3-1", "", now.Add(-2*time.Hour)),
+		newTestETCDSnapshot("etcd-snapshot-node3-2", "", now.Add(-3*time.Hour)),
+	}}
+	controlPlane := createTestControlPlane("v1.24.4+rke2r1")
+	controlPlane.Namespace = "fleet-default"
+	controlPlane.Spec.ClusterName = "test"
+	controlPlane.Spec.ETCD = &rkev1.ETCD{SnapshotRetentionPolicy: &rkev1.ETCDSnapshotRetentionPolicy{Hourly: 1}}
+	controlPlane.Spec.ETCDSnapshotRestore = &rkev1.ETCDSnapshotRestore{Name: restoring.Name}
+
+	prune, err := p.etcdSnapshotsToPrune(controlPlane)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"machine1": {"etcd-snapshot-node1-2"},
+		"machine2": {"etcd-snapshot-node2-2"},
+		s3Series:   {"etcd-snapshot-node3-2"},
+	}, prune)
+
+	entry := &planEntry{
+		Machine: &capi.Machine{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{rke2.MachineIDLabel: "machine1"}}},
+		Metadata: &plan.Metadata{Labels: map[string]string{
+			rke2.EtcdRoleLabel: "true",
+			rke2.InitNodeLabel: "true",
+		}},
+	}
+	nodePlan, err := p.addEtcdSnapshotPrune(plan.NodePlan{}, controlPlane, entry)
+	require.NoError(t, err)
+	require.Len(t, nodePlan.Files, 1)
+	assert.True(t, nodePlan.Files[0].Minor, "updating the list doesn't drain the node")
+	content, err := base64.StdEncoding.DecodeString(nodePlan.Files[0].Content)
+	require.NoError(t, err)
+	assert.Equal(t, "etcd-snapshot-node1-2\netcd-snapshot-node3-2\n", string(content), "the init node prunes snapshots that are only in S3")
+	require.Len(t, nodePlan.PeriodicInstructions, 1)
+	assert.Equal(t, etcdSnapshotPruneInstructionName, nodePlan.PeriodicInstructions[0].Name)
+
+	assert.Equal(t, []string{"-c",
+		`[ -s /var/lib/rancher/rke2/etc/config-files/etcd-snapshot-prune ] || exit 0; for s in $(cat /var/lib/rancher/rke2/etc/config-files/etcd-snapshot-prune); do rke2 etcd-snapshot delete "$@" $s; done`,
+		etcdSnapshotPruneInstructionName,
+	}, nodePlan.PeriodicInstructions[0].Args, "the cluster has no S3 settings")
+
+	// the S3 copies are deleted with the S3 settings of the cluster
+	controlPlane.Spec.ETCD.S3 = &rkev1.ETCDSnapshotS3{Bucket: "snapshots", Folder: "rke2", CloudCredentialName: "cattle-global-data:cc-abcde"}
+	p.etcdS3Args = s3Args{secretCache: secretCache{
+		"cattle-global-data/cc-abcde": {Data: map[string][]byte{
+			"s3credentialConfig-accessKey": []byte("access-key"),
+			"s3credentialConfig-secretKey": []byte("secret-key"),
+		}},
+	}}
+	nodePlan, err = p.addEtcdSnapshotPrune(plan.NodePlan{}, controlPlane, entry)
+	require.NoError(t, err)
+	require.Len(t, nodePlan.PeriodicInstructions, 1)
+	assert.Equal(t, []string{"--etcd-s3-bucket=snapshots", "--etcd-s3-access-key=access-key", "--etcd-s3-folder=rke2", "--etcd-s3"},
+		nodePlan.PeriodicInstructions[0].Args[3:])
+	assert.Equal(t, []string{"AWS_SECRET_ACCESS_KEY=secret-key"}, nodePlan.PeriodicInstructions[0].Env)
+
+	controlPlane.Spec.ETCD.SnapshotRetentionPolicy = &rkev1.ETCDSnapshotRetentionPolicy{}
+	nodePlan, err = p.addEtcdSnapshotPrune(plan.NodePlan{}, controlPlane, entry)
+	require.NoError(t, err)
+	assert.Empty(t, nodePlan.Files, "the distribution prunes snapshots without a policy")
+	assert.Empty(t, nodePlan.PeriodicInstructions)
+
+	controlPlane.Spec.ETCD.SnapshotRetentionPolicy = &rkev1.ETCDSnapshotRetentionPolicy{Hourly: 1}
+	p.etcdSnapshotCache = append(p.etcdSnapshotCache.(etcdSnapshotCache),
+		newTestETCDSnapshot("$(reboot)", "machine1", now.Add(-24*time.Hour)))
+	_, err = p.etcdSnapshotsToPrune(controlPlane)
+	assert.Error(t, err, "names are checked before they are written to the node")
+}
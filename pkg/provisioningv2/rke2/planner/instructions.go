@@ -151,3 +151,24 @@ func (p *Planner) addEtcdSnapshotListS3PeriodicInstruction(nodePlan plan.NodePla
 	})
 	return nodePlan, nil
 }
+
+// addEtcdSnapshotSHA256LocalPeriodicInstruction lists the SHA-256 digests of the local snapshots of an etcd node, in the
+// output format of sha256sum. Digests are cached next to the snapshot directory so that every snapshot is only read
+// once, and snapshots that may still be written to are skipped.
+func (p *Planner) addEtcdSnapshotSHA256LocalPeriodicInstruction(nodePlan plan.NodePlan, controlPlane *rkev1.RKEControlPlane) (plan.NodePlan, error) {
+	nodePlan.PeriodicInstructions = append(nodePlan.PeriodicInstructions, plan.PeriodicInstruction{
+		Name:    "etcd-snapshot-sha256-local",
+		Command: "sh",
+		Args: []string{
+			"-c",
+			fmt.Sprintf("cd /var/lib/rancher/%s/server/db/snapshots 2>/dev/null || exit 0; "+
+				"mkdir -p ../snapshot-sha256; "+
+				"for f in $(find . -maxdepth 1 -type f ! -name '*.part' -mmin +1 | sed 's|^./||'); do "+
+				"[ -s ../snapshot-sha256/$f ] || sha256sum $f > ../snapshot-sha256/$f; cat ../snapshot-sha256/$f; done; "+
+				"for c in ../snapshot-sha256/*; do [ -e $(basename $c) ] || rm -f $c; done",
+				rke2.GetRuntime(controlPlane.Spec.KubernetesVersion)),
+		},
+		PeriodSeconds: 600,
+	})
+	return nodePlan, nil
+}
@@ -0,0 +1,85 @@
+package planner
+
+import (
+	"fmt"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/robfig/cron"
+)
+
+const waitingForMaintenanceWindowMessage = "waiting for maintenance window"
+
+// maintenanceWindow is whether plan changes that restart nodes can be rolled out during a run of the planner, and the
+// machines whose plan changes were held because they can't.
+type maintenanceWindow struct {
+	open bool
+	next time.Time
+	held []string
+}
+
+// newMaintenanceWindow returns whether the maintenance windows of a cluster are open at the given time, and when the
+// next window opens if they aren't.
+func newMaintenanceWindow(strategy rkev1.ClusterUpgradeStrategy, now time.Time) (*maintenanceWindow, error) {
+	window := &maintenanceWindow{open: true}
+	if len(strategy.MaintenanceWindows) == 0 || strategy.IgnoreMaintenanceWindows {
+		return window, nil
+	}
+
+	window.open = false
+	for _, w := range strategy.MaintenanceWindows {
+		if w.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("maintenance window [%s] has no duration", w.Schedule)
+		}
+		location := time.UTC
+		if w.TimeZone != "" {
+			var err error
+			if location, err = time.LoadLocation(w.TimeZone); err != nil {
+				return nil, fmt.Errorf("maintenance window [%s] has an invalid time zone: %w", w.Schedule, err)
+			}
+		}
+		schedule, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("maintenance window [%s] has an invalid schedule: %w", w.Schedule, err)
+		}
+
+		// the window is open if it opened within its duration before now
+		local := now.In(location)
+		if start := schedule.Next(local.Add(-w.Duration.Duration)); !start.After(local) {
+			window.open = true
+		}
+		if next := schedule.Next(local); window.next.IsZero() || next.Before(window.next) {
+			window.next = next
+		}
+	}
+	return window, nil
+}
+
+// hold returns whether a plan change of the machine of the entry is held until the next window. A machine that is
+// being drained or uncordoned is in the middle of its upgrade, which is finished when the window closes.
+func (w *maintenanceWindow) hold(entry *planEntry) bool {
+	if w.open || isInDrain(entry) {
+		return false
+	}
+	w.held = append(w.held, entry.Machine.Name)
+	return true
+}
+
+// setMaintenanceWindowPending sets the MaintenanceWindowPending condition of a control plane to whether the planner held
+// plan changes, and enqueues the control plane for when the next window opens if it did.
+func (p *Planner) setMaintenanceWindowPending(controlPlane *rkev1.RKEControlPlane, status *rkev1.RKEControlPlaneStatus, window *maintenanceWindow) {
+	if len(window.held) == 0 {
+		if rke2.MaintenanceWindowPending.GetStatus(status) != "" {
+			rke2.MaintenanceWindowPending.False(status)
+			rke2.MaintenanceWindowPending.Message(status, "")
+		}
+		return
+	}
+	rke2.MaintenanceWindowPending.True(status)
+	rke2.MaintenanceWindowPending.Message(status, fmt.Sprintf("plan changes of machine(s) %s are held until the next maintenance window at %s",
+		atMostThree(window.held), window.next.Format(time.RFC3339)))
+	if !window.next.IsZero() {
+		p.rkeControlPlanes.EnqueueAfter(controlPlane.Namespace, controlPlane.Name, time.Until(window.next))
+	}
+}
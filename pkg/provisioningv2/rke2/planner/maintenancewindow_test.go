@@ -0,0 +1,104 @@
+package planner
+
+import (
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestNewMaintenanceWindow(t *testing.T) {
+	strategy := rkev1.ClusterUpgradeStrategy{
+		MaintenanceWindows: []rkev1.MaintenanceWindow{
+			// Saturdays from 22:00 to 02:00 in Berlin
+			{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Berlin"},
+			// Wednesdays from 12:00 to 13:00 UTC
+			{Schedule: "0 12 * * 3", Duration: metav1.Duration{Duration: time.Hour}},
+		},
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		open bool
+		next time.Time
+	}{
+		{
+			name: "before the window",
+			now:  time.Date(2022, 10, 1, 19, 59, 0, 0, time.UTC),
+			next: time.Date(2022, 10, 1, 22, 0, 0, 0, berlin),
+		},
+		{
+			name: "opening",
+			now:  time.Date(2022, 10, 1, 20, 0, 0, 0, time.UTC),
+			open: true,
+			next: time.Date(2022, 10, 5, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "after midnight",
+			now:  time.Date(2022, 10, 2, 1, 30, 0, 0, berlin),
+			open: true,
+			next: time.Date(2022, 10, 5, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "closed",
+			now:  time.Date(2022, 10, 2, 2, 0, 0, 0, berlin),
+			next: time.Date(2022, 10, 5, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "second window",
+			now:  time.Date(2022, 10, 5, 12, 30, 0, 0, time.UTC),
+			open: true,
+			next: time.Date(2022, 10, 8, 22, 0, 0, 0, berlin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := newMaintenanceWindow(strategy, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.open, window.open)
+			assert.True(t, tt.next.Equal(window.next), "next window at %s, expected %s", window.next, tt.next)
+		})
+	}
+
+	window, err := newMaintenanceWindow(rkev1.ClusterUpgradeStrategy{}, time.Now())
+	require.NoError(t, err)
+	assert.True(t, window.open, "changes are rolled out immediately without windows")
+
+	strategy.IgnoreMaintenanceWindows = true
+	window, err = newMaintenanceWindow(strategy, time.Date(2022, 10, 1, 19, 59, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, window.open, "windows are ignored in emergencies")
+
+	_, err = newMaintenanceWindow(rkev1.ClusterUpgradeStrategy{MaintenanceWindows: []rkev1.MaintenanceWindow{{Schedule: "0 22 * * 6"}}}, time.Now())
+	assert.Error(t, err)
+	_, err = newMaintenanceWindow(rkev1.ClusterUpgradeStrategy{MaintenanceWindows: []rkev1.MaintenanceWindow{
+		{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus_Mons"},
+	}}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestMaintenanceWindowHold(t *testing.T) {
+	entry := &planEntry{
+		Machine:  &capi.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine1"}},
+		Metadata: &plan.Metadata{Annotations: map[string]string{}},
+	}
+
+	window := &maintenanceWindow{open: true}
+	assert.False(t, window.hold(entry))
+
+	window = &maintenanceWindow{}
+	assert.True(t, window.hold(entry))
+	assert.Equal(t, []string{"machine1"}, window.held)
+
+	entry.Metadata.Annotations[rke2.DrainAnnotation] = "{}"
+	assert.False(t, window.hold(entry), "a node that is being drained finishes its upgrade")
+}
@@ -11,6 +11,7 @@
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/moby/locker"
@@ -136,6 +137,7 @@ type Planner struct {
 	rancherClusterCache           ranchercontrollers.ClusterCache
 	locker                        locker.Locker
 	etcdS3Args                    s3Args
+	healthGates                   *healthGateQueries
 }
 
 func New(ctx context.Context, clients *wrangler.Context) *Planner {
@@ -161,6 +163,7 @@ func New(ctx context.Context, clients *wrangler.Context) *Planner {
 		etcdS3Args: s3Args{
 			secretCache: clients.Core.Secret().Cache(),
 		},
+		healthGates: &healthGateQueries{results: map[string]*healthGateResult{}},
 	}
 }
 
@@ -268,10 +271,22 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 		return status, err
 	}
 
+	// while an upgrade is rolled back, the rest of the planner works with the version it is rolled back to
+	desiredCP := cp
+	if cp, status, err = p.rollbackUpgrade(cp, status, plan); err != nil {
+		return status, err
+	}
+
 	if status, err = p.restoreEtcdSnapshot(cp, status, clusterSecretTokens, plan); err != nil {
 		return status, err
 	}
 
+	// the config is checked once snapshots are taken and restored, an invalid config can't block a restore that
+	// brings back the previous one
+	if err := checkConfig(desiredCP, status, *releaseData, plan); err != nil {
+		return status, err
+	}
+
 	if status, err = p.rotateCertificates(cp, status, plan); err != nil {
 		return status, err
 	}
@@ -286,6 +301,17 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 		return status, ErrWaitingf("CAPI cluster or RKEControlPlane is paused")
 	}
 
+	window, err := newMaintenanceWindow(cp.Spec.UpgradeStrategy, time.Now())
+	if err != nil {
+		return status, err
+	}
+
+	canaries, err := newCanaryRollout(cp.Spec.UpgradeStrategy.Canary, &status, time.Now())
+	if err != nil {
+		return status, err
+	}
+	canaries.query = p.queryHealthGate(cp)
+
 	// on the first run through, electInitNode will return a `generic.ErrSkip` as it is attempting to wait for the cache to catch up.
 	joinServer, err = p.electInitNode(cp, plan)
 	if err != nil {
@@ -295,7 +321,7 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 	// select all etcd and then filter to just initNodes so that unavailable count is correct
 	err = p.reconcile(cp, clusterSecretTokens, plan, true, bootstrapTier, isEtcd, isNotInitNodeOrIsDeleting,
 		"1", "",
-		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions)
+		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions, window, canaries)
 	firstIgnoreError, err = ignoreErrors(firstIgnoreError, err)
 	if err != nil {
 		return status, err
@@ -314,7 +340,7 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 
 	err = p.reconcile(cp, clusterSecretTokens, plan, true, etcdTier, isEtcd, isInitNodeOrDeleting,
 		"1", joinServer,
-		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions)
+		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions, window, canaries)
 	firstIgnoreError, err = ignoreErrors(firstIgnoreError, err)
 	if err != nil {
 		return status, err
@@ -322,7 +348,7 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 
 	err = p.reconcile(cp, clusterSecretTokens, plan, true, controlPlaneTier, isControlPlane, isInitNodeOrDeleting,
 		cp.Spec.UpgradeStrategy.ControlPlaneConcurrency, joinServer,
-		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions)
+		cp.Spec.UpgradeStrategy.ControlPlaneDrainOptions, window, canaries)
 	firstIgnoreError, err = ignoreErrors(firstIgnoreError, err)
 	if err != nil {
 		return status, err
@@ -341,17 +367,19 @@ func (p *Planner) Process(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlan
 
 	err = p.reconcile(cp, clusterSecretTokens, plan, false, workerTier, isOnlyWorker, isInitNodeOrDeleting,
 		cp.Spec.UpgradeStrategy.WorkerConcurrency, joinServer,
-		cp.Spec.UpgradeStrategy.WorkerDrainOptions)
+		cp.Spec.UpgradeStrategy.WorkerDrainOptions, window, canaries)
 	firstIgnoreError, err = ignoreErrors(firstIgnoreError, err)
 	if err != nil {
 		return status, err
 	}
 
+	p.setMaintenanceWindowPending(cp, &status, window)
+
 	if firstIgnoreError != nil {
 		return status, ErrWaiting(firstIgnoreError.Error())
 	}
 
-	return status, nil
+	return upgradeRolledBack(desiredCP, status)
 }
 
 func atMostThree(names []string) string {
@@ -722,13 +750,15 @@ func getTaints(entry *planEntry, cp *rkev1.RKEControlPlane) (result []corev1.Tai
 }
 
 func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret plan.Secret, clusterPlan *plan.Plan, required bool,
-	tierName string, include, exclude roleFilter, maxUnavailable string, joinServer string, drainOptions rkev1.DrainOptions) error {
+	tierName string, include, exclude roleFilter, maxUnavailable string, joinServer string, drainOptions rkev1.DrainOptions, window *maintenanceWindow,
+	canaries *canaryRollout) error {
 	var (
-		ready, outOfSync, reconciling, nonReady, errMachines, draining, uncordoned []string
-		messages                                                                   = map[string][]string{}
+		ready, outOfSync, reconciling, nonReady, errMachines, draining, uncordoned, held, canaryHeld []string
+		messages                                                                                     = map[string][]string{}
 	)
 
 	entries := collect(clusterPlan, include)
+	tierCanaries := canaries.tier(tierName, entries, exclude)
 
 	concurrency, unavailable, err := calculateConcurrency(maxUnavailable, entries, exclude)
 	if err != nil {
@@ -762,6 +792,7 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 		if err != nil {
 			return err
 		}
+		tierCanaries.check(entry, plan)
 
 		if entry.Plan == nil {
 			logrus.Debugf("[planner] rkecluster %s/%s reconcile tier %s - setting initial plan for machine %s/%s", controlPlane.Namespace, controlPlane.Name, tierName, entry.Machine.Namespace, entry.Machine.Name)
@@ -777,6 +808,14 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 			if err := p.store.UpdatePlan(entry, plan, -1, 1); err != nil {
 				return err
 			}
+		} else if !equality.Semantic.DeepEqual(entry.Plan.Plan, plan) && window.hold(entry) {
+			logrus.Debugf("[planner] rkecluster %s/%s reconcile tier %s - plan for machine %s/%s did not match, holding it until the next maintenance window", controlPlane.Namespace, controlPlane.Name, tierName, entry.Machine.Namespace, entry.Machine.Name)
+			held = append(held, entry.Machine.Name)
+			messages[entry.Machine.Name] = append(messages[entry.Machine.Name], waitingForMaintenanceWindowMessage)
+		} else if !equality.Semantic.DeepEqual(entry.Plan.Plan, plan) && tierCanaries.hold(entry) {
+			logrus.Debugf("[planner] rkecluster %s/%s reconcile tier %s - plan for machine %s/%s did not match, holding it until the canary machines are promoted", controlPlane.Namespace, controlPlane.Name, tierName, entry.Machine.Namespace, entry.Machine.Name)
+			canaryHeld = append(canaryHeld, entry.Machine.Name)
+			messages[entry.Machine.Name] = append(messages[entry.Machine.Name], waitingForCanariesMessage)
 		} else if !equality.Semantic.DeepEqual(entry.Plan.Plan, plan) {
 			logrus.Debugf("[planner] rkecluster %s/%s reconcile tier %s - plan for machine %s/%s did not match, appending to outOfSync", controlPlane.Namespace, controlPlane.Name, tierName, entry.Machine.Namespace, entry.Machine.Name)
 			outOfSync = append(outOfSync, entry.Machine.Name)
@@ -848,6 +887,8 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 		return ErrWaiting("waiting for at least one " + tierName + " node")
 	}
 
+	p.updateCanaryRollout(controlPlane, tierCanaries, ready)
+
 	// If multiple machines are changing status, then all of their statuses should be updated to avoid having stale conditions.
 	// However, only the first one will be returned so that status goes on the control plane and cluster objects.
 	var firstError error
@@ -867,6 +908,20 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 		firstError = err
 	}
 
+	// Unlike held machines, machines that wait for the canaries block the other tiers, which can't be upgraded before
+	// this one.
+	if err := p.setMachineConditionStatus(clusterPlan, canaryHeld, fmt.Sprintf("waiting for canaries to configure %s node(s) ", tierName), messages); err != nil && firstError == nil {
+		firstError = err
+	}
+
+	// Held machines don't block the other tiers, new machines join the cluster outside of maintenance windows too.
+	var heldError error
+	if err := p.setMachineConditionStatus(clusterPlan, held, fmt.Sprintf("waiting for maintenance window to configure %s node(s) ", tierName), messages); IsErrWaiting(err) {
+		heldError = errIgnore(err.Error())
+	} else if err != nil && firstError == nil {
+		firstError = err
+	}
+
 	// Ensure that the conditions that we control are updated.
 	if err := p.setMachineConditionStatus(clusterPlan, ready, "", nil); err != nil && firstError == nil {
 		firstError = err
@@ -886,7 +941,7 @@ func (p *Planner) reconcile(controlPlane *rkev1.RKEControlPlane, tokensSecret pl
 		return errIgnore("non-ready " + tierName + " machine(s) " + atMostThree(nonReady) + detailedMessage(nonReady, messages))
 	}
 
-	return nil
+	return heldError
 }
 
 // generatePlanWithConfigFiles will generate a node plan with the corresponding config files for the entry in question.
@@ -967,6 +1022,14 @@ func (p *Planner) desiredPlan(controlPlane *rkev1.RKEControlPlane, tokensSecret
 		if err != nil {
 			return nodePlan, err
 		}
+		nodePlan, err = p.addEtcdSnapshotSHA256LocalPeriodicInstruction(nodePlan, controlPlane)
+		if err != nil {
+			return nodePlan, err
+		}
+		nodePlan, err = p.addEtcdSnapshotPrune(nodePlan, controlPlane, entry)
+		if err != nil {
+			return nodePlan, err
+		}
 		if controlPlane != nil && controlPlane.Spec.ETCD != nil && S3Enabled(controlPlane.Spec.ETCD.S3) && isInitNode(entry) {
 			nodePlan, err = p.addEtcdSnapshotListS3PeriodicInstruction(nodePlan, controlPlane)
 			if err != nil {
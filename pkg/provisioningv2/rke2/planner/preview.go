@@ -0,0 +1,211 @@
+package planner
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/pmezard/go-difflib/difflib"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/wrangler/pkg/name"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// redactedConfigKeys matches the keys of the config file whose values aren't shown in a preview.
+var redactedConfigKeys = regexp.MustCompile(`token|secret|password|access-key`)
+
+// Preview returns the changes the spec of the given control plane makes to the plans of its machines, without updating
+// any plan. The plans are generated like reconcile does, and compared to the plans the machines have now.
+func (p *Planner) Preview(controlPlane *rkev1.RKEControlPlane) ([]rkev1.RKEPlanPreviewMachine, error) {
+	capiCluster, err := rke2.GetOwnerCAPICluster(controlPlane, p.capiClusters)
+	if err != nil {
+		return nil, err
+	}
+	if capiCluster == nil {
+		return nil, ErrWaiting("CAPI cluster does not exist")
+	}
+	clusterPlan, err := p.store.Load(capiCluster, controlPlane)
+	if err != nil {
+		return nil, err
+	}
+	if releaseData := rke2.GetKDMReleaseData(p.ctx, controlPlane); releaseData != nil {
+		if err := checkConfig(controlPlane, controlPlane.Status, *releaseData, clusterPlan); err != nil {
+			return nil, err
+		}
+	}
+	tokensSecret, err := p.loadRKEStateSecret(controlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	var initJoinServer string
+	for _, entry := range collect(clusterPlan, isInitNode) {
+		initJoinServer = entry.Metadata.Annotations[rke2.JoinURLAnnotation]
+	}
+	controlPlaneJoinServer := getControlPlaneJoinURL(clusterPlan)
+
+	var result []rkev1.RKEPlanPreviewMachine
+	for _, entry := range collect(clusterPlan, anyRole) {
+		if entry.Plan == nil || isDeleting(entry) {
+			// machines without a plan get the plan of the new spec when they join
+			continue
+		}
+
+		joinServer, drainOptions := initJoinServer, controlPlane.Spec.UpgradeStrategy.ControlPlaneDrainOptions
+		if isInitNode(entry) {
+			joinServer = ""
+		} else if isOnlyWorker(entry) {
+			joinServer, drainOptions = controlPlaneJoinServer, controlPlane.Spec.UpgradeStrategy.WorkerDrainOptions
+		}
+
+		newPlan, err := p.desiredPlan(controlPlane, tokensSecret, entry, joinServer)
+		if err != nil {
+			return nil, err
+		}
+		machine, err := previewMachine(entry.Plan.Plan, newPlan, controlPlane)
+		if err != nil {
+			return nil, err
+		}
+		machine.MachineName = entry.Machine.Name
+		if entry.Machine.Status.NodeRef != nil {
+			machine.NodeName = entry.Machine.Status.NodeRef.Name
+		}
+		// like drain, single node clusters aren't drained
+		machine.Drain = machine.RestartStampChanged && drainOptions.Enabled && len(clusterPlan.Machines) > 1
+		result = append(result, machine)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].MachineName < result[j].MachineName
+	})
+	return result, nil
+}
+
+// loadRKEStateSecret returns the tokens of the cluster like ensureRKEStateSecret, but doesn't create them.
+func (p *Planner) loadRKEStateSecret(controlPlane *rkev1.RKEControlPlane) (plan.Secret, error) {
+	if controlPlane.Spec.UnmanagedConfig {
+		return plan.Secret{}, nil
+	}
+	secret, err := p.secretCache.Get(controlPlane.Namespace, name.SafeConcatName(controlPlane.Name, "rke", "state"))
+	if apierror.IsNotFound(err) {
+		return plan.Secret{}, ErrWaiting("waiting for the cluster to be provisioned")
+	} else if err != nil {
+		return plan.Secret{}, err
+	}
+	return plan.Secret{
+		ServerToken: string(secret.Data["serverToken"]),
+		AgentToken:  string(secret.Data["agentToken"]),
+	}, nil
+}
+
+func previewMachine(oldPlan, newPlan plan.NodePlan, controlPlane *rkev1.RKEControlPlane) (rkev1.RKEPlanPreviewMachine, error) {
+	machine := rkev1.RKEPlanPreviewMachine{
+		Changed:             !equality.Semantic.DeepEqual(oldPlan, newPlan),
+		Minor:               minorPlanChangeDetected(oldPlan, newPlan),
+		RestartStampChanged: shouldDrain(&oldPlan, newPlan),
+	}
+
+	oldFiles := map[string]plan.File{}
+	for _, file := range oldPlan.Files {
+		oldFiles[file.Path] = file
+	}
+	configPath := fmt.Sprintf(ConfigYamlFileName, rke2.GetRuntime(controlPlane.Spec.KubernetesVersion))
+	for _, file := range newPlan.Files {
+		oldFile, ok := oldFiles[file.Path]
+		delete(oldFiles, file.Path)
+		if ok && oldFile.Content == file.Content {
+			continue
+		}
+		change := rkev1.RKEPlanPreviewFile{
+			Path:   file.Path,
+			Change: rkev1.PlanPreviewFileChanged,
+			Minor:  file.Minor,
+		}
+		if !ok {
+			change.Change = rkev1.PlanPreviewFileAdded
+		}
+		if file.Path == configPath {
+			diff, err := configDiff(file.Path, oldFile.Content, file.Content)
+			if err != nil {
+				return machine, err
+			}
+			change.Diff = diff
+		}
+		machine.Files = append(machine.Files, change)
+	}
+	for _, file := range oldFiles {
+		machine.Files = append(machine.Files, rkev1.RKEPlanPreviewFile{
+			Path:   file.Path,
+			Change: rkev1.PlanPreviewFileRemoved,
+			Minor:  file.Minor,
+		})
+	}
+	sort.Slice(machine.Files, func(i, j int) bool {
+		return machine.Files[i].Path < machine.Files[j].Path
+	})
+
+	for _, instruction := range newPlan.Instructions {
+		if !containsInstruction(oldPlan.Instructions, instruction) {
+			machine.Instructions = append(machine.Instructions, instruction.Name)
+		}
+	}
+	return machine, nil
+}
+
+func containsInstruction(instructions []plan.OneTimeInstruction, instruction plan.OneTimeInstruction) bool {
+	for _, i := range instructions {
+		if equality.Semantic.DeepEqual(i, instruction) {
+			return true
+		}
+	}
+	return false
+}
+
+// configDiff returns the unified diff of two versions of the config file, with the values of its tokens and keys
+// redacted. The content of the files is base64 encoded, and empty if the file doesn't exist.
+func configDiff(path, oldContent, newContent string) (string, error) {
+	before, err := redactedConfig(oldContent)
+	if err != nil {
+		return "", err
+	}
+	after, err := redactedConfig(newContent)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(after),
+		FromFile: path,
+		ToFile:   path,
+		Context:  3,
+	})
+}
+
+func redactedConfig(content string) (string, error) {
+	if content == "" {
+		return "", nil
+	}
+	data, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", err
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", err
+	}
+	for key := range config {
+		if redactedConfigKeys.MatchString(key) {
+			config[key] = "[redacted]"
+		}
+	}
+	data, err = json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,69 @@
+package planner
+
+import (
+	"encoding/base64"
+	"testing"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodePlan(config, restartStamp string, files ...plan.File) plan.NodePlan {
+	return plan.NodePlan{
+		Files: append([]plan.File{{
+			Content: base64.StdEncoding.EncodeToString([]byte(config)),
+			Path:    "/etc/rancher/rke2/config.yaml.d/50-rancher.yaml",
+		}}, files...),
+		Instructions: []plan.OneTimeInstruction{{
+			Name:    "install",
+			Command: "sh",
+			Env:     []string{"RESTART_STAMP=" + restartStamp},
+		}},
+	}
+}
+
+func TestPreviewMachine(t *testing.T) {
+	controlPlane := createTestControlPlane("v1.24.4+rke2r1")
+	oldPlan := testNodePlan(`{"token": "server-token", "kubelet-arg": ["max-pods=110"]}`, "a",
+		plan.File{Path: "/var/lib/rancher/rke2/etc/registries.yaml", Content: "cmVnaXN0cmllcw=="})
+	newPlan := testNodePlan(`{"token": "server-token", "kubelet-arg": ["max-pods=250"]}`, "b",
+		plan.File{Path: "/var/lib/rancher/rke2/etc/config-files/etcd-snapshot-prune", Minor: true, Dynamic: true})
+
+	machine, err := previewMachine(oldPlan, newPlan, controlPlane)
+	require.NoError(t, err)
+	assert.True(t, machine.Changed)
+	assert.False(t, machine.Minor)
+	assert.True(t, machine.RestartStampChanged)
+	assert.Equal(t, []string{"install"}, machine.Instructions)
+
+	require.Len(t, machine.Files, 3)
+	assert.Equal(t, "/etc/rancher/rke2/config.yaml.d/50-rancher.yaml", machine.Files[0].Path)
+	assert.Equal(t, rkev1.PlanPreviewFileChanged, machine.Files[0].Change)
+	assert.Contains(t, machine.Files[0].Diff, "-    \"max-pods=110\"\n+    \"max-pods=250\"\n")
+	assert.Contains(t, machine.Files[0].Diff, `"token": "[redacted]"`)
+	assert.NotContains(t, machine.Files[0].Diff, "server-token")
+	assert.Equal(t, rkev1.RKEPlanPreviewFile{
+		Path:   "/var/lib/rancher/rke2/etc/config-files/etcd-snapshot-prune",
+		Change: rkev1.PlanPreviewFileAdded,
+		Minor:  true,
+	}, machine.Files[1])
+	assert.Equal(t, rkev1.RKEPlanPreviewFile{
+		Path:   "/var/lib/rancher/rke2/etc/registries.yaml",
+		Change: rkev1.PlanPreviewFileRemoved,
+	}, machine.Files[2], "only the config file is diffed, other files can contain credentials")
+
+	machine, err = previewMachine(oldPlan, oldPlan, controlPlane)
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.RKEPlanPreviewMachine{}, machine)
+
+	minorPlan := oldPlan
+	minorPlan.Files = append(minorPlan.Files, plan.File{Path: "/var/lib/rancher/rke2/etc/config-files/etcd-snapshot-prune", Minor: true})
+	machine, err = previewMachine(oldPlan, minorPlan, controlPlane)
+	require.NoError(t, err)
+	assert.True(t, machine.Changed)
+	assert.True(t, machine.Minor)
+	assert.False(t, machine.RestartStampChanged)
+	assert.Empty(t, machine.Instructions)
+}
@@ -0,0 +1,260 @@
+package planner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/rancher/wrangler/pkg/merr"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// rollbackUpgrade watches upgrades of the Kubernetes version of a cluster with a rollback policy, and rolls them back
+// when they fail. It returns the control plane the rest of the planner works with, which has the previous version
+// while an upgrade is rolled back. The phases are in order:
+// Snapshot -> An etcd snapshot of the previous version is taken, if the policy restores it
+// Upgrading -> The upgrade is rolled out, until it succeeds or the plans of too many machines fail or it times out
+// Restore -> The etcd snapshot taken before the upgrade is restored with the previous version, if the policy restores it
+// RollingBack -> The plans of the previous version are rolled out to the machines
+// RolledBack -> The cluster runs the previous version, until its Kubernetes version is changed
+func (p *Planner) rollbackUpgrade(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus, clusterPlan *plan.Plan) (*rkev1.RKEControlPlane, rkev1.RKEControlPlaneStatus, error) {
+	rollback := status.UpgradeRollback
+	if rollback != nil && rollback.ToVersion != cp.Spec.KubernetesVersion && rollback.Phase != rkev1.UpgradeRollbackPhaseRestore {
+		// the version was changed again, the upgrade isn't rolled back anymore, only the result of a successful
+		// upgrade is kept until the next one
+		if rollback.Phase != rkev1.UpgradeRollbackPhaseSucceeded {
+			status.UpgradeRollback = nil
+		}
+		rollback = nil
+	}
+
+	policy := cp.Spec.UpgradeStrategy.Rollback
+	if rollback == nil {
+		if policy == nil || !policy.Enabled || status.AppliedSpec == nil || status.AppliedSpec.KubernetesVersion == "" ||
+			status.AppliedSpec.KubernetesVersion == cp.Spec.KubernetesVersion {
+			return cp, status, nil
+		}
+		rollback = &rkev1.UpgradeRollbackStatus{
+			FromVersion: status.AppliedSpec.KubernetesVersion,
+			ToVersion:   cp.Spec.KubernetesVersion,
+			Phase:       rkev1.UpgradeRollbackPhaseUpgrading,
+			StartTime:   metav1.Now(),
+			Message:     fmt.Sprintf("upgrading from %s to %s", status.AppliedSpec.KubernetesVersion, cp.Spec.KubernetesVersion),
+		}
+		if restoresEtcdSnapshot(*policy, rollback.FromVersion, rollback.ToVersion) {
+			rollback.Phase = rkev1.UpgradeRollbackPhaseSnapshot
+			rollback.Message = fmt.Sprintf("taking an etcd snapshot before upgrading from %s to %s", rollback.FromVersion, rollback.ToVersion)
+			logrus.Infof("[planner] rkecluster %s/%s: %s", cp.Namespace, cp.Name, rollback.Message)
+			status.UpgradeRollback = rollback
+			return rollbackControlPlane(cp, rollback), status, ErrWaiting(rollback.Message)
+		}
+		logrus.Infof("[planner] rkecluster %s/%s: %s, the upgrade is rolled back if it fails", cp.Namespace, cp.Name, rollback.Message)
+		status.UpgradeRollback = rollback
+		return cp, status, nil
+	}
+
+	rollback = rollback.DeepCopy()
+	status.UpgradeRollback = rollback
+
+	switch rollback.Phase {
+	case rkev1.UpgradeRollbackPhaseSnapshot:
+		rollbackCP := rollbackControlPlane(cp, rollback)
+		if policy != nil && policy.Enabled && restoresEtcdSnapshot(*policy, rollback.FromVersion, rollback.ToVersion) {
+			// an older snapshot would lose the changes made since it was taken when it is restored
+			snapshot, err := p.newestEtcdSnapshot(cp, rollback.StartTime.Time)
+			if err != nil {
+				return rollbackCP, status, err
+			}
+			if snapshot == nil {
+				if errs := p.runEtcdSnapshotCreate(rollbackCP, clusterPlan); len(errs) > 0 {
+					return rollbackCP, status, ErrWaiting(merr.NewErrors(errs...).Error())
+				}
+				return rollbackCP, status, ErrWaiting("waiting for the etcd snapshot taken before the upgrade to be recorded")
+			}
+			rollback.SnapshotName = snapshot.Name
+		}
+		rollback.Phase = rkev1.UpgradeRollbackPhaseUpgrading
+		rollback.StartTime = metav1.Now()
+		rollback.Message = fmt.Sprintf("upgrading from %s to %s", rollback.FromVersion, rollback.ToVersion)
+		logrus.Infof("[planner] rkecluster %s/%s: %s, the upgrade is rolled back if it fails", cp.Namespace, cp.Name, rollback.Message)
+		return cp, status, ErrWaiting(rollback.Message)
+	case rkev1.UpgradeRollbackPhaseUpgrading:
+		if policy == nil || !policy.Enabled {
+			status.UpgradeRollback = nil
+			return cp, status, nil
+		}
+		if status.AppliedSpec != nil && status.AppliedSpec.KubernetesVersion == rollback.ToVersion {
+			rollback.Phase = rkev1.UpgradeRollbackPhaseSucceeded
+			rollback.Message = fmt.Sprintf("upgraded from %s to %s", rollback.FromVersion, rollback.ToVersion)
+			return cp, status, nil
+		}
+		// a restore that is in progress is finished first
+		if status.ETCDSnapshotRestorePhase != "" && status.ETCDSnapshotRestorePhase != rkev1.ETCDSnapshotPhaseFinished {
+			return cp, status, nil
+		}
+
+		reason := upgradeFailure(*policy, rollback, clusterPlan, time.Now())
+		if reason == "" {
+			if policy.Timeout.Duration > 0 {
+				p.rkeControlPlanes.EnqueueAfter(cp.Namespace, cp.Name, time.Until(rollback.StartTime.Add(policy.Timeout.Duration)))
+			}
+			return cp, status, nil
+		}
+
+		rollback.Message = fmt.Sprintf("upgrade to %s failed: %s, rolling back to %s", rollback.ToVersion, reason, rollback.FromVersion)
+		logrus.Warnf("[planner] rkecluster %s/%s: %s", cp.Namespace, cp.Name, rollback.Message)
+		rollback.Phase = rkev1.UpgradeRollbackPhaseRollingBack
+		if rollback.SnapshotName != "" {
+			rollback.Phase = rkev1.UpgradeRollbackPhaseRestore
+			// the restore of the rollback starts over, even if the same snapshot was restored before
+			status.ETCDSnapshotRestore = nil
+			status.ETCDSnapshotRestorePhase = ""
+		}
+		return cp, status, ErrWaiting(rollback.Message)
+	case rkev1.UpgradeRollbackPhaseRestore:
+		rollbackCP := rollbackControlPlane(cp, rollback)
+		if status.ETCDSnapshotRestorePhase != rkev1.ETCDSnapshotPhaseFinished ||
+			status.ETCDSnapshotRestore == nil || *status.ETCDSnapshotRestore != *rollbackCP.Spec.ETCDSnapshotRestore {
+			// restoreEtcdSnapshot restores the snapshot of the control plane that is returned
+			return rollbackCP, status, nil
+		}
+		// the restore in the spec of the control plane was finished before the upgrade, it isn't started again
+		status.ETCDSnapshotRestore = cp.Spec.ETCDSnapshotRestore
+		status.ETCDSnapshotRestorePhase = ""
+		if status.ETCDSnapshotRestore != nil {
+			status.ETCDSnapshotRestorePhase = rkev1.ETCDSnapshotPhaseFinished
+		}
+		rollback.Phase = rkev1.UpgradeRollbackPhaseRollingBack
+		rollback.Message = fmt.Sprintf("restored etcd snapshot %s, rolling back to %s", rollback.SnapshotName, rollback.FromVersion)
+		return cp, status, ErrWaiting(rollback.Message)
+	case rkev1.UpgradeRollbackPhaseRollingBack, rkev1.UpgradeRollbackPhaseRolledBack:
+		return rollbackControlPlane(cp, rollback), status, nil
+	}
+	return cp, status, nil
+}
+
+// upgradeRolledBack marks an upgrade that was rolled back once the plans of the previous version are rolled out to all
+// machines. The control plane isn't reconciled while its spec has the version the upgrade failed to roll out.
+func upgradeRolledBack(cp *rkev1.RKEControlPlane, status rkev1.RKEControlPlaneStatus) (rkev1.RKEControlPlaneStatus, error) {
+	rollback := status.UpgradeRollback
+	if rollback == nil || (rollback.Phase != rkev1.UpgradeRollbackPhaseRollingBack && rollback.Phase != rkev1.UpgradeRollbackPhaseRolledBack) {
+		return status, nil
+	}
+	if cp.Spec.KubernetesVersion != rollback.ToVersion {
+		status.UpgradeRollback = nil
+		return status, nil
+	}
+	if rollback.Phase == rkev1.UpgradeRollbackPhaseRollingBack {
+		rollback = rollback.DeepCopy()
+		rollback.Phase = rkev1.UpgradeRollbackPhaseRolledBack
+		rollback.Message = fmt.Sprintf("upgrade to %s was rolled back to %s, change the Kubernetes version to upgrade again",
+			rollback.ToVersion, rollback.FromVersion)
+		status.UpgradeRollback = rollback
+	}
+	return status, ErrWaiting(rollback.Message)
+}
+
+// upgradeFailure returns why an upgrade failed according to the rollback policy, or an empty string if it didn't.
+func upgradeFailure(policy rkev1.UpgradeRollback, rollback *rkev1.UpgradeRollbackStatus, clusterPlan *plan.Plan, now time.Time) string {
+	rollback.FailedMachines = nil
+	for _, entry := range collect(clusterPlan, anyRole) {
+		if entry.Plan != nil && entry.Plan.Failed && !isDeleting(entry) {
+			rollback.FailedMachines = append(rollback.FailedMachines, entry.Machine.Name)
+		}
+	}
+	sort.Strings(rollback.FailedMachines)
+
+	threshold := policy.FailureThreshold
+	if threshold <= 0 {
+		threshold = 1
+	}
+	if len(rollback.FailedMachines) >= threshold {
+		return fmt.Sprintf("plan failed to apply on machine(s) %s", atMostThree(append([]string{}, rollback.FailedMachines...)))
+	}
+	if policy.Timeout.Duration > 0 && !now.Before(rollback.StartTime.Add(policy.Timeout.Duration)) {
+		return fmt.Sprintf("upgrade did not finish within %s", policy.Timeout.Duration)
+	}
+	return ""
+}
+
+// restoresEtcdSnapshot returns whether the rollback of an upgrade restores an etcd snapshot taken before it. Upgrades
+// to another minor version always do, the previous version can't run on the etcd data that was migrated by the newer one.
+func restoresEtcdSnapshot(policy rkev1.UpgradeRollback, fromVersion, toVersion string) bool {
+	if policy.RestoreETCDSnapshot {
+		return true
+	}
+	from, err := semver.NewVersion(strings.TrimPrefix(fromVersion, "v"))
+	if err != nil {
+		return true
+	}
+	to, err := semver.NewVersion(strings.TrimPrefix(toVersion, "v"))
+	if err != nil {
+		return true
+	}
+	return from.Major() != to.Major() || from.Minor() != to.Minor()
+}
+
+// rollbackControlPlane returns a copy of the control plane with the version an upgrade is rolled back to, or the
+// version it upgrades from while the snapshot before the upgrade is taken, and the etcd snapshot taken before the
+// upgrade as the snapshot to restore while it is restored. The plans of the previous version
+// are rolled out outside of maintenance windows and to all machines at once, the cluster is broken until they are.
+func rollbackControlPlane(cp *rkev1.RKEControlPlane, rollback *rkev1.UpgradeRollbackStatus) *rkev1.RKEControlPlane {
+	cp = cp.DeepCopy()
+	cp.Spec.KubernetesVersion = rollback.FromVersion
+	cp.Spec.UpgradeStrategy.IgnoreMaintenanceWindows = true
+	cp.Spec.UpgradeStrategy.Canary = nil
+	if rollback.Phase == rkev1.UpgradeRollbackPhaseRestore {
+		cp.Spec.ETCDSnapshotRestore = &rkev1.ETCDSnapshotRestore{
+			Name:             rollback.SnapshotName,
+			RestoreRKEConfig: "none",
+		}
+	}
+	return cp
+}
+
+// upgradeRollbackSnapshot returns the etcdsnapshot object an upgrade of the control plane is rolled back to, if any.
+func upgradeRollbackSnapshot(cp *rkev1.RKEControlPlane) string {
+	if rollback := cp.Status.UpgradeRollback; rollback != nil &&
+		(rollback.Phase == rkev1.UpgradeRollbackPhaseUpgrading || rollback.Phase == rkev1.UpgradeRollbackPhaseRestore) {
+		return rollback.SnapshotName
+	}
+	return ""
+}
+
+// newestEtcdSnapshot returns the newest successful etcd snapshot of a cluster that was taken after the given time, or
+// nil if there is none.
+func (p *Planner) newestEtcdSnapshot(cp *rkev1.RKEControlPlane, after time.Time) (*rkev1.ETCDSnapshot, error) {
+	snapshots, err := p.etcdSnapshotCache.List(cp.Namespace, labels.SelectorFromSet(map[string]string{
+		rke2.ClusterNameLabel: cp.Spec.ClusterName,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		newest        *rkev1.ETCDSnapshot
+		newestCreated time.Time
+	)
+	for _, snapshot := range snapshots {
+		if snapshot.SnapshotFile.Status == "failed" || snapshot.Status.Missing {
+			continue
+		}
+		created := snapshot.CreationTimestamp.Time
+		if snapshot.SnapshotFile.CreatedAt != nil {
+			created = snapshot.SnapshotFile.CreatedAt.Time
+		}
+		if created.Before(after) {
+			continue
+		}
+		if newest == nil || created.After(newestCreated) {
+			newest, newestCreated = snapshot, created
+		}
+	}
+	return newest, nil
+}
@@ -0,0 +1,198 @@
+package planner
+
+import (
+	"testing"
+	"time"
+
+	rkev1 "github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1"
+	"github.com/rancher/rancher/pkg/apis/rke.cattle.io/v1/plan"
+	"github.com/rancher/rancher/pkg/controllers/provisioningv2/rke2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capi "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func newTestUpgradePlan(failed ...string) *plan.Plan {
+	clusterPlan := &plan.Plan{
+		Nodes:    map[string]*plan.Node{},
+		Machines: map[string]*capi.Machine{},
+		Metadata: map[string]*plan.Metadata{},
+	}
+	for _, name := range []string{"cp-0", "cp-1", "worker-0"} {
+		clusterPlan.Machines[name] = &capi.Machine{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		clusterPlan.Metadata[name] = &plan.Metadata{Labels: map[string]string{rke2.WorkerRoleLabel: "true"}}
+		clusterPlan.Nodes[name] = &plan.Node{}
+	}
+	for _, name := range failed {
+		clusterPlan.Nodes[name].Failed = true
+	}
+	return clusterPlan
+}
+
+func TestRollbackUpgrade(t *testing.T) {
+	now := time.Now()
+	p := &Planner{etcdSnapshotCache: etcdSnapshotCache{
+		newTestETCDSnapshot("old", "machine-0", now.Add(-2*time.Hour)),
+		newTestETCDSnapshot("older", "machine-0", now.Add(-time.Hour)),
+	}}
+	userRestore := &rkev1.ETCDSnapshotRestore{Name: "test-old-local", Generation: 1}
+
+	cp := createTestControlPlane("v1.25.3+rke2r1")
+	cp.Namespace = "fleet-default"
+	cp.Spec.ClusterName = "test"
+	cp.Spec.ETCDSnapshotRestore = userRestore
+	cp.Spec.UpgradeStrategy.Rollback = &rkev1.UpgradeRollback{Enabled: true, RestoreETCDSnapshot: true}
+	status := rkev1.RKEControlPlaneStatus{
+		AppliedSpec:              &rkev1.RKEControlPlaneSpec{KubernetesVersion: "v1.24.7+rke2r1"},
+		ETCDSnapshotRestore:      userRestore,
+		ETCDSnapshotRestorePhase: rkev1.ETCDSnapshotPhaseFinished,
+	}
+
+	// the upgrade waits for a snapshot of the previous version, older snapshots aren't restored
+	result, status, err := p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	assert.True(t, IsErrWaiting(err))
+	assert.Equal(t, "v1.24.7+rke2r1", result.Spec.KubernetesVersion)
+	require.NotNil(t, status.UpgradeRollback)
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseSnapshot, status.UpgradeRollback.Phase)
+	assert.Equal(t, "v1.24.7+rke2r1", status.UpgradeRollback.FromVersion)
+	assert.Equal(t, "v1.25.3+rke2r1", status.UpgradeRollback.ToVersion)
+	cp.Status = status
+
+	_, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	assert.True(t, IsErrWaiting(err))
+	assert.Contains(t, err.Error(), "failed to find node to perform etcd snapshot")
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseSnapshot, status.UpgradeRollback.Phase)
+	assert.Empty(t, status.UpgradeRollback.SnapshotName)
+
+	// the upgrade is rolled out once the snapshot is taken
+	p.etcdSnapshotCache = append(p.etcdSnapshotCache.(etcdSnapshotCache), newTestETCDSnapshot("new", "machine-0", time.Now().Add(time.Second)))
+	result, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	assert.True(t, IsErrWaiting(err))
+	assert.Equal(t, cp, result)
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseUpgrading, status.UpgradeRollback.Phase)
+	assert.Equal(t, "test-new-local", status.UpgradeRollback.SnapshotName)
+	cp.Status = status
+
+	// the upgrade fails on a machine
+	_, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan("cp-1"))
+	assert.True(t, IsErrWaiting(err))
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseRestore, status.UpgradeRollback.Phase)
+	assert.Equal(t, []string{"cp-1"}, status.UpgradeRollback.FailedMachines)
+	assert.Equal(t, "upgrade to v1.25.3+rke2r1 failed: plan failed to apply on machine(s) cp-1, rolling back to v1.24.7+rke2r1", status.UpgradeRollback.Message)
+	assert.Nil(t, status.ETCDSnapshotRestore)
+	assert.Empty(t, status.ETCDSnapshotRestorePhase)
+	cp.Status = status
+
+	// the snapshot is restored with the previous version
+	result, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan("cp-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1.24.7+rke2r1", result.Spec.KubernetesVersion)
+	assert.Equal(t, &rkev1.ETCDSnapshotRestore{Name: "test-new-local", RestoreRKEConfig: "none"}, result.Spec.ETCDSnapshotRestore)
+	assert.Equal(t, "v1.25.3+rke2r1", cp.Spec.KubernetesVersion, "the control plane itself isn't changed")
+	assert.Equal(t, "test-new-local", upgradeRollbackSnapshot(cp), "the snapshot isn't pruned")
+
+	status.ETCDSnapshotRestore = result.Spec.ETCDSnapshotRestore
+	status.ETCDSnapshotRestorePhase = rkev1.ETCDSnapshotPhaseFinished
+	_, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan("cp-1"))
+	assert.True(t, IsErrWaiting(err))
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseRollingBack, status.UpgradeRollback.Phase)
+	assert.Equal(t, userRestore, status.ETCDSnapshotRestore, "the restore in the spec isn't started again")
+	assert.Equal(t, rkev1.ETCDSnapshotPhaseFinished, status.ETCDSnapshotRestorePhase)
+	cp.Status = status
+
+	// the machines are reconciled with the previous version
+	result, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	require.NoError(t, err)
+	assert.Equal(t, "v1.24.7+rke2r1", result.Spec.KubernetesVersion)
+	assert.Equal(t, userRestore, result.Spec.ETCDSnapshotRestore)
+	assert.True(t, result.Spec.UpgradeStrategy.IgnoreMaintenanceWindows)
+
+	status, err = upgradeRolledBack(cp, status)
+	assert.True(t, IsErrWaiting(err), "the failed version is never marked as applied")
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseRolledBack, status.UpgradeRollback.Phase)
+	cp.Status = status
+
+	// the previous version is set again, which ends the rollback
+	cp.Spec.KubernetesVersion = "v1.24.7+rke2r1"
+	result, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	require.NoError(t, err)
+	assert.Equal(t, cp, result)
+	assert.Nil(t, status.UpgradeRollback)
+
+	status.UpgradeRollback = cp.Status.UpgradeRollback
+	status, err = upgradeRolledBack(cp, status)
+	assert.NoError(t, err)
+	assert.Nil(t, status.UpgradeRollback)
+}
+
+func TestRollbackUpgradeSucceeded(t *testing.T) {
+	p := &Planner{}
+	cp := createTestControlPlane("v1.25.3+rke2r1")
+	cp.Spec.UpgradeStrategy.Rollback = &rkev1.UpgradeRollback{Enabled: true, FailureThreshold: 2}
+	status := rkev1.RKEControlPlaneStatus{
+		AppliedSpec: &rkev1.RKEControlPlaneSpec{KubernetesVersion: "v1.25.2+rke2r1"},
+	}
+
+	// patch upgrades only take a snapshot if the policy restores it
+	_, status, err := p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	require.NoError(t, err)
+	assert.Empty(t, status.UpgradeRollback.SnapshotName)
+
+	_, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan("cp-1"))
+	require.NoError(t, err, "the failure threshold isn't reached")
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseUpgrading, status.UpgradeRollback.Phase)
+
+	status.AppliedSpec = &cp.Spec
+	_, status, err = p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	require.NoError(t, err)
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseSucceeded, status.UpgradeRollback.Phase)
+	status, err = upgradeRolledBack(cp, status)
+	assert.NoError(t, err)
+}
+
+func TestRestoresEtcdSnapshot(t *testing.T) {
+	tests := []struct {
+		policy   rkev1.UpgradeRollback
+		from, to string
+		restores bool
+	}{
+		{rkev1.UpgradeRollback{Enabled: true}, "v1.25.2+rke2r1", "v1.25.3+rke2r1", false},
+		{rkev1.UpgradeRollback{Enabled: true, RestoreETCDSnapshot: true}, "v1.25.2+rke2r1", "v1.25.3+rke2r1", true},
+		{rkev1.UpgradeRollback{Enabled: true}, "v1.24.7+rke2r1", "v1.25.3+rke2r1", true},
+		{rkev1.UpgradeRollback{Enabled: true}, "v1.24.7+k3s1", "v1.24.8+k3s1", false},
+		{rkev1.UpgradeRollback{Enabled: true}, "invalid", "v1.25.3+rke2r1", true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.restores, restoresEtcdSnapshot(test.policy, test.from, test.to), "%s to %s", test.from, test.to)
+	}
+
+	// a minor upgrade takes a snapshot even if the policy doesn't restore it
+	p := &Planner{}
+	cp := createTestControlPlane("v1.25.3+rke2r1")
+	cp.Spec.UpgradeStrategy.Rollback = &rkev1.UpgradeRollback{Enabled: true}
+	status := rkev1.RKEControlPlaneStatus{
+		AppliedSpec: &rkev1.RKEControlPlaneSpec{KubernetesVersion: "v1.24.7+rke2r1"},
+	}
+	result, status, err := p.rollbackUpgrade(cp, status, newTestUpgradePlan())
+	assert.True(t, IsErrWaiting(err))
+	assert.Equal(t, "v1.24.7+rke2r1", result.Spec.KubernetesVersion)
+	require.NotNil(t, status.UpgradeRollback)
+	assert.Equal(t, rkev1.UpgradeRollbackPhaseSnapshot, status.UpgradeRollback.Phase)
+}
+
+func TestUpgradeFailure(t *testing.T) {
+	start := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	policy := rkev1.UpgradeRollback{Enabled: true, FailureThreshold: 2, Timeout: metav1.Duration{Duration: time.Hour}}
+	rollback := &rkev1.UpgradeRollbackStatus{StartTime: metav1.NewTime(start)}
+
+	assert.Empty(t, upgradeFailure(policy, rollback, newTestUpgradePlan("worker-0"), start.Add(time.Minute)))
+	assert.Equal(t, []string{"worker-0"}, rollback.FailedMachines)
+	assert.Equal(t, "plan failed to apply on machine(s) cp-0,worker-0",
+		upgradeFailure(policy, rollback, newTestUpgradePlan("worker-0", "cp-0"), start.Add(time.Minute)))
+	assert.Equal(t, "upgrade did not finish within 1h0m0s",
+		upgradeFailure(policy, rollback, newTestUpgradePlan(), start.Add(time.Hour)))
+
+	policy.Timeout = metav1.Duration{}
+	assert.Empty(t, upgradeFailure(policy, rollback, newTestUpgradePlan(), start.Add(24*time.Hour)))
+}
@@ -75,6 +75,8 @@ type Options struct {
 	AuditLogMaxsize   int
 	AuditLogMaxbackup int
 	AuditLevel        int
+	AuditLogSinks     audit.SinkOptions
+	AuditLogChain     audit.ChainOptions
 	Features          string
 	ClusterRegistry   string
 }
@@ -209,7 +211,17 @@ func New(ctx context.Context, clientConfg clientcmd.ClientConfig, opts *Options)
 		return nil, err
 	}
 
-	auditLogWriter := audit.NewLogWriter(opts.AuditLogPath, audit.Level(opts.AuditLevel), opts.AuditLogMaxage, opts.AuditLogMaxbackup, opts.AuditLogMaxsize)
+	var auditLogSinks []audit.Sink
+	if audit.Level(opts.AuditLevel) != audit.LevelNull {
+		auditLogSinks, err = audit.NewSinks(opts.AuditLogSinks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	auditLogWriter := audit.NewLogWriter(opts.AuditLogPath, audit.Level(opts.AuditLevel), opts.AuditLogMaxage, opts.AuditLogMaxbackup, opts.AuditLogMaxsize, auditLogSinks...)
+	if err := auditLogWriter.EnableHashChain(opts.AuditLogChain); err != nil {
+		return nil, err
+	}
 	auditFilter, err := audit.NewAuditLogMiddleware(auditLogWriter)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,27 @@
+package rbac
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingActive returns whether a role binding with the given NotBefore and ExpiresAt times grants its role at the
+// given time, and how long until that changes. The duration is 0 if it never changes again.
+func BindingActive(notBefore, expiresAt *metav1.Time, now time.Time) (bool, time.Duration) {
+	if notBefore != nil && now.Before(notBefore.Time) {
+		return false, notBefore.Sub(now)
+	}
+	if expiresAt == nil {
+		return true, 0
+	}
+	if !now.Before(expiresAt.Time) {
+		return false, 0
+	}
+	return true, expiresAt.Sub(now)
+}
+
+// BindingExpired returns whether a role binding with the given ExpiresAt time expired at the given time.
+func BindingExpired(expiresAt *metav1.Time, now time.Time) bool {
+	return expiresAt != nil && !now.Before(expiresAt.Time)
+}
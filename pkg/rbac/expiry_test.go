@@ -0,0 +1,40 @@
+package rbac
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBindingActive(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	before := &metav1.Time{Time: now.Add(-time.Hour)}
+	after := &metav1.Time{Time: now.Add(time.Hour)}
+
+	tests := []struct {
+		name      string
+		notBefore *metav1.Time
+		expiresAt *metav1.Time
+		active    bool
+		next      time.Duration
+	}{
+		{name: "no times", active: true},
+		{name: "not yet active", notBefore: after, next: time.Hour},
+		{name: "active", notBefore: before, active: true},
+		{name: "expires", notBefore: before, expiresAt: after, active: true, next: time.Hour},
+		{name: "expired", expiresAt: before},
+		{name: "expires now", expiresAt: &metav1.Time{Time: now}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active, next := BindingActive(tt.notBefore, tt.expiresAt, now)
+			if active != tt.active || next != tt.next {
+				t.Errorf("BindingActive() = %v, %v, want %v, %v", active, next, tt.active, tt.next)
+			}
+			if expired := BindingExpired(tt.expiresAt, now); expired != (tt.expiresAt != nil && !tt.active) {
+				t.Errorf("BindingExpired() = %v", expired)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package resourcequota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rancher/norman/types/convert"
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const extendedField = "extended"
+
+// standardLimits maps the json names of the fields of a ResourceQuotaLimit to the resources they limit in a
+// ResourceQuota.
+var standardLimits = map[string]api.ResourceName{
+	"pods":                   api.ResourcePods,
+	"services":               api.ResourceServices,
+	"replicationControllers": api.ResourceReplicationControllers,
+	"secrets":                api.ResourceSecrets,
+	"configMaps":             api.ResourceConfigMaps,
+	"persistentVolumeClaims": api.ResourcePersistentVolumeClaims,
+	"servicesNodePorts":      api.ResourceServicesNodePorts,
+	"servicesLoadBalancers":  api.ResourceServicesLoadBalancers,
+	"requestsCpu":            api.ResourceRequestsCPU,
+	"requestsMemory":         api.ResourceRequestsMemory,
+	"requestsStorage":        api.ResourceRequestsStorage,
+	"limitsCpu":              api.ResourceLimitsCPU,
+	"limitsMemory":           api.ResourceLimitsMemory,
+}
+
+// LimitToMap flattens a limit into its values keyed by the json names of its fields and the resource names of its
+// extended limits.
+func LimitToMap(limit *v32.ResourceQuotaLimit) (map[string]string, error) {
+	toReturn := map[string]string{}
+	if limit == nil {
+		return toReturn, nil
+	}
+	converted, err := convert.EncodeToMap(limit)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range converted {
+		if key == extendedField {
+			continue
+		}
+		toReturn[key] = convert.ToString(value)
+	}
+	for key, value := range limit.Extended {
+		toReturn[key] = value
+	}
+	return toReturn, nil
+}
+
+// MapToLimit is the inverse of LimitToMap, keys that aren't the json name of a field become extended limits.
+func MapToLimit(limitMap map[string]string) (*v32.ResourceQuotaLimit, error) {
+	standard := map[string]interface{}{}
+	extended := map[string]string{}
+	for key, value := range limitMap {
+		if _, ok := standardLimits[key]; ok {
+			standard[key] = value
+		} else {
+			extended[key] = value
+		}
+	}
+
+	toReturn := &v32.ResourceQuotaLimit{}
+	if err := convert.ToObj(standard, toReturn); err != nil {
+		return nil, err
+	}
+	if len(extended) > 0 {
+		toReturn.Extended = extended
+	}
+	return toReturn, nil
+}
+
+// ResourceQuotaName returns the resource limited in a ResourceQuota for a key of a flattened limit.
+func ResourceQuotaName(key string) api.ResourceName {
+	if name, ok := standardLimits[key]; ok {
+		return name
+	}
+	return api.ResourceName(key)
+}
+
+// ValidateExtendedLimits checks that the extended limits are valid quantities of qualified resource names, which
+// aren't limited by one of the fields already.
+func ValidateExtendedLimits(limit *v32.ResourceQuotaLimit) error {
+	if limit == nil {
+		return nil
+	}
+
+	standard := map[api.ResourceName]string{}
+	for key, name := range standardLimits {
+		standard[name] = key
+	}
+
+	var keys []string
+	for key := range limit.Extended {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := standardLimits[key]; ok {
+			return fmt.Errorf("extended limit %s must be set as a field", key)
+		}
+		if field, ok := standard[api.ResourceName(key)]; ok {
+			return fmt.Errorf("extended limit %s must be set as %s", key, field)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid extended limit %s: %s", key, strings.Join(errs, ", "))
+		}
+		if _, err := resource.ParseQuantity(limit.Extended[key]); err != nil {
+			return fmt.Errorf("invalid quantity %s for extended limit %s: %v", limit.Extended[key], key, err)
+		}
+	}
+	return nil
+}
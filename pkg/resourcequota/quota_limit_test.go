@@ -0,0 +1,101 @@
+package resourcequota
+
+import (
+	"testing"
+
+	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
+	"github.com/stretchr/testify/assert"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestLimitToMapRoundTrip(t *testing.T) {
+	limit := &v32.ResourceQuotaLimit{
+		Pods:         "10",
+		LimitsMemory: "1Gi",
+		Extended: map[string]string{
+			"requests.ephemeral-storage": "5Gi",
+			"requests.nvidia.com/gpu":    "2",
+		},
+	}
+
+	limitMap, err := LimitToMap(limit)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"pods":                       "10",
+		"limitsMemory":               "1Gi",
+		"requests.ephemeral-storage": "5Gi",
+		"requests.nvidia.com/gpu":    "2",
+	}, limitMap)
+
+	result, err := MapToLimit(limitMap)
+	assert.NoError(t, err)
+	assert.Equal(t, limit, result)
+}
+
+func TestValidateExtendedLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		extended map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "valid extended limits",
+			extended: map[string]string{
+				"count/deployments.apps":                            "10",
+				"requests.nvidia.com/gpu":                           "1",
+				"gold.storageclass.storage.k8s.io/requests.storage": "10Gi",
+			},
+		},
+		{
+			name:     "field name",
+			extended: map[string]string{"requestsCpu": "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "resource limited by a field",
+			extended: map[string]string{"requests.cpu": "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid resource name",
+			extended: map[string]string{"not a/valid/name": "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid quantity",
+			extended: map[string]string{"requests.nvidia.com/gpu": "lots"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExtendedLimits(&v32.ResourceQuotaLimit{Extended: tt.extended})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsQuotaFitExtended(t *testing.T) {
+	project := &v32.ResourceQuotaLimit{
+		Extended: map[string]string{"requests.nvidia.com/gpu": "4"},
+	}
+	ns := &v32.ResourceQuotaLimit{
+		Extended: map[string]string{"requests.nvidia.com/gpu": "2"},
+	}
+
+	fit, _, err := IsQuotaFit(ns, []*v32.ResourceQuotaLimit{ns}, project)
+	assert.NoError(t, err)
+	assert.True(t, fit)
+
+	fit, exceeded, err := IsQuotaFit(ns, []*v32.ResourceQuotaLimit{ns, ns}, project)
+	assert.NoError(t, err)
+	assert.False(t, fit)
+	gpu := exceeded[api.ResourceName("requests.nvidia.com/gpu")]
+	assert.Equal(t, 0, gpu.Cmp(resource.MustParse("6")))
+}
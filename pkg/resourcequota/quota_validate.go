@@ -4,7 +4,6 @@
 	"sync"
 	"time"
 
-	"github.com/rancher/norman/types/convert"
 	v32 "github.com/rancher/rancher/pkg/apis/management.cattle.io/v3"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -59,12 +58,12 @@ func IsQuotaFit(nsLimit *v32.ResourceQuotaLimit, nsLimits []*v32.ResourceQuotaLi
 
 func ConvertLimitToResourceList(limit *v32.ResourceQuotaLimit) (api.ResourceList, error) {
 	toReturn := api.ResourceList{}
-	converted, err := convert.EncodeToMap(limit)
+	converted, err := LimitToMap(limit)
 	if err != nil {
 		return nil, err
 	}
 	for key, value := range converted {
-		q, err := resource.ParseQuantity(convert.ToString(value))
+		q, err := resource.ParseQuantity(value)
 		if err != nil {
 			return nil, err
 		}
@@ -53,6 +53,7 @@
 		Init(credTypes).
 		Init(mgmtSecretTypes).
 		Init(clusterTemplateTypes).
+		Init(projectTemplateTypes).
 		Init(driverMetadataTypes).
 		Init(driverMetadataCisTypes).
 		Init(encryptionTypes).
@@ -363,7 +364,22 @@ func authzTypes(schemas *types.Schemas) *types.Schemas {
 		}).
 		MustImport(&Version, v3.ClusterRoleTemplateBinding{}).
 		MustImport(&Version, v3.ProjectRoleTemplateBinding{}).
-		MustImport(&Version, v3.GlobalRoleBinding{})
+		MustImport(&Version, v3.GlobalRoleBinding{}).
+		MustImport(&Version, v3.ElevationDecisionInput{}).
+		MustImportAndCustomize(&Version, v3.ElevationRequest{}, func(schema *types.Schema) {
+			schema.CollectionMethods = []string{http.MethodGet, http.MethodPost}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
+			schema.ResourceActions = map[string]types.Action{
+				v3.ElevationRequestActionApprove: {
+					Input:  "elevationDecisionInput",
+					Output: "elevationRequest",
+				},
+				v3.ElevationRequestActionDeny: {
+					Input:  "elevationDecisionInput",
+					Output: "elevationRequest",
+				},
+			}
+		})
 }
 
 func nodeTypes(schemas *types.Schemas) *types.Schemas {
@@ -473,6 +489,14 @@ func authnTypes(schemas *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.SearchPrincipalsInput{}).
 		MustImport(&Version, v3.ChangePasswordInput{}).
 		MustImport(&Version, v3.SetPasswordInput{}).
+		MustImport(&Version, v3.TOTPEnrollOutput{}).
+		MustImport(&Version, v3.TOTPVerifyInput{}).
+		MustImport(&Version, v3.TOTPVerifyOutput{}).
+		MustImport(&Version, v3.TOTPDisableInput{}).
+		MustImport(&Version, v3.EffectivePermissionsInput{}).
+		MustImport(&Version, v3.EffectivePermissionsOutput{}).
+		MustImport(&Version, v3.WhoCanInput{}).
+		MustImport(&Version, v3.WhoCanOutput{}).
 		MustImportAndCustomize(&Version, v3.User{}, func(schema *types.Schema) {
 			schema.ResourceActions = map[string]types.Action{
 				"setpassword": {
@@ -480,12 +504,30 @@ func authnTypes(schemas *types.Schemas) *types.Schemas {
 					Output: "user",
 				},
 				"refreshauthprovideraccess": {},
+				"totpenroll": {
+					Output: "totpEnrollOutput",
+				},
+				"totpverify": {
+					Input:  "totpVerifyInput",
+					Output: "totpVerifyOutput",
+				},
+				"totpdisable": {
+					Input: "totpDisableInput",
+				},
 			}
 			schema.CollectionActions = map[string]types.Action{
 				"changepassword": {
 					Input: "changePasswordInput",
 				},
 				"refreshauthprovideraccess": {},
+				"effectivepermissions": {
+					Input:  "effectivePermissionsInput",
+					Output: "effectivePermissionsOutput",
+				},
+				"whocan": {
+					Input:  "whoCanInput",
+					Output: "whoCanOutput",
+				},
 			}
 		}).
 		MustImportAndCustomize(&Version, v3.AuthConfig{}, func(schema *types.Schema) {
@@ -982,6 +1024,16 @@ func clusterTemplateTypes(schemas *types.Schemas) *types.Schemas {
 
 }
 
+func projectTemplateTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		TypeName("projectTemplate", v3.ProjectTemplate{}).
+		TypeName("projectTemplateRevision", v3.ProjectTemplateRevision{}).
+		AddMapperForType(&Version, v3.ProjectTemplate{}, m.Drop{Field: "namespaceId"}, m.DisplayName{}).
+		AddMapperForType(&Version, v3.ProjectTemplateRevision{}, m.Drop{Field: "namespaceId"}, m.DisplayName{}).
+		MustImport(&Version, v3.ProjectTemplate{}).
+		MustImport(&Version, v3.ProjectTemplateRevision{})
+}
+
 func clusterScanTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.MustImportAndCustomize(&Version, v3.ClusterScan{}, func(schema *types.Schema) {
 		schema.CollectionMethods = []string{http.MethodGet}
@@ -109,19 +109,46 @@
 	Rke2DefaultVersion = NewSetting("rke2-default-version", "")
 	K3sDefaultVersion  = NewSetting("k3s-default-version", "")
 
+	// AccountLockoutDurationMinutes is how long a local user stays locked out before being unlocked automatically.
+	AccountLockoutDurationMinutes = NewSetting("account-lockout-duration-minutes", "30")
+
+	// AccountLockoutMaxAttempts is the number of failed logins within account-lockout-window-minutes after which a local user is locked out.
+	AccountLockoutMaxAttempts = NewSetting("account-lockout-max-attempts", "0") // 0 = lockout disabled
+
+	// AccountLockoutWindowMinutes is the period in which failed logins of a local user are counted towards a lockout.
+	AccountLockoutWindowMinutes = NewSetting("account-lockout-window-minutes", "15")
+
+	// AuditLogPolicy is an ordered list of rules, in JSON or YAML, that sets the audit level per user, group, method, URI or resource.
+	// Requests that match no rule are logged at the level set by the audit-level flag.
+	AuditLogPolicy = NewSetting("audit-log-policy", "")
+
+	// AuthTokenIdleTTLMinutes is the time after which session and API tokens that were not used are deleted.
+	AuthTokenIdleTTLMinutes = NewSetting("auth-token-idle-ttl-minutes", "0") // 0 = tokens never expire from being idle
+
 	// AuthTokenMaxTTLMinutes is the max allowable time to live for tokens. Excluding those created for UI sessions which is controlled by AuthUserSessionTTLMinutes.
 	AuthTokenMaxTTLMinutes = NewSetting("auth-token-max-ttl-minutes", "0") // never expire
 
 	// AuthUserInfoMaxAgeSeconds represents the maximum age of a users auth tokens before an auth provider group membership sync will be performed.
 	AuthUserInfoMaxAgeSeconds = NewSetting("auth-user-info-max-age-seconds", "3600") // 1 hour
 
+	// AuthTrustedProxyCIDRs is a comma separated list of the CIDRs of proxies in front of Rancher whose X-Forwarded-For
+	// header is trusted for the client address of a request.
+	AuthTrustedProxyCIDRs = NewSetting("auth-trusted-proxy-cidrs", "")
+
 	// AuthUserSessionTTLMinutes represents the time to live for tokens used for login sessions in minutes.
 	AuthUserSessionTTLMinutes = NewSetting("auth-user-session-ttl-minutes", "960") // 16 hours
 
+	// CanaryHealthGateURLs is a comma separated list of the Prometheus URLs the query health gates of canary rollouts
+	// may query, a health gate URL has to have the scheme and host of one of them and start with its path.
+	CanaryHealthGateURLs = NewSetting("canary-health-gate-urls", "http://rancher-monitoring-prometheus.cattle-monitoring-system.svc:9090")
+
 	// CSPAdapterMinVersion is used to determine if an existing installation of the CSP adapter should be upgraded to a new version
 	// has no effect if the csp adapter is not installed
 	CSPAdapterMinVersion = NewSetting("csp-adapter-min-version", "")
 
+	// ElevationRequestMaxDurationMinutes is the max duration for which an approved elevation request grants its role.
+	ElevationRequestMaxDurationMinutes = NewSetting("elevation-request-max-duration-minutes", "480") // 8 hours
+
 	// FleetMinVersion is the minimum version of the fleet chart that rancher will install
 	FleetMinVersion = NewSetting("fleet-min-version", "")
 
@@ -140,6 +167,27 @@
 	// Deprecated: On removal use kubeconfig-default-ttl-minutes for all kubeconfigs.
 	KubeconfigTokenTTLMinutes = NewSetting("kubeconfig-token-ttl-minutes", "960") // 16 hours
 
+	// LocalTOTPRequiredGlobalRoles is a comma separated list of GlobalRoles whose local users must enroll a TOTP second factor.
+	LocalTOTPRequiredGlobalRoles = NewSetting("local-totp-required-global-roles", "")
+
+	// PasswordHistoryCount is the number of previous passwords of a local user that can't be reused.
+	PasswordHistoryCount = NewSetting("password-history-count", "0")
+
+	// PasswordMaxAgeDays is the number of days after which local users must change their password at the next login.
+	PasswordMaxAgeDays = NewSetting("password-max-age-days", "0") // 0 = passwords never expire
+
+	// PasswordRequireDigit requires passwords of local users to contain a digit.
+	PasswordRequireDigit = NewSetting("password-require-digit", "false")
+
+	// PasswordRequireLowerCase requires passwords of local users to contain a lower case letter.
+	PasswordRequireLowerCase = NewSetting("password-require-lower-case", "false")
+
+	// PasswordRequireSpecialCharacter requires passwords of local users to contain a character that is neither a letter nor a digit.
+	PasswordRequireSpecialCharacter = NewSetting("password-require-special-character", "false")
+
+	// PasswordRequireUpperCase requires passwords of local users to contain an upper case letter.
+	PasswordRequireUpperCase = NewSetting("password-require-upper-case", "false")
+
 	// RancherWebhookMinVersion is the minimum version of the webhook that rancher will install
 	RancherWebhookMinVersion = NewSetting("rancher-webhook-min-version", "")
 
@@ -13,6 +13,7 @@
 	"github.com/ehazlett/simplelog"
 	_ "github.com/rancher/norman/controller"
 	"github.com/rancher/norman/pkg/kwrapper/k8s"
+	"github.com/rancher/rancher/pkg/auth/audit"
 	"github.com/rancher/rancher/pkg/data/management"
 	"github.com/rancher/rancher/pkg/logserver"
 	"github.com/rancher/rancher/pkg/rancher"
@@ -41,6 +42,7 @@ func main() {
 func runRancher(ctx context.Context) error {
 	management.RegisterPasswordResetCommand()
 	management.RegisterEnsureDefaultAdminCommand()
+	audit.RegisterVerifyCommand()
 	if reexec.Init() {
 		return nil
 	}
@@ -151,6 +153,50 @@ func runRancher(ctx context.Context) error {
 			Usage:       "Defines the maximum size in megabytes of the audit log file before it gets rotated, default size is 100M",
 			Destination: &config.AuditLogMaxsize,
 		},
+		cli.StringFlag{
+			Name:        "audit-log-webhook-url",
+			EnvVar:      "AUDIT_LOG_WEBHOOK_URL",
+			Usage:       "HTTP(S) endpoint audit log records are additionally sent to in batches as newline delimited JSON",
+			Destination: &config.AuditLogSinks.WebhookURL,
+		},
+		cli.StringFlag{
+			Name:        "audit-log-syslog-address",
+			EnvVar:      "AUDIT_LOG_SYSLOG_ADDRESS",
+			Usage:       "RFC5424 syslog receiver audit log records are additionally sent to, in the form tcp://host:port or tls://host:port",
+			Destination: &config.AuditLogSinks.SyslogAddress,
+		},
+		cli.BoolFlag{
+			Name:        "audit-log-stdout",
+			EnvVar:      "AUDIT_LOG_STDOUT",
+			Usage:       "Additionally write audit log records to stdout",
+			Destination: &config.AuditLogSinks.Stdout,
+		},
+		cli.IntFlag{
+			Name:        "audit-log-sink-buffer-size",
+			Value:       10000,
+			EnvVar:      "AUDIT_LOG_SINK_BUFFER_SIZE",
+			Usage:       "Defines the number of audit log records queued for the webhook, syslog and stdout destinations before records are dropped",
+			Destination: &config.AuditLogSinks.BufferSize,
+		},
+		cli.BoolFlag{
+			Name:        "audit-log-hash-chain",
+			EnvVar:      "AUDIT_LOG_HASH_CHAIN",
+			Usage:       "Add a sequence number and a hash chained to the previous record to every audit log record, and write periodic checkpoint records",
+			Destination: &config.AuditLogChain.Enabled,
+		},
+		cli.StringFlag{
+			Name:        "audit-log-checkpoint-key",
+			EnvVar:      "AUDIT_LOG_CHECKPOINT_KEY",
+			Usage:       "Path to a PEM encoded PKCS #8 ed25519 private key used to sign audit log checkpoint records",
+			Destination: &config.AuditLogChain.SigningKeyPath,
+		},
+		cli.IntFlag{
+			Name:        "audit-log-checkpoint-interval",
+			Value:       1000,
+			EnvVar:      "AUDIT_LOG_CHECKPOINT_INTERVAL",
+			Usage:       "Defines the number of audit log records between checkpoint records when the hash chain is enabled",
+			Destination: &config.AuditLogChain.CheckpointInterval,
+		},
 		cli.IntFlag{
 			Name:        "audit-level",
 			Value:       0,